@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
 
@@ -322,6 +323,43 @@ func GetBlockById(groupId string, id string) (*BlockStruct, error) {
 	return &ret, nil
 }
 
+func AnnouncedUsers(groupId string) ([]*qApi.AnnouncedUserListItem, error) {
+	url := fmt.Sprintf("%s/api/v1/group/%s/announced/users", ApiServer, groupId)
+	ret := []*qApi.AnnouncedUserListItem{}
+	body, err := httpGet(url)
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(body, &ret)
+	if err != nil {
+		return nil, errors.New(string(body))
+	}
+	return ret, nil
+}
+
+func ApproveAnnouncedUser(groupId string, pubkey string) (*qApi.AnnounceResultResult, error) {
+	return updAnnounceResult(groupId, pubkey, "approve")
+}
+
+func RejectAnnouncedUser(groupId string, pubkey string) (*qApi.AnnounceResultResult, error) {
+	return updAnnounceResult(groupId, pubkey, "reject")
+}
+
+func updAnnounceResult(groupId string, pubkey string, action string) (*qApi.AnnounceResultResult, error) {
+	// sign pubkey is base64 encoded, escape it in path
+	url := fmt.Sprintf("%s/api/v1/group/%s/announced/users/%s/%s", ApiServer, groupId, url.PathEscape(pubkey), action)
+	ret := qApi.AnnounceResultResult{}
+	body, err := httpPost(url, []byte(""))
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(body, &ret)
+	if err != nil || ret.TrxId == "" {
+		return nil, errors.New(string(body))
+	}
+	return &ret, nil
+}
+
 func newHTTPClient() (*http.Client, error) {
 	certPath := config.RumConfig.Quorum.ServerSSLCertificate
 
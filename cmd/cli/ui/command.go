@@ -21,6 +21,9 @@ const (
 	CMD_QUORUM_NEW_GROUP   string = "/group.create"
 	CMD_QUORUM_LEAVE_GROUP string = "/group.leave"
 	CMD_QUORUM_DEL_GROUP   string = "/group.delete"
+	CMD_QUORUM_ANNOUNCED   string = "/group.announced"
+	CMD_QUORUM_APPROVE     string = "/group.approve"
+	CMD_QUORUM_REJECT      string = "/group.reject"
 	CMD_CONFIG_RELOAD      string = "/config.reload"
 	CMD_CONFIG_SAVE        string = "/config.save"
 	CMD_MODE_BLOCKS        string = "/mode.blocks"
@@ -40,7 +43,7 @@ const (
 )
 
 func cmdInputInit() {
-	baseCommands := []string{CMD_QUORUM_CONNECT, CMD_QUORUM_JOIN, CMD_QUORUM_APPLY_TOKEN, CMD_QUORUM_SYNC_GROUP, CMD_QUORUM_NEW_GROUP, CMD_QUORUM_LEAVE_GROUP, CMD_QUORUM_DEL_GROUP, CMD_CONFIG_RELOAD, CMD_CONFIG_SAVE, CMD_MODE_BLOCKS, CMD_MODE_QUORUM, CMD_MODE_NETWORK}
+	baseCommands := []string{CMD_QUORUM_CONNECT, CMD_QUORUM_JOIN, CMD_QUORUM_APPLY_TOKEN, CMD_QUORUM_SYNC_GROUP, CMD_QUORUM_NEW_GROUP, CMD_QUORUM_LEAVE_GROUP, CMD_QUORUM_DEL_GROUP, CMD_QUORUM_ANNOUNCED, CMD_QUORUM_APPROVE, CMD_QUORUM_REJECT, CMD_CONFIG_RELOAD, CMD_CONFIG_SAVE, CMD_MODE_BLOCKS, CMD_MODE_QUORUM, CMD_MODE_NETWORK}
 	quorumCommands := []string{CMD_QUORUM_SEND, CMD_QUORUM_NICK}
 	blocksCommands := []string{CMD_BLOCKS_JMP, CMD_BLOCKS_GENDOT}
 	networkCommands := []string{CMD_NETWORK_PING}
@@ -132,6 +135,18 @@ func cmdInputInit() {
 				reset("")
 				QuorumDelGroupHandler()
 				return
+			} else if strings.HasPrefix(cmdStr, CMD_QUORUM_ANNOUNCED) {
+				reset("")
+				QuorumAnnouncedUsersHandler()
+				return
+			} else if strings.HasPrefix(cmdStr, CMD_QUORUM_APPROVE) {
+				reset("")
+				QuorumApproveUserHandler(cmdStr)
+				return
+			} else if strings.HasPrefix(cmdStr, CMD_QUORUM_REJECT) {
+				reset("")
+				QuorumRejectUserHandler(cmdStr)
+				return
 			} else if strings.HasPrefix(cmdStr, CMD_CONFIG_RELOAD) {
 				reset("")
 				config.Init()
@@ -43,6 +43,9 @@ var helpCells = strings.TrimSpace(
 		fmt.Sprintf("%s <group_name>\t Create a new group.\n", CMD_QUORUM_NEW_GROUP) +
 		fmt.Sprintf("%s\t Delete cuerrent group(you are owner).\n", CMD_QUORUM_DEL_GROUP) +
 		fmt.Sprintf("%s\t Leave cuerrent group.\n", CMD_QUORUM_LEAVE_GROUP) +
+		fmt.Sprintf("%s\t List announced users of cuerrent group.\n", CMD_QUORUM_ANNOUNCED) +
+		fmt.Sprintf("%s <pubkey>\t Approve an announced user(you are owner).\n", CMD_QUORUM_APPROVE) +
+		fmt.Sprintf("%s <pubkey>\t Reject an announced user(you are owner).\n", CMD_QUORUM_REJECT) +
 		fmt.Sprintf("%s \t Reload the config.\n", CMD_CONFIG_RELOAD) +
 		fmt.Sprintf("%s \t Save the config.\n", CMD_CONFIG_SAVE) +
 		fmt.Sprintf("%s \t Switch to blocks mode.\n", CMD_MODE_BLOCKS) +
@@ -215,6 +215,57 @@ func QuorumDelGroupHandler() {
 	}()
 }
 
+// CMD /group.announced handler
+func QuorumAnnouncedUsersHandler() {
+	if quorumData.GetCurrentGroup() == "" {
+		Error("No Group Selected", "Please select a group first.")
+		return
+	}
+	go goQuorumAnnouncedUsers(quorumData.GetCurrentGroup())
+}
+
+// CMD /group.approve handler
+func QuorumApproveUserHandler(cmd string) {
+	pubkey := strings.Replace(cmd, CMD_QUORUM_APPROVE, "", -1)
+	pubkey = strings.TrimSpace(pubkey)
+	if checkAnnounceResultPermission(pubkey) {
+		go goQuorumAnnounceResult(quorumData.GetCurrentGroup(), pubkey, true)
+	}
+}
+
+// CMD /group.reject handler
+func QuorumRejectUserHandler(cmd string) {
+	pubkey := strings.Replace(cmd, CMD_QUORUM_REJECT, "", -1)
+	pubkey = strings.TrimSpace(pubkey)
+	if checkAnnounceResultPermission(pubkey) {
+		go goQuorumAnnounceResult(quorumData.GetCurrentGroup(), pubkey, false)
+	}
+}
+
+func checkAnnounceResultPermission(pubkey string) bool {
+	if quorumData.GetCurrentGroup() == "" {
+		Error("No Group Selected", "Please select a group first.")
+		return false
+	}
+	if pubkey == "" {
+		Error("No User Selected", "Please input the pubkey of announced user.")
+		return false
+	}
+	curGroupOwner := ""
+	for _, group := range quorumData.GetGroups().GroupInfos {
+		if group.GroupId == quorumData.GetCurrentGroup() {
+			curGroupOwner = group.OwnerPubKey
+			break
+		}
+	}
+	myPubKey := quorumData.GetNodeInfo().NodePubKey
+	if myPubKey != curGroupOwner {
+		Error("No Permission", "Only the owner can approve or reject announced users.")
+		return false
+	}
+	return true
+}
+
 // CMD /group.sync handler
 func QuorumForceSyncGroupHandler() {
 	if quorumData.GetCurrentGroup() == "" {
@@ -906,6 +957,39 @@ func goQuorumDelGroup(gid string) {
 	}
 }
 
+func goQuorumAnnouncedUsers(gid string) {
+	users, err := api.AnnouncedUsers(gid)
+	if err != nil {
+		Error("Failed to get announced users", err.Error())
+		return
+	}
+	contentInfoView.Clear()
+	fmt.Fprintf(contentInfoView, "Announced Users: %d\n\n", len(users))
+	for _, user := range users {
+		fmt.Fprintf(contentInfoView, "Pubkey: %s\n", user.AnnouncedSignPubkey)
+		fmt.Fprintf(contentInfoView, "Result: %s\n", user.Result)
+		if user.Memo != "" {
+			fmt.Fprintf(contentInfoView, "Memo:   %s\n", user.Memo)
+		}
+		fmt.Fprintf(contentInfoView, "\n")
+	}
+	App.Draw()
+}
+
+func goQuorumAnnounceResult(gid string, pubkey string, approve bool) {
+	updAnnounceResult := api.RejectAnnouncedUser
+	if approve {
+		updAnnounceResult = api.ApproveAnnouncedUser
+	}
+	ret, err := updAnnounceResult(gid, pubkey)
+	if err != nil {
+		Error("Failed to update announced user", err.Error())
+	} else {
+		cmdInput.SetLabel(fmt.Sprintf("User %s: ", ret.AnnouncedSignPubkey))
+		cmdInput.SetText(ret.Result)
+	}
+}
+
 func goQuorumForceSyncGroup(gid string) {
 	_, err := api.ForceSyncGroup(gid)
 	if err != nil {
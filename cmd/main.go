@@ -1,10 +1,15 @@
 package main
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"flag"
 	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"path"
@@ -28,10 +33,12 @@ import (
 	"github.com/rumsystem/quorum/internal/pkg/nodectx"
 	"github.com/rumsystem/quorum/internal/pkg/options"
 	"github.com/rumsystem/quorum/internal/pkg/p2p"
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
 	"github.com/rumsystem/quorum/internal/pkg/storage"
 	"github.com/rumsystem/quorum/internal/pkg/utils"
+	"github.com/rumsystem/quorum/internal/pkg/webhook"
 	appapi "github.com/rumsystem/quorum/pkg/app/api"
-	_ "google.golang.org/protobuf/proto" //import for swaggo
+	"google.golang.org/protobuf/proto"
 
 	//_ "google.golang.org/protobuf/proto/reflect/protoreflect" //import for swaggo
 	_ "google.golang.org/protobuf/types/known/timestamppb" //import for swaggo
@@ -72,7 +79,7 @@ func createDb(path string) (*storage.DbMgr, error) {
 		return nil, err
 	}
 
-	manager := storage.DbMgr{&groupDb, &dataDb, nil, path}
+	manager := storage.DbMgr{GroupInfoDb: &groupDb, Db: &dataDb, Auth: nil, DataPath: path}
 	return &manager, nil
 }
 
@@ -241,7 +248,7 @@ func mainRet(config cli.Config) int {
 		if err != nil {
 			mainlog.Fatalf(err.Error())
 		}
-		dbManager.TryMigration(0) //TOFIX: pass the node data_ver
+		migrateDb(config, dbManager, nil)
 		nodectx.InitCtx(ctx, "", node, dbManager, "pubsub", GitCommit)
 		nodectx.GetNodeCtx().Keystore = ksi
 		nodectx.GetNodeCtx().PublicKey = keys.PubKey
@@ -274,23 +281,29 @@ func mainRet(config cli.Config) int {
 		if err != nil {
 			mainlog.Fatalf(err.Error())
 		}
-		dbManager.TryMigration(0) //TOFIX: pass the node data_ver
+		appdb, err := createAppDb(datapath)
+		if err != nil {
+			mainlog.Fatalf(err.Error())
+		}
+		checkLockError(err)
+		migrateDb(config, dbManager, appdb)
 		nodectx.InitCtx(ctx, "default", node, dbManager, "pubsub", GitCommit)
 		nodectx.GetNodeCtx().Keystore = ksi
 		nodectx.GetNodeCtx().PublicKey = keys.PubKey
 		nodectx.GetNodeCtx().PeerId = peerid
 		groupmgr := chain.InitGroupMgr(nodectx.GetDbMgr())
 
-		err = groupmgr.SyncAllGroup()
+		//start webhooks before sync, so trxs applied by sync are delivered
+		webhookmgr := webhook.InitWebhookMgr(ctx, nodectx.GetDbMgr(), nodectx.GetNodeCtx().Name)
+		err = webhookmgr.Start()
 		if err != nil {
 			mainlog.Fatalf(err.Error())
 		}
 
-		appdb, err := createAppDb(datapath)
+		err = groupmgr.SyncAllGroup()
 		if err != nil {
 			mainlog.Fatalf(err.Error())
 		}
-		checkLockError(err)
 
 		//run local http api service
 		h := &api.Handler{Node: node, NodeCtx: nodectx.GetNodeCtx(), Ctx: ctx, GitCommit: GitCommit}
@@ -371,6 +384,12 @@ func main() {
 		logging.SetLogLevel("user", "debug")
 		logging.SetLogLevel("groupmgr", "debug")
 		logging.SetLogLevel("trxmgr", "debug")
+		logging.SetLogLevel("admission", "debug")
+		logging.SetLogLevel("schema", "debug")
+		logging.SetLogLevel("pos", "debug")
+		logging.SetLogLevel("bft", "debug")
+		logging.SetLogLevel("snapshot", "debug")
+		logging.SetLogLevel("syncstream", "debug")
 	}
 
 	if *help {
@@ -441,6 +460,23 @@ func main() {
 
 		return
 	}
+
+	if flag.NArg() > 0 && flag.Arg(0) == "group" {
+		if err := groupCommand(config, flag.Args()[1:]); err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if flag.NArg() > 0 && flag.Arg(0) == "fsck" {
+		if err := fsckCommand(config, flag.Args()[1:]); err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	if err := utils.EnsureDir(config.DataDir); err != nil {
 		panic(err)
 	}
@@ -452,3 +488,189 @@ func main() {
 
 	os.Exit(mainRet(config))
 }
+
+//migrateDb runs the pending migrations of the dbs, and exits after showing them if dry run
+func migrateDb(config cli.Config, dbManager *storage.DbMgr, appdb *appdata.AppDb) {
+	opt := &storage.MigrationOptions{DryRun: config.MigrateDryRun, Backup: config.MigrateBackup}
+	ran, err := dbManager.Migrate(opt)
+	if err != nil {
+		mainlog.Fatalf(err.Error())
+	}
+	if appdb != nil {
+		appRan, err := storage.Migrate(appdb.DataPath, storage.APP_DB, appdb.Db, opt)
+		if err != nil {
+			mainlog.Fatalf(err.Error())
+		}
+		ran = append(ran, appRan...)
+	}
+	if config.MigrateDryRun {
+		fmt.Printf("%d pending db migrations\n", len(ran))
+		os.Exit(0)
+	}
+}
+
+//newLocalAPIClient returns the client and api root of the running node
+func newLocalAPIClient(config cli.Config) (*http.Client, string, error) {
+	host, port, err := net.SplitHostPort(config.APIListenAddresses)
+	if err != nil {
+		return nil, "", err
+	}
+	if host == "" || host == "0.0.0.0" {
+		host = "127.0.0.1"
+	}
+
+	client, err := utils.NewHTTPClient()
+	if err != nil {
+		return nil, "", err
+	}
+	//replaying a large group takes a while
+	client.Timeout = 0
+	return client, fmt.Sprintf("https://%s/api/v1", net.JoinHostPort(host, port)), nil
+}
+
+//fsckCommand checks and repairs the groups by the api of the running node, all groups are checked if no group id
+func fsckCommand(config cli.Config, args []string) error {
+	fs := flag.NewFlagSet("fsck", flag.ContinueOnError)
+	repair := fs.Bool("repair", false, "repair the groups with issues by replaying from the genesis block")
+	fs.Usage = func() {
+		fmt.Printf("Usage: %s [-apilisten <addr>] fsck [-repair] [group_id ...]\n", os.Args[0])
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	client, apiroot, err := newLocalAPIClient(config)
+	if err != nil {
+		return err
+	}
+	request := func(method string, url string, result interface{}) error {
+		req, err := http.NewRequest(method, url, nil)
+		if err != nil {
+			return err
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("%s %s failed: %s", method, url, body)
+		}
+		return json.Unmarshal(body, result)
+	}
+
+	groupIds := fs.Args()
+	if len(groupIds) == 0 {
+		groups := &api.GroupInfoList{}
+		if err := request(http.MethodGet, apiroot+"/groups", groups); err != nil {
+			return err
+		}
+		for _, group := range groups.GroupInfos {
+			groupIds = append(groupIds, group.GroupId)
+		}
+	}
+
+	failed := 0
+	for _, groupId := range groupIds {
+		report := &storage.FsckReport{}
+		if err := request(http.MethodGet, fmt.Sprintf("%s/group/%s/fsck", apiroot, groupId), report); err != nil {
+			return err
+		}
+		fmt.Printf("group %s: %d blocks, %d cached blocks, %d trxs, %d posts, %d issues\n", groupId, report.Blocks, report.CachedBlocks, report.Trxs, report.Posts, len(report.Issues))
+		for _, issue := range report.Issues {
+			fmt.Printf("  %s block:%s trx:%s %s\n", issue.Type, issue.BlockId, issue.TrxId, issue.Detail)
+		}
+		if len(report.Issues) == 0 {
+			continue
+		}
+		if !*repair {
+			failed++
+			continue
+		}
+
+		result := &api.RepairGroupResult{}
+		if err := request(http.MethodPost, fmt.Sprintf("%s/group/%s/fsck/repair", apiroot, groupId), result); err != nil {
+			return err
+		}
+		fmt.Printf("group %s is repaired from %s: %d blocks, height %d, %d issues\n", groupId, result.Archive, result.Blocks, result.HighestHeight, len(result.Report.Issues))
+		if len(result.Report.Issues) > 0 {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d groups have issues", failed)
+	}
+	return nil
+}
+
+//groupCommand exports or imports a group by the api of the running node
+func groupCommand(config cli.Config, args []string) error {
+	usage := fmt.Errorf("Usage: %s [-apilisten <addr>] group export <group_id> [file] | group import <file>", os.Args[0])
+	if len(args) < 2 {
+		return usage
+	}
+
+	client, apiroot, err := newLocalAPIClient(config)
+	if err != nil {
+		return err
+	}
+	apiurl := apiroot + "/group"
+
+	switch args[0] {
+	case "export":
+		filename := args[1] + ".qar"
+		if len(args) > 2 {
+			filename = args[2]
+		}
+		resp, err := client.Get(fmt.Sprintf("%s/%s/export", apiurl, args[1]))
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("export group failed: %s", body)
+		}
+		if err := ioutil.WriteFile(filename, body, 0600); err != nil {
+			return err
+		}
+		fmt.Printf("group %s is exported to %s\n", args[1], filename)
+	case "import":
+		archiveBytes, err := ioutil.ReadFile(args[1])
+		if err != nil {
+			return err
+		}
+		//verify before upload, so a broken archive is found without the node
+		archive := &quorumpb.GroupArchive{}
+		if err := proto.Unmarshal(archiveBytes, archive); err != nil {
+			return err
+		}
+		if err := chain.VerifyGroupArchive(archive); err != nil {
+			return err
+		}
+		resp, err := client.Post(apiurl+"/import", "application/octet-stream", bytes.NewReader(archiveBytes))
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("import group failed: %s", body)
+		}
+		fmt.Println(string(body))
+	default:
+		return usage
+	}
+	return nil
+}
@@ -83,12 +83,11 @@ func (h *Handler) Announce(c echo.Context) (err error) {
 
 		item.SignPubkey = group.Item.UserSignPubkey
 
-		if item.Type == quorumpb.AnnounceType_AS_USER {
-			item.EncryptPubkey, err = nodectx.GetNodeCtx().Keystore.GetEncodedPubkey(params.GroupId, localcrypto.Encrypt)
-			if err != nil {
-				output[ERROR_INFO] = err.Error()
-				return c.JSON(http.StatusBadRequest, output)
-			}
+		//producer announces encrypt pubkey too, cipher key of new key epoch is wrapped to it
+		item.EncryptPubkey, err = nodectx.GetNodeCtx().Keystore.GetEncodedPubkey(params.GroupId, localcrypto.Encrypt)
+		if err != nil {
+			output[ERROR_INFO] = err.Error()
+			return c.JSON(http.StatusBadRequest, output)
 		}
 
 		item.OwnerPubkey = ""
@@ -0,0 +1,107 @@
+package api
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/labstack/echo/v4"
+	chain "github.com/rumsystem/quorum/internal/pkg/chain"
+	"github.com/rumsystem/quorum/internal/pkg/nodectx"
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+)
+
+type AnnounceResultResult struct {
+	GroupId                string `json:"group_id" validate:"required"`
+	AnnouncedSignPubkey    string `json:"sign_pubkey" validate:"required"`
+	AnnouncedEncryptPubkey string `json:"encrypt_pubkey"`
+	Result                 string `json:"result" validate:"required,oneof=APPROVED REJECTED"`
+	OwnerPubkey            string `json:"owner_pubkey" validate:"required"`
+	Sign                   string `json:"sign" validate:"required"`
+	TrxId                  string `json:"trx_id" validate:"required"`
+}
+
+// @Tags User
+// @Summary ApproveAnnouncedUser
+// @Description Group owner approves an announced user, private POST is encrypted to approved users
+// @Produce json
+// @Param group_id path string true "Group Id"
+// @Param pubkey path string true "Announced user sign pubkey (url encoded)"
+// @Success 200 {object} AnnounceResultResult
+// @Router /api/v1/group/{group_id}/announced/users/{pubkey}/approve [post]
+func (h *Handler) ApproveAnnouncedUser(c echo.Context) (err error) {
+	return h.updAnnounceResult(c, quorumpb.ApproveType_APPROVED)
+}
+
+// @Tags User
+// @Summary RejectAnnouncedUser
+// @Description Group owner rejects an announced user
+// @Produce json
+// @Param group_id path string true "Group Id"
+// @Param pubkey path string true "Announced user sign pubkey (url encoded)"
+// @Success 200 {object} AnnounceResultResult
+// @Router /api/v1/group/{group_id}/announced/users/{pubkey}/reject [post]
+func (h *Handler) RejectAnnouncedUser(c echo.Context) (err error) {
+	return h.updAnnounceResult(c, quorumpb.ApproveType_REJECTED)
+}
+
+func (h *Handler) updAnnounceResult(c echo.Context, result quorumpb.ApproveType) (err error) {
+	output := make(map[string]string)
+	groupid := c.Param("group_id")
+	if groupid == "" {
+		output[ERROR_INFO] = "group_id can't be nil."
+		return c.JSON(http.StatusBadRequest, output)
+	}
+
+	//sign pubkey is base64 encoded, it should be url encoded in path
+	pubkey, err := url.PathUnescape(c.Param("pubkey"))
+	if err != nil || pubkey == "" {
+		output[ERROR_INFO] = "pubkey is invalid."
+		return c.JSON(http.StatusBadRequest, output)
+	}
+
+	groupmgr := chain.GetGroupMgr()
+	if group, ok := groupmgr.Groups[groupid]; !ok {
+		output[ERROR_INFO] = fmt.Sprintf("Group %s not exist", groupid)
+		return c.JSON(http.StatusBadRequest, output)
+	} else if group.Item.OwnerPubKey != group.Item.UserSignPubkey {
+		output[ERROR_INFO] = "Only group owner can approve or reject announced user"
+		return c.JSON(http.StatusBadRequest, output)
+	} else {
+		announced, err := group.GetAnnouncedUserByPubkey(pubkey)
+		if err != nil {
+			output[ERROR_INFO] = "User is not announced"
+			return c.JSON(http.StatusBadRequest, output)
+		}
+
+		item := &quorumpb.AnnounceItem{}
+		item.GroupId = announced.GroupId
+		item.SignPubkey = announced.SignPubkey
+		item.EncryptPubkey = announced.EncryptPubkey
+		item.AnnouncerSignature = announced.AnnouncerSignature
+		item.Type = announced.Type
+		item.Action = announced.Action
+		item.TimeStamp = announced.TimeStamp
+		item.Memo = announced.Memo
+		item.Result = result
+		item.OwnerPubkey = group.Item.OwnerPubKey
+
+		ks := nodectx.GetNodeCtx().Keystore
+		signature, err := ks.SignByKeyName(item.GroupId, chain.GetAnnounceResultHash(item))
+		if err != nil {
+			output[ERROR_INFO] = err.Error()
+			return c.JSON(http.StatusBadRequest, output)
+		}
+		item.OwnerSignature = hex.EncodeToString(signature)
+
+		trxId, err := group.UpdAnnounceResult(item)
+		if err != nil {
+			output[ERROR_INFO] = err.Error()
+			return c.JSON(http.StatusBadRequest, output)
+		}
+
+		announceResult := &AnnounceResultResult{GroupId: item.GroupId, AnnouncedSignPubkey: item.SignPubkey, AnnouncedEncryptPubkey: item.EncryptPubkey, Result: item.Result.String(), OwnerPubkey: item.OwnerPubkey, Sign: item.OwnerSignature, TrxId: trxId}
+		return c.JSON(http.StatusOK, announceResult)
+	}
+}
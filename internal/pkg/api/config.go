@@ -26,6 +26,11 @@ const (
 	Add      = "Add"
 	Update   = "Update"
 	Remove   = "Remove"
+	Delete   = "Delete"
+	Like     = "Like"
+	Dislike  = "Dislike"
+	React    = "EmojiReact"
+	Undo     = "Undo"
 	Group    = "Group"
 	User     = "User"
 	Auth     = "Auth"
@@ -66,11 +66,6 @@ func (h *Handler) CreateGroup() echo.HandlerFunc {
 			return c.JSON(http.StatusBadRequest, output)
 		}
 
-		if params.ConsensusType != "poa" {
-			output := "Other types of groups are not supported yet"
-			return c.JSON(http.StatusBadRequest, output)
-		}
-
 		groupid := guuid.New()
 
 		nodeoptions := options.GetNodeOptions()
@@ -148,7 +143,11 @@ func (h *Handler) CreateGroup() echo.HandlerFunc {
 		item.OwnerPubKey = p2pcrypto.ConfigEncodeKey(groupSignPubkey)
 		item.UserSignPubkey = item.OwnerPubKey
 		item.UserEncryptPubkey = groupEncryptPubkey
-		item.ConsenseType = quorumpb.GroupConsenseType_POA
+		if params.ConsensusType == "pos" {
+			item.ConsenseType = quorumpb.GroupConsenseType_POS
+		} else {
+			item.ConsenseType = quorumpb.GroupConsenseType_POA
+		}
 
 		if params.EncryptionType == "public" {
 			item.EncryptType = quorumpb.GroupEncryptType_PUBLIC
@@ -0,0 +1,155 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/labstack/echo/v4"
+	"github.com/rumsystem/quorum/internal/pkg/eventbus"
+)
+
+const EVENT_KEEPALIVE_INTERVAL time.Duration = 30 //30s
+
+var upgrader = websocket.Upgrader{}
+
+// @Tags Node
+// @Summary Events
+// @Description Stream events of blocks, trxs and group state, by WebSocket (upgrade request) or Server-Sent Events
+// @Produce text/event-stream
+// @Param group_id query []string false "group ids, all groups if empty"
+// @Param types query []string false "event types, all types if empty"
+// @Param last_event_id query int false "resume after the event id (or Last-Event-ID header)"
+// @Success 200 {object} eventbus.Event
+// @Router /api/v1/events [get]
+func (h *Handler) Events(c echo.Context) (err error) {
+	output := make(map[string]string)
+
+	filter := &eventbus.Filter{}
+	filter.GroupIds = getListQueryParam(c, "group_id")
+	for _, eventType := range getListQueryParam(c, "types") {
+		t := eventbus.EventType(strings.ToLower(eventType))
+		if !eventbus.IsValidEventType(t) {
+			output[ERROR_INFO] = fmt.Sprintf("invalid event type: %s", eventType)
+			return c.JSON(http.StatusBadRequest, output)
+		}
+		filter.Types = append(filter.Types, t)
+	}
+
+	lastEventId := c.QueryParam("last_event_id")
+	if lastEventId == "" {
+		lastEventId = c.Request().Header.Get("Last-Event-ID")
+	}
+	var lastId uint64
+	if lastEventId != "" {
+		if lastId, err = strconv.ParseUint(lastEventId, 10, 64); err != nil {
+			output[ERROR_INFO] = fmt.Sprintf("invalid last_event_id: %s", lastEventId)
+			return c.JSON(http.StatusBadRequest, output)
+		}
+	}
+
+	if websocket.IsWebSocketUpgrade(c.Request()) {
+		return streamEventsByWebSocket(c, filter, lastId)
+	}
+	return streamEventsBySSE(c, filter, lastId)
+}
+
+func streamEventsBySSE(c echo.Context, filter *eventbus.Filter, lastId uint64) error {
+	bus := eventbus.GetEventBus()
+	sub := bus.Subscribe(filter, lastId)
+	defer bus.Unsubscribe(sub)
+
+	resp := c.Response()
+	resp.Header().Set(echo.HeaderContentType, "text/event-stream")
+	resp.Header().Set("Cache-Control", "no-cache")
+	resp.Header().Set("Connection", "keep-alive")
+	resp.WriteHeader(http.StatusOK)
+	resp.Flush()
+
+	ticker := time.NewTicker(EVENT_KEEPALIVE_INTERVAL * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case event, ok := <-sub.C:
+			if !ok {
+				//dropped by event bus, client should reconnect with the last event id
+				return nil
+			}
+			data, err := json.Marshal(event)
+			if err != nil {
+				return err
+			}
+			if _, err := fmt.Fprintf(resp, "id: %d\nevent: %s\ndata: %s\n\n", event.Id, event.Type, data); err != nil {
+				return nil
+			}
+			resp.Flush()
+		case <-ticker.C:
+			if _, err := fmt.Fprintf(resp, ": keepalive\n\n"); err != nil {
+				return nil
+			}
+			resp.Flush()
+		case <-c.Request().Context().Done():
+			return nil
+		}
+	}
+}
+
+func streamEventsByWebSocket(c echo.Context, filter *eventbus.Filter, lastId uint64) error {
+	conn, err := upgrader.Upgrade(c.Response(), c.Request(), nil)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	bus := eventbus.GetEventBus()
+	sub := bus.Subscribe(filter, lastId)
+	defer bus.Unsubscribe(sub)
+
+	//read until the client closes the connection, messages from client are ignored
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(EVENT_KEEPALIVE_INTERVAL * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case event, ok := <-sub.C:
+			if !ok {
+				return nil
+			}
+			if err := conn.WriteJSON(event); err != nil {
+				return nil
+			}
+		case <-ticker.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(time.Second*5)); err != nil {
+				return nil
+			}
+		case <-closed:
+			return nil
+		}
+	}
+}
+
+//getListQueryParam returns values of a query param which can be repeated or separated by comma
+func getListQueryParam(c echo.Context, name string) []string {
+	var values []string
+	for _, param := range c.QueryParams()[name] {
+		for _, value := range strings.Split(param, ",") {
+			if value = strings.TrimSpace(value); value != "" {
+				values = append(values, value)
+			}
+		}
+	}
+	return values
+}
@@ -0,0 +1,139 @@
+package api
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	chain "github.com/rumsystem/quorum/internal/pkg/chain"
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+)
+
+type FileResult struct {
+	TrxId     string `json:"trx_id"`
+	Name      string `json:"name"`
+	MediaType string `json:"media_type"`
+	Size      int64  `json:"size"`
+	Sha256    string `json:"sha256"`
+	Chunks    int    `json:"chunks"`
+}
+
+// @Tags Groups
+// @Summary UploadFile
+// @Description Upload a file to the group. The file is split into encrypted chunks served to peers, and a FileManifest with the hashes of the chunks is posted to the group
+// @Accept multipart/form-data
+// @Produce json
+// @Param group_id path string true "Group Id"
+// @Param file formData file true "the file, 100Mb at most"
+// @Success 200 {object} FileResult
+// @Router /api/v1/group/{group_id}/files [post]
+func (h *Handler) UploadFile(c echo.Context) (err error) {
+	output := make(map[string]string)
+	groupid := c.Param("group_id")
+
+	groupmgr := chain.GetGroupMgr()
+	group, ok := groupmgr.Groups[groupid]
+	if !ok {
+		output[ERROR_INFO] = fmt.Sprintf("Group %s not exist", groupid)
+		return c.JSON(http.StatusBadRequest, output)
+	}
+	if !group.HasPermission(quorumpb.TrxType_POST) {
+		output[ERROR_INFO] = "Reader can not post to group"
+		return c.JSON(http.StatusBadRequest, output)
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		output[ERROR_INFO] = err.Error()
+		return c.JSON(http.StatusBadRequest, output)
+	}
+	if fileHeader.Size > int64(chain.MAX_FILE_SIZE) {
+		output[ERROR_INFO] = fmt.Sprintf("File size over %dMb", chain.MAX_FILE_SIZE/1024/1024)
+		return c.JSON(http.StatusBadRequest, output)
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		output[ERROR_INFO] = err.Error()
+		return c.JSON(http.StatusBadRequest, output)
+	}
+	defer file.Close()
+	data, err := io.ReadAll(file)
+	if err != nil {
+		output[ERROR_INFO] = err.Error()
+		return c.JSON(http.StatusBadRequest, output)
+	}
+
+	mediaType := fileHeader.Header.Get("Content-Type")
+	if mediaType == "" {
+		mediaType = http.DetectContentType(data)
+	}
+	manifest, err := chain.CreateFileManifest(group.Item, fileHeader.Filename, mediaType, data, h.NodeCtx.Name)
+	if err != nil {
+		output[ERROR_INFO] = err.Error()
+		return c.JSON(http.StatusBadRequest, output)
+	}
+
+	trxId, err := group.PostToGroup(manifest)
+	if err != nil {
+		//the manifest is not posted, no one is able to fetch the chunks
+		chain.RmFileChunks(group.Item, manifest, h.NodeCtx.Name)
+		output[ERROR_INFO] = err.Error()
+		return c.JSON(http.StatusBadRequest, output)
+	}
+	return c.JSON(http.StatusOK, &FileResult{TrxId: trxId, Name: manifest.Name, MediaType: manifest.MediaType, Size: manifest.Size, Sha256: manifest.Sha256, Chunks: len(manifest.Chunks)})
+}
+
+// @Tags Groups
+// @Summary DownloadFile
+// @Description Download the file posted by the trx, chunks not saved by this node are fetched from peers of the group, verified and cached
+// @Produce application/octet-stream
+// @Param group_id path string true "Group Id"
+// @Param trx_id path string true "Trx Id of the file"
+// @Success 200 {string} string "the file"
+// @Router /api/v1/group/{group_id}/files/{trx_id} [get]
+func (h *Handler) DownloadFile(c echo.Context) (err error) {
+	output := make(map[string]string)
+	groupid := c.Param("group_id")
+	trxid := c.Param("trx_id")
+
+	groupmgr := chain.GetGroupMgr()
+	group, ok := groupmgr.Groups[groupid]
+	if !ok {
+		output[ERROR_INFO] = fmt.Sprintf("Group %s not exist", groupid)
+		return c.JSON(http.StatusBadRequest, output)
+	}
+
+	trx, err := group.GetTrx(trxid)
+	if err != nil {
+		output[ERROR_INFO] = err.Error()
+		return c.JSON(http.StatusBadRequest, output)
+	}
+	if trx.GroupId != groupid {
+		output[ERROR_INFO] = "TRX_NOT_FOUND"
+		return c.JSON(http.StatusBadRequest, output)
+	}
+	manifest, err := chain.GetFileManifest(group.Item, trx, h.NodeCtx.Name)
+	if err != nil {
+		output[ERROR_INFO] = err.Error()
+		return c.JSON(http.StatusBadRequest, output)
+	}
+
+	if err := chain.FetchFile(c.Request().Context(), group.Item, manifest, h.NodeCtx.Name); err != nil {
+		output[ERROR_INFO] = err.Error()
+		return c.JSON(http.StatusInternalServerError, output)
+	}
+
+	mediaType := manifest.MediaType
+	if mediaType == "" {
+		mediaType = "application/octet-stream"
+	}
+	//the file is verified by FetchFile, chunks are written to the response one by one
+	resp := c.Response()
+	resp.Header().Set(echo.HeaderContentType, mediaType)
+	resp.Header().Set(echo.HeaderContentLength, strconv.FormatInt(manifest.Size, 10))
+	resp.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", manifest.Name))
+	resp.WriteHeader(http.StatusOK)
+	return chain.WriteFile(group.Item, manifest, resp, h.NodeCtx.Name)
+}
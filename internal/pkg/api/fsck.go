@@ -0,0 +1,69 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	chain "github.com/rumsystem/quorum/internal/pkg/chain"
+	"github.com/rumsystem/quorum/internal/pkg/storage"
+)
+
+type RepairGroupResult struct {
+	GroupId        string              `json:"group_id"`
+	Archive        string              `json:"archive"`
+	Blocks         int                 `json:"blocks"`
+	HighestHeight  int64               `json:"highest_height"`
+	HighestBlockId string              `json:"highest_block_id"`
+	Report         *storage.FsckReport `json:"report"`
+}
+
+// @Tags Groups
+// @Summary CheckGroup
+// @Description Check the storage consistency of the group, walk the blocks from the genesis block and report the orphaned or stale cached blocks, mismatched parent and sub blocks, wrong heights, missing trxs of blocks and posts without trxs
+// @Produce json
+// @Param group_id path string true "Group Id"
+// @Success 200 {object} storage.FsckReport
+// @Router /api/v1/group/{group_id}/fsck [get]
+func (h *Handler) CheckGroup(c echo.Context) (err error) {
+	output := make(map[string]string)
+	groupid := c.Param("group_id")
+
+	report, err := chain.GetGroupMgr().CheckGroup(groupid)
+	if err != nil {
+		output[ERROR_INFO] = err.Error()
+		return c.JSON(http.StatusBadRequest, output)
+	}
+	return c.JSON(http.StatusOK, report)
+}
+
+// @Tags Groups
+// @Summary RepairGroup
+// @Description Repair the group by replaying the valid blocks connected to the genesis block, the blocks are saved as a group archive in the data dir first, then the group data is removed and rebuilt, and the group starts sync
+// @Produce json
+// @Param group_id path string true "Group Id"
+// @Success 200 {object} RepairGroupResult
+// @Router /api/v1/group/{group_id}/fsck/repair [post]
+func (h *Handler) RepairGroup(c echo.Context) (err error) {
+	output := make(map[string]string)
+	groupid := c.Param("group_id")
+
+	groupmgr := chain.GetGroupMgr()
+	group, archivePath, err := groupmgr.RepairGroup(groupid)
+	if err != nil {
+		output[ERROR_INFO] = err.Error()
+		return c.JSON(http.StatusBadRequest, output)
+	}
+
+	report, err := groupmgr.CheckGroup(groupid)
+	if err != nil {
+		output[ERROR_INFO] = err.Error()
+		return c.JSON(http.StatusInternalServerError, output)
+	}
+
+	if err := group.StartSync(); err != nil {
+		c.Logger().Errorf("<%s> start sync after repair failed: %s", groupid, err)
+	}
+
+	result := &RepairGroupResult{GroupId: groupid, Archive: archivePath, Blocks: report.Blocks, HighestHeight: group.Item.HighestHeight, HighestBlockId: group.Item.HighestBlockId, Report: report}
+	return c.JSON(http.StatusOK, result)
+}
@@ -13,6 +13,10 @@ type AnnouncedUserListItem struct {
 	AnnouncedEncryptPubkey string
 	AnnouncerSign          string
 	Result                 string
+	OwnerPubkey            string
+	OwnerSign              string
+	TimeStamp              int64
+	Memo                   string
 }
 
 // @Tags User
@@ -47,6 +51,10 @@ func (h *Handler) GetAnnouncedGroupUsers(c echo.Context) (err error) {
 			item.AnnouncedEncryptPubkey = usr.EncryptPubkey
 			item.AnnouncerSign = usr.AnnouncerSignature
 			item.Result = usr.Result.String()
+			item.OwnerPubkey = usr.OwnerPubkey
+			item.OwnerSign = usr.OwnerSignature
+			item.TimeStamp = usr.TimeStamp
+			item.Memo = usr.Memo
 			usrResultList = append(usrResultList, item)
 		}
 
@@ -3,10 +3,12 @@ package api
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/labstack/echo/v4"
 	"github.com/rumsystem/quorum/internal/pkg/chain"
+	"github.com/rumsystem/quorum/internal/pkg/storage"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 
@@ -19,34 +21,66 @@ type GroupContentObjectItem struct {
 	Content   proto.Message
 	TypeUrl   string
 	TimeStamp int64
+	Edited    bool                  //Content is the latest version
+	Deleted   bool                  //deleted post has no Content
+	Profile   *quorumpb.ProfileItem `json:",omitempty"` //current profile of the publisher, set if profile=true
 }
 
+type GroupContentList struct {
+	Contents   []*GroupContentObjectItem `json:"contents"`
+	NextCursor string                    `json:"next_cursor"`
+}
+
+const DEFAULT_CONTENT_LIMIT int = 20
+const MAX_CONTENT_LIMIT int = 100
+
 // @Tags Groups
 // @Summary GetGroupCtn
-// @Description Get group content
+// @Description Get a page of group content, pass next_cursor of the response as cursor to get the next page. Edited post returns the latest content, deleted post returns no content
 // @Produce json
-// @Success 200 {object} []GroupContentObjectItem
-// @Router /v1/group/:group_id/content [get]
+// @Param group_id path string true "Group Id"
+// @Param cursor query string false "next_cursor returned by the last page"
+// @Param limit query int false "max contents in a page, default 20, max 100"
+// @Param from query int false "start timestamp (nanoseconds)"
+// @Param to query int false "end timestamp (nanoseconds)"
+// @Param senders query []string false "publisher pubkeys"
+// @Param type query string false "content type url, e.g. quorum.pb.Object"
+// @Param order query string false "asc (default) or desc"
+// @Param profile query bool false "true to embed the current profile of the publisher"
+// @Success 200 {object} GroupContentList
+// @Router /api/v1/group/{group_id}/content [get]
 func (h *Handler) GetGroupCtn(c echo.Context) (err error) {
 
 	output := make(map[string]string)
-	filter := strings.ToLower(c.QueryParam("filter"))
 	groupid := c.Param("group_id")
 	if groupid == "" {
 		output[ERROR_INFO] = "group_id can't be nil."
 		return c.JSON(http.StatusBadRequest, output)
 	}
 
+	query, err := getGroupCtnQuery(c)
+	if err != nil {
+		output[ERROR_INFO] = err.Error()
+		return c.JSON(http.StatusBadRequest, output)
+	}
+
 	groupmgr := chain.GetGroupMgr()
 	if group, ok := groupmgr.Groups[groupid]; ok {
-		ctnList, err := group.GetGroupCtn(filter)
+		ctnList, nextCursor, err := group.GetGroupCtn(query)
 		if err != nil {
 			output[ERROR_INFO] = err.Error()
 			return c.JSON(http.StatusBadRequest, output)
 		}
 
-		var ctnobjList []*GroupContentObjectItem
+		withProfile := c.QueryParam("profile") == "true"
+		profiles := make(map[string]*quorumpb.ProfileItem)
+
+		ctnobjList := []*GroupContentObjectItem{}
 		for _, ctn := range ctnList {
+			if ctn.Deleted {
+				ctnobjList = append(ctnobjList, &GroupContentObjectItem{TrxId: ctn.TrxId, Publisher: ctn.PublisherPubkey, TimeStamp: ctn.TimeStamp, Deleted: true})
+				continue
+			}
 			anyobj := &anypb.Any{}
 			err := proto.Unmarshal(ctn.Content, anyobj)
 			if err != nil {
@@ -67,14 +101,73 @@ func (h *Handler) GetGroupCtn(c echo.Context) (err error) {
 				typeurl = strings.Replace(anyobj.TypeUrl, "type.googleapis.com/", "", 1)
 			}
 			if err == nil {
-				ctnobjitem := &GroupContentObjectItem{TrxId: ctn.TrxId, Publisher: ctn.PublisherPubkey, Content: ctnobj, TimeStamp: ctn.TimeStamp, TypeUrl: typeurl}
+				ctnobjitem := &GroupContentObjectItem{TrxId: ctn.TrxId, Publisher: ctn.PublisherPubkey, Content: ctnobj, TimeStamp: ctn.TimeStamp, TypeUrl: typeurl, Edited: ctn.Edited}
 				ctnobjList = append(ctnobjList, ctnobjitem)
 			}
 		}
-		return c.JSON(http.StatusOK, ctnobjList)
+		if withProfile {
+			for _, item := range ctnobjList {
+				profile, ok := profiles[item.Publisher]
+				if !ok {
+					if profile, err = group.GetProfile(item.Publisher); err != nil {
+						c.Logger().Errorf("GetProfile %s Err: %s", item.Publisher, err)
+					}
+					profiles[item.Publisher] = profile
+				}
+				item.Profile = profile
+			}
+		}
+		return c.JSON(http.StatusOK, &GroupContentList{Contents: ctnobjList, NextCursor: nextCursor})
 	} else {
 		output[ERROR_INFO] = fmt.Sprintf("Group %s not exist", groupid)
 		return c.JSON(http.StatusBadRequest, output)
 	}
 
 }
+
+func getGroupCtnQuery(c echo.Context) (*storage.GrpCtntQuery, error) {
+	var err error
+	query := &storage.GrpCtntQuery{}
+	query.Cursor = c.QueryParam("cursor")
+	query.TypeUrl = c.QueryParam("type")
+
+	query.Limit = DEFAULT_CONTENT_LIMIT
+	if limit := c.QueryParam("limit"); limit != "" {
+		if query.Limit, err = strconv.Atoi(limit); err != nil || query.Limit <= 0 || query.Limit > MAX_CONTENT_LIMIT {
+			return nil, fmt.Errorf("limit should be 1-%d", MAX_CONTENT_LIMIT)
+		}
+	}
+
+	if from := c.QueryParam("from"); from != "" {
+		if query.From, err = strconv.ParseInt(from, 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid from: %s", from)
+		}
+	}
+	if to := c.QueryParam("to"); to != "" {
+		if query.To, err = strconv.ParseInt(to, 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid to: %s", to)
+		}
+	}
+	if query.From > 0 && query.To > 0 && query.From > query.To {
+		return nil, fmt.Errorf("from should not be later than to")
+	}
+
+	//senders can be repeated or separated by comma
+	for _, senders := range c.QueryParams()["senders"] {
+		for _, sender := range strings.Split(senders, ",") {
+			if sender = strings.TrimSpace(sender); sender != "" {
+				query.Senders = append(query.Senders, sender)
+			}
+		}
+	}
+
+	switch strings.ToLower(c.QueryParam("order")) {
+	case "", "asc":
+		query.Reverse = false
+	case "desc":
+		query.Reverse = true
+	default:
+		return nil, fmt.Errorf("order should be asc or desc")
+	}
+	return query, nil
+}
@@ -11,6 +11,7 @@ import (
 type ProducerListItem struct {
 	ProducerPubkey string
 	OwnerPubkey    string
+	SignerPubkey   string
 	OwnerSign      string
 	TimeStamp      int64
 	BlockProduced  int64
@@ -47,6 +48,11 @@ func (h *Handler) GetGroupProducers(c echo.Context) (err error) {
 			item = &ProducerListItem{}
 			item.ProducerPubkey = prd.ProducerPubkey
 			item.OwnerPubkey = prd.GroupOwnerPubkey
+			//producer registered by admin is signed by admin
+			item.SignerPubkey = prd.SignerPubkey
+			if item.SignerPubkey == "" {
+				item.SignerPubkey = prd.GroupOwnerPubkey
+			}
 			item.OwnerSign = prd.GroupOwnerSign
 			item.TimeStamp = prd.TimeStamp
 			item.BlockProduced = prd.BlockProduced
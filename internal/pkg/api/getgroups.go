@@ -16,6 +16,7 @@ type groupInfo struct {
 	ConsensusType  string `json:"consensus_type" validate:"required"`
 	EncryptionType string `json:"encryption_type" validate:"required"`
 	CipherKey      string `json:"cipher_key" validate:"required"`
+	KeyEpoch       int64  `json:"key_epoch"`
 	AppKey         string `json:"app_key" validate:"required"`
 	LastUpdated    int64  `json:"last_updated" validate:"required"`
 	HighestHeight  int64  `json:"highest_height" validate:"required"`
@@ -57,21 +58,13 @@ func (h *Handler) GetGroups(c echo.Context) (err error) {
 		group.ConsensusType = value.Item.ConsenseType.String()
 		group.EncryptionType = value.Item.EncryptType.String()
 		group.CipherKey = value.Item.CipherKey
+		group.KeyEpoch = value.Item.KeyEpoch
 		group.AppKey = value.Item.AppKey
 		group.LastUpdated = value.Item.LastUpdate
 		group.HighestHeight = value.Item.HighestHeight
 		group.HighestBlockId = value.Item.HighestBlockId
 
-		switch value.ChainCtx.Syncer.Status {
-		case chain.SYNCING_BACKWARD:
-			group.GroupStatus = "SYNCING"
-		case chain.SYNCING_FORWARD:
-			group.GroupStatus = "SYNCING"
-		case chain.SYNC_FAILED:
-			group.GroupStatus = "SYNC_FAILED"
-		case chain.IDLE:
-			group.GroupStatus = "IDLE"
-		}
+		group.GroupStatus = chain.GetSyncerStatusName(value.ChainCtx.Syncer.Status)
 		groups = append(groups, group)
 	}
 
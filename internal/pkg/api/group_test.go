@@ -233,10 +233,14 @@ func getGroupContent(api string, groupID string) ([]GroupContentItem, error) {
 		return nil, err
 	}
 
-	var result []GroupContentItem
-	if err := json.Unmarshal(resp, &result); err != nil {
+	var contentList struct {
+		Contents   []GroupContentItem `json:"contents"`
+		NextCursor string             `json:"next_cursor"`
+	}
+	if err := json.Unmarshal(resp, &contentList); err != nil {
 		return nil, err
 	}
+	result := contentList.Contents
 
 	for _, item := range result {
 		validate := validator.New()
@@ -0,0 +1,167 @@
+package api
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	p2pcrypto "github.com/libp2p/go-libp2p-core/crypto"
+	chain "github.com/rumsystem/quorum/internal/pkg/chain"
+	localcrypto "github.com/rumsystem/quorum/internal/pkg/crypto"
+	"github.com/rumsystem/quorum/internal/pkg/nodectx"
+	"github.com/rumsystem/quorum/internal/pkg/options"
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+type ImportGroupResult struct {
+	GroupId        string `json:"group_id"`
+	GroupName      string `json:"group_name"`
+	UserPubkey     string `json:"user_pubkey"`
+	Blocks         int    `json:"blocks"`
+	HighestHeight  int64  `json:"highest_height"`
+	HighestBlockId string `json:"highest_block_id"`
+}
+
+// @Tags Groups
+// @Summary ExportGroup
+// @Description Export the group archive, includes the genesis block, all blocks and the group item without the keys of this node. The archive includes the cipher key of the group, keep it as safe as the group seed
+// @Produce application/octet-stream
+// @Param group_id path string true "Group Id"
+// @Success 200 {string} string "the protobuf encoded GroupArchive"
+// @Router /api/v1/group/{group_id}/export [get]
+func (h *Handler) ExportGroup(c echo.Context) (err error) {
+	output := make(map[string]string)
+	groupid := c.Param("group_id")
+
+	groupmgr := chain.GetGroupMgr()
+	group, ok := groupmgr.Groups[groupid]
+	if !ok {
+		output[ERROR_INFO] = fmt.Sprintf("Group %s not exist", groupid)
+		return c.JSON(http.StatusBadRequest, output)
+	}
+
+	archive, err := chain.ExportGroup(group.Item)
+	if err != nil {
+		output[ERROR_INFO] = err.Error()
+		return c.JSON(http.StatusInternalServerError, output)
+	}
+	archiveBytes, err := proto.Marshal(archive)
+	if err != nil {
+		output[ERROR_INFO] = err.Error()
+		return c.JSON(http.StatusInternalServerError, output)
+	}
+
+	c.Response().Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s.qar", groupid))
+	return c.Blob(http.StatusOK, "application/octet-stream", archiveBytes)
+}
+
+// @Tags Groups
+// @Summary ImportGroup
+// @Description Import a group from the archive exported by /api/v1/group/{group_id}/export. Every block is verified and all trxs are replayed before the group is added, then the group starts sync
+// @Accept application/octet-stream
+// @Produce json
+// @Param data body string true "the protobuf encoded GroupArchive"
+// @Success 200 {object} ImportGroupResult
+// @Router /api/v1/group/import [post]
+func (h *Handler) ImportGroup(c echo.Context) (err error) {
+	output := make(map[string]string)
+	archiveBytes, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		output[ERROR_INFO] = err.Error()
+		return c.JSON(http.StatusBadRequest, output)
+	}
+
+	archive := &quorumpb.GroupArchive{}
+	if err := proto.Unmarshal(archiveBytes, archive); err != nil {
+		output[ERROR_INFO] = "unmarshal archive failed with msg:" + err.Error()
+		return c.JSON(http.StatusBadRequest, output)
+	}
+	if err := chain.VerifyGroupArchive(archive); err != nil {
+		output[ERROR_INFO] = err.Error()
+		return c.JSON(http.StatusBadRequest, output)
+	}
+
+	groupmgr := chain.GetGroupMgr()
+	if _, ok := groupmgr.Groups[archive.GroupItem.GroupId]; ok {
+		output[ERROR_INFO] = "GROUP_ALREADY_EXIST"
+		return c.JSON(http.StatusBadRequest, output)
+	}
+
+	item := proto.Clone(archive.GroupItem).(*quorumpb.GroupItem)
+	item.UserSignPubkey, item.UserEncryptPubkey, err = getGroupUserKeys(item.GroupId)
+	if err != nil {
+		output[ERROR_INFO] = err.Error()
+		return c.JSON(http.StatusBadRequest, output)
+	}
+	item.HighestBlockId = item.GenesisBlock.BlockId
+	item.HighestHeight = 0
+	item.LastUpdate = time.Now().UnixNano()
+
+	group, err := groupmgr.ImportGroup(archive, item)
+	if err != nil {
+		output[ERROR_INFO] = err.Error()
+		return c.JSON(http.StatusBadRequest, output)
+	}
+
+	//the group is imported even if sync failed, it can be started by /api/v1/group/{group_id}/startsync
+	if err := group.StartSync(); err != nil {
+		c.Logger().Errorf("<%s> start sync after import failed: %s", group.Item.GroupId, err)
+	}
+
+	result := &ImportGroupResult{GroupId: group.Item.GroupId, GroupName: group.Item.GroupName, UserPubkey: group.Item.UserSignPubkey, Blocks: len(archive.Blocks), HighestHeight: group.Item.HighestHeight, HighestBlockId: group.Item.HighestBlockId}
+	return c.JSON(http.StatusOK, result)
+}
+
+//getGroupUserKeys returns the sign and encrypt pubkeys of this node for the group, the keys are created if not exist
+func getGroupUserKeys(groupid string) (string, string, error) {
+	ks := nodectx.GetNodeCtx().Keystore
+	dirks, ok := ks.(*localcrypto.DirKeyStore)
+	if !ok {
+		return "", "", fmt.Errorf("unknown keystore type  %v:", ks)
+	}
+
+	hexkey, err := dirks.GetEncodedPubkey(groupid, localcrypto.Sign)
+	if err != nil {
+		if !strings.HasPrefix(err.Error(), "key not exist ") {
+			return "", "", err
+		}
+		newsignaddr, err := dirks.NewKeyWithDefaultPassword(groupid, localcrypto.Sign)
+		if err != nil {
+			return "", "", fmt.Errorf("create new group key err: %s", err.Error())
+		}
+		if err := options.GetNodeOptions().SetSignKeyMap(groupid, newsignaddr); err != nil {
+			return "", "", fmt.Errorf("save key map %s err: %s", newsignaddr, err.Error())
+		}
+		if hexkey, err = dirks.GetEncodedPubkey(groupid, localcrypto.Sign); err != nil {
+			return "", "", err
+		}
+	}
+	pubkeybytes, err := hex.DecodeString(hexkey)
+	if err != nil {
+		return "", "", err
+	}
+	p2ppubkey, err := p2pcrypto.UnmarshalSecp256k1PublicKey(pubkeybytes)
+	if err != nil {
+		return "", "", err
+	}
+	signPubkey, err := p2pcrypto.MarshalPublicKey(p2ppubkey)
+	if err != nil {
+		return "", "", fmt.Errorf("group key can't be decoded, err: %s", err.Error())
+	}
+
+	encryptPubkey, err := dirks.GetEncodedPubkey(groupid, localcrypto.Encrypt)
+	if err != nil {
+		if !strings.HasPrefix(err.Error(), "key not exist ") {
+			return "", "", err
+		}
+		if encryptPubkey, err = dirks.NewKeyWithDefaultPassword(groupid, localcrypto.Encrypt); err != nil {
+			return "", "", fmt.Errorf("Create key pair failed with msg: %s", err.Error())
+		}
+	}
+	return p2pcrypto.ConfigEncodeKey(signPubkey), encryptPubkey, nil
+}
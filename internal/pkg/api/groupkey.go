@@ -0,0 +1,139 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	chain "github.com/rumsystem/quorum/internal/pkg/chain"
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+)
+
+type GrpKeyParam struct {
+	GroupId     string   `from:"group_id"     json:"group_id"     validate:"required"`
+	RemoveUsers []string `from:"remove_users" json:"remove_users"`
+	Memo        string   `from:"memo"         json:"memo"`
+}
+
+type GrpKeyResult struct {
+	GroupId      string   `json:"group_id" validate:"required"`
+	KeyEpoch     int64    `json:"key_epoch"`
+	Members      []string `json:"members"`
+	RemovedUsers []string `json:"removed_users"`
+	OwnerPubkey  string   `json:"owner_pubkey" validate:"required"`
+	TrxId        string   `json:"trx_id" validate:"required"`
+	Memo         string   `json:"memo"`
+}
+
+type GroupKeyListItem struct {
+	KeyEpoch     int64
+	Members      []string
+	RemovedUsers []string
+	OwnerPubkey  string
+	TimeStamp    int64
+	Memo         string
+}
+
+// @Tags Management
+// @Summary GroupKey
+// @Description rotate the cipher key of a private group, the new key is wrapped to remaining members only
+// @Accept json
+// @Produce json
+// @Param data body GrpKeyParam true "GrpKeyParam"
+// @Success 200 {object} GrpKeyResult
+// @Router /api/v1/group/key [post]
+func (h *Handler) GroupKey(c echo.Context) (err error) {
+	output := make(map[string]string)
+	validate := validator.New()
+	params := new(GrpKeyParam)
+
+	if err = c.Bind(params); err != nil {
+		output[ERROR_INFO] = err.Error()
+		return c.JSON(http.StatusBadRequest, output)
+	}
+
+	if err = validate.Struct(params); err != nil {
+		output[ERROR_INFO] = err.Error()
+		return c.JSON(http.StatusBadRequest, output)
+	}
+
+	groupmgr := chain.GetGroupMgr()
+	if group, ok := groupmgr.Groups[params.GroupId]; !ok {
+		output[ERROR_INFO] = "Can not find group"
+		return c.JSON(http.StatusBadRequest, output)
+	} else if group.Item.OwnerPubKey != group.Item.UserSignPubkey {
+		output[ERROR_INFO] = "Only group owner can rotate group key"
+		return c.JSON(http.StatusBadRequest, output)
+	} else if group.Item.EncryptType != quorumpb.GroupEncryptType_PRIVATE {
+		output[ERROR_INFO] = "Group key rotation is only supported by private group"
+		return c.JSON(http.StatusBadRequest, output)
+	} else {
+		item, err := group.CreateGroupKeyItem(params.RemoveUsers, params.Memo)
+		if err != nil {
+			output[ERROR_INFO] = err.Error()
+			return c.JSON(http.StatusBadRequest, output)
+		}
+
+		trxId, err := group.UpdGroupKey(item)
+		if err != nil {
+			output[ERROR_INFO] = err.Error()
+			return c.JSON(http.StatusBadRequest, output)
+		}
+
+		groupKeyResult := &GrpKeyResult{GroupId: item.GroupId, KeyEpoch: item.Epoch, Members: getKeyMembers(item), RemovedUsers: item.RemovedUsers, OwnerPubkey: item.OwnerPubkey, TrxId: trxId, Memo: item.Memo}
+		return c.JSON(http.StatusOK, groupKeyResult)
+	}
+}
+
+// @Tags Management
+// @Summary GetGroupKeys
+// @Description Get key epochs of a private group
+// @Produce json
+// @Param group_id path string  true "Group Id"
+// @Success 200 {array} GroupKeyListItem
+// @Router /api/v1/group/{group_id}/keys [get]
+func (h *Handler) GetGroupKeys(c echo.Context) (err error) {
+	output := make(map[string]string)
+	groupid := c.Param("group_id")
+
+	if groupid == "" {
+		output[ERROR_INFO] = "group_id can't be nil."
+		return c.JSON(http.StatusBadRequest, output)
+	}
+
+	groupmgr := chain.GetGroupMgr()
+	if group, ok := groupmgr.Groups[groupid]; ok {
+		keyList, err := group.GetGroupKeys()
+		if err != nil {
+			output[ERROR_INFO] = err.Error()
+			return c.JSON(http.StatusBadRequest, output)
+		}
+
+		keyResultList := []*GroupKeyListItem{}
+		for _, key := range keyList {
+			var item *GroupKeyListItem
+			item = &GroupKeyListItem{}
+			item.KeyEpoch = key.Epoch
+			item.Members = getKeyMembers(key)
+			item.RemovedUsers = key.RemovedUsers
+			item.OwnerPubkey = key.OwnerPubkey
+			item.TimeStamp = key.TimeStamp
+			item.Memo = key.Memo
+			keyResultList = append(keyResultList, item)
+		}
+
+		return c.JSON(http.StatusOK, keyResultList)
+	} else {
+		output[ERROR_INFO] = fmt.Sprintf("Group %s not exist", groupid)
+		return c.JSON(http.StatusBadRequest, output)
+	}
+}
+
+func getKeyMembers(item *quorumpb.GroupKeyItem) []string {
+	members := []string{}
+	for _, key := range item.Keys {
+		members = append(members, key.SignPubkey)
+	}
+	return members
+}
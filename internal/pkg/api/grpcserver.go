@@ -0,0 +1,404 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+
+	logging "github.com/ipfs/go-log/v2"
+	"github.com/labstack/echo/v4"
+	"github.com/rumsystem/quorum/internal/pkg/chain"
+	"github.com/rumsystem/quorum/internal/pkg/eventbus"
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+	appapi "github.com/rumsystem/quorum/pkg/app/api"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var grpc_log = logging.Logger("grpc")
+
+//grpcServer serves QuorumApi by forwarding the rpc calls to the REST handlers registered on echo,
+//so the gRPC api shares the routes, the JWT auth and the logic of the REST api
+type grpcServer struct {
+	quorumpb.UnimplementedQuorumApiServer
+	e       *echo.Echo
+	jwtAuth echo.MiddlewareFunc
+}
+
+//StartGrpcServer runs the gRPC api alongside the echo server, with the same TLS cert
+func StartGrpcServer(listenAddr string, e *echo.Echo, jwtAuth echo.MiddlewareFunc, certPath string, keyPath string) error {
+	creds, err := credentials.NewServerTLSFromFile(certPath, keyPath)
+	if err != nil {
+		return err
+	}
+	lis, err := net.Listen("tcp", listenAddr)
+	if err != nil {
+		return err
+	}
+
+	server := grpc.NewServer(grpc.Creds(creds))
+	quorumpb.RegisterQuorumApiServer(server, &grpcServer{e: e, jwtAuth: jwtAuth})
+	grpc_log.Infof("gRPC api listen on %s", listenAddr)
+	return server.Serve(lis)
+}
+
+//newGrpcRequest returns a http request with the auth token from grpc metadata and the address of the grpc
+//peer, requests from loopback address skip the JWT auth as the REST api does
+func newGrpcRequest(ctx context.Context, method string, path string, body []byte) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Host = "grpc"
+	if p, ok := peer.FromContext(ctx); ok {
+		req.RemoteAddr = p.Addr.String()
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if auth := md.Get("authorization"); len(auth) > 0 {
+			req.Header.Set(echo.HeaderAuthorization, auth[0])
+		}
+	}
+	return req, nil
+}
+
+//forward calls the REST api and unmarshals the json response to result, the response of
+//a list api is unmarshaled to the listField of result
+func (s *grpcServer) forward(ctx context.Context, method string, path string, body []byte, result proto.Message, listField string) error {
+	req, err := newGrpcRequest(ctx, method, path, body)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	rec := httptest.NewRecorder()
+	s.e.ServeHTTP(rec, req)
+
+	resp := rec.Body.Bytes()
+	if rec.Code != http.StatusOK {
+		return grpcError(rec.Code, resp)
+	}
+	if listField != "" {
+		resp = []byte(fmt.Sprintf(`{"%s":%s}`, listField, resp))
+	}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(resp, result); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+//forwardJSON posts params to the REST api as the json body, the json fields of generated pb structs
+//are the same as the REST api
+func (s *grpcServer) forwardJSON(ctx context.Context, path string, params interface{}, result proto.Message) error {
+	body, err := json.Marshal(params)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return s.forward(ctx, http.MethodPost, path, body, result, "")
+}
+
+//authorize runs the JWT and scope middlewares of echo for the rpc which is not forwarded to REST api
+func (s *grpcServer) authorize(ctx context.Context, scope string) error {
+	req, err := newGrpcRequest(ctx, http.MethodGet, "/api/v1/events", nil)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	c := s.e.NewContext(req, httptest.NewRecorder())
+	if err := s.jwtAuth(appapi.ScopeAuth(scope)(func(echo.Context) error { return nil }))(c); err != nil {
+		if he, ok := err.(*echo.HTTPError); ok {
+			if he.Code == http.StatusForbidden {
+				return status.Error(codes.PermissionDenied, fmt.Sprint(he.Message))
+			}
+			return status.Error(codes.Unauthenticated, fmt.Sprint(he.Message))
+		}
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+	return nil
+}
+
+func grpcError(httpCode int, resp []byte) error {
+	msg := string(resp)
+	output := make(map[string]interface{})
+	if err := json.Unmarshal(resp, &output); err == nil {
+		if info, ok := output[ERROR_INFO]; ok {
+			msg = fmt.Sprint(info)
+		} else if info, ok := output["message"]; ok {
+			msg = fmt.Sprint(info)
+		}
+	}
+	return status.Error(grpcCode(httpCode), msg)
+}
+
+func grpcCode(httpCode int) codes.Code {
+	switch httpCode {
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound, http.StatusMethodNotAllowed:
+		return codes.Unimplemented
+	default:
+		return codes.Internal
+	}
+}
+
+func groupPath(format string, groupId string, args ...string) string {
+	params := []interface{}{url.PathEscape(groupId)}
+	for _, arg := range args {
+		params = append(params, url.PathEscape(arg))
+	}
+	return fmt.Sprintf(format, params...)
+}
+
+//groups
+
+func (s *grpcServer) CreateGroup(ctx context.Context, params *quorumpb.CreateGroupParam) (*quorumpb.CreateGroupResult, error) {
+	result := &quorumpb.CreateGroupResult{}
+	return result, s.forwardJSON(ctx, "/api/v1/group", params, result)
+}
+
+func (s *grpcServer) JoinGroup(ctx context.Context, params *quorumpb.JoinGroupParam) (*quorumpb.JoinGroupResult, error) {
+	result := &quorumpb.JoinGroupResult{}
+	return result, s.forwardJSON(ctx, "/api/v1/group/join", params, result)
+}
+
+func (s *grpcServer) LeaveGroup(ctx context.Context, params *quorumpb.LeaveGroupParam) (*quorumpb.LeaveGroupResult, error) {
+	result := &quorumpb.LeaveGroupResult{}
+	return result, s.forwardJSON(ctx, "/api/v1/group/leave", params, result)
+}
+
+func (s *grpcServer) ClearGroupData(ctx context.Context, params *quorumpb.ClearGroupDataParam) (*quorumpb.ClearGroupDataResult, error) {
+	result := &quorumpb.ClearGroupDataResult{}
+	return result, s.forwardJSON(ctx, "/api/v1/group/clear", params, result)
+}
+
+func (s *grpcServer) GetGroups(ctx context.Context, params *emptypb.Empty) (*quorumpb.GroupInfoList, error) {
+	result := &quorumpb.GroupInfoList{}
+	return result, s.forward(ctx, http.MethodGet, "/api/v1/groups", nil, result, "")
+}
+
+func (s *grpcServer) StartSync(ctx context.Context, params *quorumpb.GroupParam) (*quorumpb.StartSyncResult, error) {
+	result := &quorumpb.StartSyncResult{}
+	return result, s.forward(ctx, http.MethodPost, groupPath("/api/v1/group/%s/startsync", params.GroupId), nil, result, "")
+}
+
+//content
+
+func (s *grpcServer) PostToGroup(ctx context.Context, params *quorumpb.Activity) (*quorumpb.TrxResult, error) {
+	result := &quorumpb.TrxResult{}
+	body, err := protojson.Marshal(params)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return result, s.forward(ctx, http.MethodPost, "/api/v1/group/content", body, result, "")
+}
+
+func (s *grpcServer) UpdateProfile(ctx context.Context, params *quorumpb.Activity) (*quorumpb.TrxResult, error) {
+	result := &quorumpb.TrxResult{}
+	body, err := protojson.Marshal(params)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return result, s.forward(ctx, http.MethodPost, "/api/v1/group/profile", body, result, "")
+}
+
+func (s *grpcServer) GetGroupContent(ctx context.Context, params *quorumpb.GroupContentParam) (*quorumpb.GroupContentList, error) {
+	query := url.Values{}
+	if params.Cursor != "" {
+		query.Set("cursor", params.Cursor)
+	}
+	if params.Limit > 0 {
+		query.Set("limit", fmt.Sprint(params.Limit))
+	}
+	if params.From > 0 {
+		query.Set("from", fmt.Sprint(params.From))
+	}
+	if params.To > 0 {
+		query.Set("to", fmt.Sprint(params.To))
+	}
+	for _, sender := range params.Senders {
+		query.Add("senders", sender)
+	}
+	if params.Type != "" {
+		query.Set("type", params.Type)
+	}
+	if params.Order != "" {
+		query.Set("order", params.Order)
+	}
+
+	result := &quorumpb.GroupContentList{}
+	path := groupPath("/api/v1/group/%s/content", params.GroupId) + "?" + query.Encode()
+	return result, s.forward(ctx, http.MethodGet, path, nil, result, "")
+}
+
+//StreamGroupContent sends the POSTs added to group from now on
+func (s *grpcServer) StreamGroupContent(params *quorumpb.GroupParam, stream quorumpb.QuorumApi_StreamGroupContentServer) error {
+	ctx := stream.Context()
+	if err := s.authorize(ctx, appapi.SCOPE_READ); err != nil {
+		return err
+	}
+	if _, ok := chain.GetGroupMgr().Groups[params.GroupId]; !ok {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("Group %s not exist", params.GroupId))
+	}
+
+	bus := eventbus.GetEventBus()
+	filter := &eventbus.Filter{GroupIds: []string{params.GroupId}, Types: []eventbus.EventType{eventbus.POST_ADDED}}
+	var lastId uint64
+	for {
+		sub := bus.Subscribe(filter, lastId)
+		for dropped := false; !dropped; {
+			select {
+			case event, ok := <-sub.C:
+				if !ok {
+					//dropped by the event bus, resubscribe from the last event
+					dropped = true
+					break
+				}
+				if event.Type == eventbus.EVENTS_LOST {
+					grpc_log.Warningf("<%s> contents added after event <%d> are lost", params.GroupId, event.Id)
+					continue
+				}
+				lastId = event.Id
+
+				item, err := s.getGroupContentItem(ctx, event)
+				if err != nil {
+					bus.Unsubscribe(sub)
+					return err
+				}
+				if item == nil {
+					continue
+				}
+				if err := stream.Send(item); err != nil {
+					bus.Unsubscribe(sub)
+					return err
+				}
+			case <-ctx.Done():
+				bus.Unsubscribe(sub)
+				return nil
+			}
+		}
+	}
+}
+
+//getGroupContentItem gets the content of POST_ADDED event by the timestamp and sender of the trx
+func (s *grpcServer) getGroupContentItem(ctx context.Context, event *eventbus.Event) (*quorumpb.GroupContentObjectItem, error) {
+	ctnList, err := s.GetGroupContent(ctx, &quorumpb.GroupContentParam{GroupId: event.GroupId, From: event.TimeStamp, To: event.TimeStamp, Senders: []string{event.Sender}, Limit: int32(MAX_CONTENT_LIMIT)})
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range ctnList.Contents {
+		if item.TrxId == event.TrxId {
+			return item, nil
+		}
+	}
+	return nil, nil
+}
+
+//blocks and trxs
+
+func (s *grpcServer) GetBlock(ctx context.Context, params *quorumpb.BlockParam) (*quorumpb.Block, error) {
+	result := &quorumpb.Block{}
+	return result, s.forward(ctx, http.MethodGet, groupPath("/api/v1/block/%s/%s", params.GroupId, params.BlockId), nil, result, "")
+}
+
+func (s *grpcServer) GetTrx(ctx context.Context, params *quorumpb.TrxParam) (*quorumpb.Trx, error) {
+	result := &quorumpb.Trx{}
+	return result, s.forward(ctx, http.MethodGet, groupPath("/api/v1/trx/%s/%s", params.GroupId, params.TrxId), nil, result, "")
+}
+
+//producers
+
+func (s *grpcServer) UpdateProducer(ctx context.Context, params *quorumpb.GrpProducerParam) (*quorumpb.GrpProducerResult, error) {
+	result := &quorumpb.GrpProducerResult{}
+	return result, s.forwardJSON(ctx, "/api/v1/group/producer", params, result)
+}
+
+func (s *grpcServer) GetProducers(ctx context.Context, params *quorumpb.GroupParam) (*quorumpb.ProducerList, error) {
+	result := &quorumpb.ProducerList{}
+	return result, s.forward(ctx, http.MethodGet, groupPath("/api/v1/group/%s/producers", params.GroupId), nil, result, "producers")
+}
+
+//announce
+
+func (s *grpcServer) Announce(ctx context.Context, params *quorumpb.AnnounceParam) (*quorumpb.AnnounceResult, error) {
+	result := &quorumpb.AnnounceResult{}
+	return result, s.forwardJSON(ctx, "/api/v1/group/announce", params, result)
+}
+
+func (s *grpcServer) GetAnnouncedUsers(ctx context.Context, params *quorumpb.GroupParam) (*quorumpb.AnnouncedUserList, error) {
+	result := &quorumpb.AnnouncedUserList{}
+	return result, s.forward(ctx, http.MethodGet, groupPath("/api/v1/group/%s/announced/users", params.GroupId), nil, result, "users")
+}
+
+func (s *grpcServer) GetAnnouncedProducers(ctx context.Context, params *quorumpb.GroupParam) (*quorumpb.AnnouncedProducerList, error) {
+	result := &quorumpb.AnnouncedProducerList{}
+	return result, s.forward(ctx, http.MethodGet, groupPath("/api/v1/group/%s/announced/producers", params.GroupId), nil, result, "producers")
+}
+
+func (s *grpcServer) ApproveAnnouncedUser(ctx context.Context, params *quorumpb.AnnouncedUserParam) (*quorumpb.AnnounceResultResult, error) {
+	result := &quorumpb.AnnounceResultResult{}
+	return result, s.forward(ctx, http.MethodPost, groupPath("/api/v1/group/%s/announced/users/%s/approve", params.GroupId, params.Pubkey), nil, result, "")
+}
+
+func (s *grpcServer) RejectAnnouncedUser(ctx context.Context, params *quorumpb.AnnouncedUserParam) (*quorumpb.AnnounceResultResult, error) {
+	result := &quorumpb.AnnounceResultResult{}
+	return result, s.forward(ctx, http.MethodPost, groupPath("/api/v1/group/%s/announced/users/%s/reject", params.GroupId, params.Pubkey), nil, result, "")
+}
+
+//deny list
+
+func (s *grpcServer) UpdateDeniedList(ctx context.Context, params *quorumpb.DenyListParam) (*quorumpb.DenyUserResult, error) {
+	result := &quorumpb.DenyUserResult{}
+	return result, s.forwardJSON(ctx, "/api/v1/group/deniedlist", params, result)
+}
+
+func (s *grpcServer) GetDeniedList(ctx context.Context, params *quorumpb.GroupParam) (*quorumpb.DeniedUserList, error) {
+	result := &quorumpb.DeniedUserList{}
+	return result, s.forward(ctx, http.MethodGet, groupPath("/api/v1/group/%s/deniedlist", params.GroupId), nil, result, "users")
+}
+
+//schema
+
+func (s *grpcServer) UpdateSchema(ctx context.Context, params *quorumpb.SchemaParam) (*quorumpb.SchemaResult, error) {
+	result := &quorumpb.SchemaResult{}
+	return result, s.forwardJSON(ctx, "/api/v1/group/schema", params, result)
+}
+
+func (s *grpcServer) GetSchema(ctx context.Context, params *quorumpb.GroupParam) (*quorumpb.SchemaList, error) {
+	result := &quorumpb.SchemaList{}
+	return result, s.forward(ctx, http.MethodGet, groupPath("/api/v1/group/%s/app/schema", params.GroupId), nil, result, "schemas")
+}
+
+//network
+
+func (s *grpcServer) GetNodeInfo(ctx context.Context, params *emptypb.Empty) (*quorumpb.NodeInfo, error) {
+	result := &quorumpb.NodeInfo{}
+	return result, s.forward(ctx, http.MethodGet, "/api/v1/node", nil, result, "")
+}
+
+func (s *grpcServer) GetNetwork(ctx context.Context, params *emptypb.Empty) (*quorumpb.NetworkInfo, error) {
+	result := &quorumpb.NetworkInfo{}
+	return result, s.forward(ctx, http.MethodGet, "/api/v1/network", nil, result, "")
+}
+
+func (s *grpcServer) AddPeers(ctx context.Context, params *quorumpb.AddPeersParam) (*quorumpb.AddPeerResult, error) {
+	result := &quorumpb.AddPeerResult{}
+	return result, s.forwardJSON(ctx, "/api/v1/network/peers", params.Peers, result)
+}
+
+func (s *grpcServer) PSPing(ctx context.Context, params *quorumpb.PSPingParam) (*quorumpb.PingResult, error) {
+	result := &quorumpb.PingResult{}
+	return result, s.forwardJSON(ctx, "/api/v1/psping", params, result)
+}
@@ -0,0 +1,114 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"github.com/rumsystem/quorum/internal/pkg/options"
+	appapi "github.com/rumsystem/quorum/pkg/app/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func newGrpcTestContext(ip string, token string) context.Context {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 5216}})
+	if token != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+token))
+	}
+	return ctx
+}
+
+func TestGrpcRequestFromPeer(t *testing.T) {
+	req, err := newGrpcRequest(newGrpcTestContext("10.0.0.2", "abc"), http.MethodGet, "/api/v1/node", nil)
+	if err != nil {
+		t.Fatalf("new grpc request err: %s", err)
+	}
+	if req.RemoteAddr != "10.0.0.2:5216" {
+		t.Errorf("remote addr is %s, want the grpc peer", req.RemoteAddr)
+	}
+	if appapi.IsLoopbackRequest(req) {
+		t.Errorf("request from remote peer is loopback")
+	}
+	if auth := req.Header.Get(echo.HeaderAuthorization); auth != "Bearer abc" {
+		t.Errorf("authorization is %q, want the token in metadata", auth)
+	}
+
+	req, err = newGrpcRequest(newGrpcTestContext("127.0.0.1", ""), http.MethodGet, "/api/v1/node", nil)
+	if err != nil {
+		t.Fatalf("new grpc request err: %s", err)
+	}
+	if !appapi.IsLoopbackRequest(req) {
+		t.Errorf("request from loopback peer is not loopback")
+	}
+
+	//without peer in context the request is not trusted
+	req, err = newGrpcRequest(context.Background(), http.MethodGet, "/api/v1/node", nil)
+	if err != nil {
+		t.Fatalf("new grpc request err: %s", err)
+	}
+	if appapi.IsLoopbackRequest(req) {
+		t.Errorf("request without peer is loopback")
+	}
+}
+
+func TestGrpcAuthorize(t *testing.T) {
+	nodeOpt, err := options.InitNodeOptions(t.TempDir(), "peer")
+	if err != nil {
+		t.Fatalf("init node options err: %s", err)
+	}
+	jwtAuth := middleware.JWTWithConfig(appapi.CustomJWTConfig(nodeOpt.JWTKey))
+	s := &grpcServer{e: echo.New(), jwtAuth: jwtAuth}
+
+	if err := s.authorize(newGrpcTestContext("127.0.0.1", ""), appapi.SCOPE_READ); err != nil {
+		t.Errorf("loopback rpc without token err: %s", err)
+	}
+	if err := s.authorize(newGrpcTestContext("10.0.0.2", ""), appapi.SCOPE_READ); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("remote rpc without token got %v, want Unauthenticated", err)
+	}
+
+	//token of the app is created from loopback, as /app/api/v1/token does
+	tokenStr, err := createGrpcTestToken(s.e, jwtAuth, []string{appapi.SCOPE_READ})
+	if err != nil {
+		t.Fatalf("create token err: %s", err)
+	}
+	if err := s.authorize(newGrpcTestContext("10.0.0.2", tokenStr), appapi.SCOPE_READ); err != nil {
+		t.Errorf("remote rpc with read token err: %s", err)
+	}
+	if err := s.authorize(newGrpcTestContext("10.0.0.2", tokenStr), appapi.SCOPE_ADMIN); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("remote rpc without admin scope got %v, want PermissionDenied", err)
+	}
+}
+
+func createGrpcTestToken(e *echo.Echo, jwtAuth echo.MiddlewareFunc, scopes []string) (string, error) {
+	apph := &appapi.Handler{}
+	e.POST("/app/api/v1/token", apph.CreateToken, jwtAuth, appapi.ScopeAuth(appapi.SCOPE_NODE))
+
+	body, err := json.Marshal(&appapi.CreateTokenParam{Name: "app", Scopes: scopes})
+	if err != nil {
+		return "", err
+	}
+	req := httptest.NewRequest(http.MethodPost, "/app/api/v1/token", bytes.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.RemoteAddr = "127.0.0.1:5000"
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		return "", fmt.Errorf("create token got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	result := &appapi.CreateTokenResult{}
+	if err := json.Unmarshal(rec.Body.Bytes(), result); err != nil {
+		return "", err
+	}
+	return result.Token, nil
+}
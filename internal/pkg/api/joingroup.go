@@ -188,7 +188,11 @@ func (h *Handler) JoinGroup() echo.HandlerFunc {
 		item.CipherKey = params.CipherKey
 		item.AppKey = params.AppKey
 
-		item.ConsenseType = quorumpb.GroupConsenseType_POA
+		if params.ConsensusType == "pos" {
+			item.ConsenseType = quorumpb.GroupConsenseType_POS
+		} else {
+			item.ConsenseType = quorumpb.GroupConsenseType_POA
+		}
 		item.UserSignPubkey = p2pcrypto.ConfigEncodeKey(groupSignPubkey)
 
 		userEncryptKey, err := dirks.GetEncodedPubkey(params.GroupId, localcrypto.Encrypt)
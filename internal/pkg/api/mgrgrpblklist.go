@@ -87,8 +87,11 @@ func (h *Handler) MgrGrpBlkList(c echo.Context) (err error) {
 	if group, ok := groupmgr.Groups[item.GroupId]; !ok {
 		output[ERROR_INFO] = "Can not find group"
 		return c.JSON(http.StatusBadRequest, output)
-	} else if group.Item.OwnerPubKey != group.Item.UserSignPubkey {
-		output[ERROR_INFO] = "Only group owner can add or remove user to blocklist"
+	} else if !group.HasPermission(quorumpb.TrxType_AUTH) {
+		output[ERROR_INFO] = "Only group owner, admin or moderator can add or remove user to blocklist"
+		return c.JSON(http.StatusBadRequest, output)
+	} else if group.GetUserRole(item.PeerId) >= group.GetUserRole(group.Item.UserSignPubkey) {
+		output[ERROR_INFO] = "Can not add or remove user with the same or higher role to blocklist"
 		return c.JSON(http.StatusBadRequest, output)
 	} else {
 		var buffer bytes.Buffer
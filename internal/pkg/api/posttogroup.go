@@ -8,6 +8,7 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/rumsystem/quorum/internal/pkg/chain"
 	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+	"google.golang.org/protobuf/proto"
 )
 
 type CustomValidatorPost struct {
@@ -34,6 +35,38 @@ func (cv *CustomValidatorPost) Validate(i interface{}) error {
 			}
 			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("Object and Target Object must not be nil"))
 		}
+		//Update and Delete point at the trx id of the post by Object.Id
+		if inputobj.Type == Update || inputobj.Type == Delete {
+			if inputobj.Object != nil && inputobj.Target != nil {
+				if inputobj.Target.Type == Group && inputobj.Target.Id != "" {
+					if inputobj.Object.Id == "" {
+						return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("Object Id of the post must not be empty"))
+					}
+					if inputobj.Type == Delete || (inputobj.Object.Type == Note && (inputobj.Object.Content != "" || len(inputobj.Object.Image) > 0)) {
+						return nil
+					}
+					return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("unsupported object type: %s", inputobj.Object.Type))
+				}
+				return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("Target Group must not be nil"))
+			}
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("Object and Target Object must not be nil"))
+		}
+		//reactions point at the trx id of the post by Object.Id, the emoji of EmojiReact is the Content
+		if inputobj.Type == Like || inputobj.Type == Dislike || inputobj.Type == React || inputobj.Type == Undo {
+			if inputobj.Object != nil && inputobj.Target != nil {
+				if inputobj.Target.Type == Group && inputobj.Target.Id != "" {
+					if inputobj.Object.Id == "" {
+						return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("Object Id of the post must not be empty"))
+					}
+					if inputobj.Type == React && (inputobj.Content == "" || len(inputobj.Content) > chain.MAX_REACTION_LENGTH) {
+						return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("Content of EmojiReact should be 1-%d bytes", chain.MAX_REACTION_LENGTH))
+					}
+					return nil
+				}
+				return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("Target Group must not be nil"))
+			}
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("Object and Target Object must not be nil"))
+		}
 		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("unknown type of Actitity: %s", inputobj.Type))
 	default:
 		if err := cv.Validator.Struct(i); err != nil {
@@ -45,7 +78,7 @@ func (cv *CustomValidatorPost) Validate(i interface{}) error {
 
 // @Tags Groups
 // @Summary PostToGroup
-// @Description Post object to a group
+// @Description Post object to a group, or edit (Update) and delete (Delete) a post by the trx id in Object.id. A post can only be edited by the publisher, and deleted by the publisher or group owner. React to a post by Like, Dislike or EmojiReact (the emoji is the content of activity), a later reaction replaces the earlier one of the sender, and Undo removes it
 // @Accept json
 // @Produce json
 // @Param data body quorumpb.Activity true "Activity object"
@@ -68,7 +101,21 @@ func (h *Handler) PostToGroup(c echo.Context) (err error) {
 
 	groupmgr := chain.GetGroupMgr()
 	if group, ok := groupmgr.Groups[paramspb.Target.Id]; ok {
-		trxId, err := group.PostToGroup(paramspb.Object)
+		if !group.HasPermission(quorumpb.TrxType_POST) {
+			output[ERROR_INFO] = "Reader can not post to group"
+			return c.JSON(http.StatusBadRequest, output)
+		}
+
+		var content proto.Message = paramspb.Object
+		switch paramspb.Type {
+		case Update:
+			content = &quorumpb.Activity{Type: paramspb.Type, Object: paramspb.Object}
+		case Delete, Like, Dislike, Undo:
+			content = &quorumpb.Activity{Type: paramspb.Type, Object: &quorumpb.Object{Id: paramspb.Object.Id}}
+		case React:
+			content = &quorumpb.Activity{Type: paramspb.Type, Object: &quorumpb.Object{Id: paramspb.Object.Id}, Content: paramspb.Content}
+		}
+		trxId, err := group.PostToGroup(content)
 
 		if err != nil {
 			output[ERROR_INFO] = err.Error()
@@ -1,7 +1,6 @@
 package api
 
 import (
-	"bytes"
 	"encoding/hex"
 	"net/http"
 	"time"
@@ -18,6 +17,7 @@ type GrpProducerResult struct {
 	GroupId        string `json:"group_id" validate:"required"`
 	ProducerPubkey string `json:"producer_pubkey" validate:"required"`
 	OwnerPubkey    string `json:"owner_pubkey" validate:"required"`
+	SignerPubkey   string `json:"signer_pubkey" validate:"required"`
 	Sign           string `json:"sign" validate:"required"`
 	TrxId          string `json:"trx_id" validate:"required"`
 	Memo           string `json:"memo"`
@@ -58,8 +58,8 @@ func (h *Handler) GroupProducer(c echo.Context) (err error) {
 	if group, ok := groupmgr.Groups[params.GroupId]; !ok {
 		output[ERROR_INFO] = "Can not find group"
 		return c.JSON(http.StatusBadRequest, output)
-	} else if group.Item.OwnerPubKey != group.Item.UserSignPubkey {
-		output[ERROR_INFO] = "Only group owner can add or remove producer"
+	} else if !group.HasPermission(quorumpb.TrxType_PRODUCER) {
+		output[ERROR_INFO] = "Only group owner or admin can add or remove producer"
 		return c.JSON(http.StatusBadRequest, output)
 	} else {
 		isAnnounced, err := group.IsProducerAnnounced(params.ProducerPubkey)
@@ -88,12 +88,10 @@ func (h *Handler) GroupProducer(c echo.Context) (err error) {
 		item.GroupId = params.GroupId
 		item.ProducerPubkey = params.ProducerPubkey
 		item.GroupOwnerPubkey = group.Item.OwnerPubKey
+		//producer registered by admin is signed by admin
+		item.SignerPubkey = group.Item.UserSignPubkey
 
-		var buffer bytes.Buffer
-		buffer.Write([]byte(item.GroupId))
-		buffer.Write([]byte(item.ProducerPubkey))
-		buffer.Write([]byte(item.GroupOwnerPubkey))
-		hash := chain.Hash(buffer.Bytes())
+		hash := chain.GetProducerItemHash(item)
 
 		ks := nodectx.GetNodeCtx().Keystore
 		signature, err := ks.SignByKeyName(item.GroupId, hash)
@@ -123,7 +121,7 @@ func (h *Handler) GroupProducer(c echo.Context) (err error) {
 		}
 
 		var blockGrpUserResult *GrpProducerResult
-		blockGrpUserResult = &GrpProducerResult{GroupId: item.GroupId, ProducerPubkey: item.ProducerPubkey, OwnerPubkey: item.GroupOwnerPubkey, Sign: item.GroupOwnerSign, Action: item.Action.String(), Memo: item.Memo, TrxId: trxId}
+		blockGrpUserResult = &GrpProducerResult{GroupId: item.GroupId, ProducerPubkey: item.ProducerPubkey, OwnerPubkey: item.GroupOwnerPubkey, SignerPubkey: item.SignerPubkey, Sign: item.GroupOwnerSign, Action: item.Action.String(), Memo: item.Memo, TrxId: trxId}
 
 		return c.JSON(http.StatusOK, blockGrpUserResult)
 	}
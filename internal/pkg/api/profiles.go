@@ -0,0 +1,86 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/labstack/echo/v4"
+	chain "github.com/rumsystem/quorum/internal/pkg/chain"
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+)
+
+type ProfileResult struct {
+	Profile *quorumpb.ProfileItem   `json:"profile"`
+	History []*quorumpb.ProfileItem `json:"history"`
+}
+
+// @Tags User
+// @Summary GetGroupProfiles
+// @Description Get the current profile of every user posted a profile to the group, the latest profile of a user wins
+// @Produce json
+// @Param group_id path string true "Group Id"
+// @Success 200 {array} quorumpb.ProfileItem
+// @Router /api/v1/group/{group_id}/profiles [get]
+func (h *Handler) GetGroupProfiles(c echo.Context) (err error) {
+	output := make(map[string]string)
+	groupid := c.Param("group_id")
+
+	groupmgr := chain.GetGroupMgr()
+	group, ok := groupmgr.Groups[groupid]
+	if !ok {
+		output[ERROR_INFO] = fmt.Sprintf("Group %s not exist", groupid)
+		return c.JSON(http.StatusBadRequest, output)
+	}
+
+	profiles, err := group.GetProfiles()
+	if err != nil {
+		output[ERROR_INFO] = err.Error()
+		return c.JSON(http.StatusBadRequest, output)
+	}
+	if profiles == nil {
+		profiles = []*quorumpb.ProfileItem{}
+	}
+	return c.JSON(http.StatusOK, profiles)
+}
+
+// @Tags User
+// @Summary GetGroupProfile
+// @Description Get the current profile of the user and all profiles posted by the user ordered by time
+// @Produce json
+// @Param group_id path string true "Group Id"
+// @Param pubkey path string true "User Pubkey, url encoded"
+// @Success 200 {object} ProfileResult
+// @Router /api/v1/group/{group_id}/profiles/{pubkey} [get]
+func (h *Handler) GetGroupProfile(c echo.Context) (err error) {
+	output := make(map[string]string)
+	groupid := c.Param("group_id")
+	pubkey, err := url.PathUnescape(c.Param("pubkey"))
+	if err != nil || pubkey == "" {
+		output[ERROR_INFO] = "pubkey is invalid."
+		return c.JSON(http.StatusBadRequest, output)
+	}
+
+	groupmgr := chain.GetGroupMgr()
+	group, ok := groupmgr.Groups[groupid]
+	if !ok {
+		output[ERROR_INFO] = fmt.Sprintf("Group %s not exist", groupid)
+		return c.JSON(http.StatusBadRequest, output)
+	}
+
+	profile, err := group.GetProfile(pubkey)
+	if err != nil {
+		output[ERROR_INFO] = err.Error()
+		return c.JSON(http.StatusBadRequest, output)
+	}
+	if profile == nil {
+		output[ERROR_INFO] = "PROFILE_NOT_FOUND"
+		return c.JSON(http.StatusBadRequest, output)
+	}
+	history, err := group.GetProfileHistory(pubkey)
+	if err != nil {
+		output[ERROR_INFO] = err.Error()
+		return c.JSON(http.StatusBadRequest, output)
+	}
+	return c.JSON(http.StatusOK, &ProfileResult{Profile: profile, History: history})
+}
@@ -0,0 +1,160 @@
+package api
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	chain "github.com/rumsystem/quorum/internal/pkg/chain"
+	"github.com/rumsystem/quorum/internal/pkg/nodectx"
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+)
+
+type GrpRoleParam struct {
+	GroupId    string `from:"group_id"    json:"group_id"    validate:"required"`
+	UserPubkey string `from:"user_pubkey" json:"user_pubkey" validate:"required"`
+	Role       string `from:"role"        json:"role"        validate:"required,oneof=admin moderator writer reader"`
+	Action     string `from:"action"      json:"action"      validate:"required,oneof=add remove"`
+	Memo       string `from:"memo"        json:"memo"`
+}
+
+type GrpRoleResult struct {
+	GroupId       string `json:"group_id" validate:"required"`
+	UserPubkey    string `json:"user_pubkey" validate:"required"`
+	Role          string `json:"role" validate:"required"`
+	GranterPubkey string `json:"granter_pubkey" validate:"required"`
+	Sign          string `json:"sign" validate:"required"`
+	Action        string `json:"action" validate:"required"`
+	Memo          string `json:"memo"`
+	TrxId         string `json:"trx_id" validate:"required"`
+}
+
+type RoleListItem struct {
+	UserPubkey    string
+	Role          string
+	GranterPubkey string
+	GranterSign   string
+	TimeStamp     int64
+	Memo          string
+}
+
+// @Tags Management
+// @Summary GroupRole
+// @Description grant a role to a user or revoke it, a user can only grant roles lower than its own role
+// @Accept json
+// @Produce json
+// @Param data body GrpRoleParam true "GrpRoleParam"
+// @Success 200 {object} GrpRoleResult
+// @Router /api/v1/group/role [post]
+func (h *Handler) GroupRole(c echo.Context) (err error) {
+	output := make(map[string]string)
+	validate := validator.New()
+	params := new(GrpRoleParam)
+
+	if err = c.Bind(params); err != nil {
+		output[ERROR_INFO] = err.Error()
+		return c.JSON(http.StatusBadRequest, output)
+	}
+
+	if err = validate.Struct(params); err != nil {
+		output[ERROR_INFO] = err.Error()
+		return c.JSON(http.StatusBadRequest, output)
+	}
+
+	groupmgr := chain.GetGroupMgr()
+	if group, ok := groupmgr.Groups[params.GroupId]; !ok {
+		output[ERROR_INFO] = "Can not find group"
+		return c.JSON(http.StatusBadRequest, output)
+	} else if !group.HasPermission(quorumpb.TrxType_ROLE) {
+		output[ERROR_INFO] = "Only group owner, admin or moderator can grant role"
+		return c.JSON(http.StatusBadRequest, output)
+	} else if params.UserPubkey == group.Item.OwnerPubKey {
+		output[ERROR_INFO] = "Role of group owner can not be changed"
+		return c.JSON(http.StatusBadRequest, output)
+	} else {
+		item := &quorumpb.RoleItem{}
+		item.GroupId = params.GroupId
+		item.UserPubkey = params.UserPubkey
+		item.Role = quorumpb.GroupRole(quorumpb.GroupRole_value[strings.ToUpper(params.Role)])
+		item.GranterPubkey = group.Item.UserSignPubkey
+		item.Memo = params.Memo
+
+		if params.Action == "add" {
+			item.Action = quorumpb.ActionType_ADD
+		} else {
+			item.Action = quorumpb.ActionType_REMOVE
+		}
+
+		myRole := group.GetUserRole(group.Item.UserSignPubkey)
+		if myRole <= group.GetUserRole(item.UserPubkey) || (item.Action == quorumpb.ActionType_ADD && myRole <= item.Role) {
+			output[ERROR_INFO] = "Can only grant or revoke role lower than your own role"
+			return c.JSON(http.StatusBadRequest, output)
+		}
+
+		ks := nodectx.GetNodeCtx().Keystore
+		signature, err := ks.SignByKeyName(item.GroupId, chain.GetRoleItemHash(item))
+		if err != nil {
+			output[ERROR_INFO] = err.Error()
+			return c.JSON(http.StatusBadRequest, output)
+		}
+		item.GranterSign = hex.EncodeToString(signature)
+		item.TimeStamp = time.Now().UnixNano()
+
+		trxId, err := group.UpdRole(item)
+		if err != nil {
+			output[ERROR_INFO] = err.Error()
+			return c.JSON(http.StatusBadRequest, output)
+		}
+
+		roleResult := &GrpRoleResult{GroupId: item.GroupId, UserPubkey: item.UserPubkey, Role: item.Role.String(), GranterPubkey: item.GranterPubkey, Sign: item.GranterSign, Action: item.Action.String(), Memo: item.Memo, TrxId: trxId}
+		return c.JSON(http.StatusOK, roleResult)
+	}
+}
+
+// @Tags Management
+// @Summary GetGroupRoles
+// @Description Get the roles granted in group, users not listed are writers
+// @Produce json
+// @Param group_id path string  true "Group Id"
+// @Success 200 {array} RoleListItem
+// @Router /api/v1/group/{group_id}/roles [get]
+func (h *Handler) GetGroupRoles(c echo.Context) (err error) {
+	output := make(map[string]string)
+	groupid := c.Param("group_id")
+
+	if groupid == "" {
+		output[ERROR_INFO] = "group_id can't be nil."
+		return c.JSON(http.StatusBadRequest, output)
+	}
+
+	groupmgr := chain.GetGroupMgr()
+	if group, ok := groupmgr.Groups[groupid]; ok {
+		roleList, err := group.GetRoles()
+		if err != nil {
+			output[ERROR_INFO] = err.Error()
+			return c.JSON(http.StatusBadRequest, output)
+		}
+
+		roleResultList := []*RoleListItem{}
+		for _, role := range roleList {
+			var item *RoleListItem
+			item = &RoleListItem{}
+			item.UserPubkey = role.UserPubkey
+			item.Role = role.Role.String()
+			item.GranterPubkey = role.GranterPubkey
+			item.GranterSign = role.GranterSign
+			item.TimeStamp = role.TimeStamp
+			item.Memo = role.Memo
+			roleResultList = append(roleResultList, item)
+		}
+
+		return c.JSON(http.StatusOK, roleResultList)
+	} else {
+		output[ERROR_INFO] = fmt.Sprintf("Group %s not exist", groupid)
+		return c.JSON(http.StatusBadRequest, output)
+	}
+}
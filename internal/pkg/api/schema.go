@@ -1,7 +1,6 @@
 package api
 
 import (
-	"bytes"
 	"encoding/hex"
 	"net/http"
 	"time"
@@ -26,18 +25,19 @@ type SchemaParam struct {
 }
 
 type SchemaResult struct {
-	GroupId     string `json:"group_id" validate:"required"`
-	OwnerPubkey string `json:"owner_pubkey" validate:"required"`
-	SchemaType  string `json:"schema_type" validate:"required"`
-	SchemaRule  string `json:"schema_rule" validate:"required"`
-	Action      string `json:"action" validate:"required"`
-	Sign        string `json:"sign" validate:"required"`
-	TrxId       string `json:"trx_id" validate:"required"`
+	GroupId      string `json:"group_id" validate:"required"`
+	OwnerPubkey  string `json:"owner_pubkey" validate:"required"`
+	SignerPubkey string `json:"signer_pubkey" validate:"required"`
+	SchemaType   string `json:"schema_type" validate:"required"`
+	SchemaRule   string `json:"schema_rule" validate:"required"`
+	Action       string `json:"action" validate:"required"`
+	Sign         string `json:"sign" validate:"required"`
+	TrxId        string `json:"trx_id" validate:"required"`
 }
 
 // @Tags Group
 // @Summary Schema
-// @Description Add schema to group
+// @Description Add schema to group, rule is a JSON schema which POST content of the type (type url, e.g. quorum.pb.Object) must conform to
 // @Accept json
 // @Produce json
 // @Param data body SchemaParam true "schema param"
@@ -59,6 +59,13 @@ func (h *Handler) Schema(c echo.Context) (err error) {
 		return c.JSON(http.StatusBadRequest, output)
 	}
 
+	if params.Action == "add" {
+		if err = chain.CheckSchemaRule(params.Rule); err != nil {
+			output[ERROR_INFO] = err.Error()
+			return c.JSON(http.StatusBadRequest, output)
+		}
+	}
+
 	var item *quorumpb.SchemaItem
 	item = &quorumpb.SchemaItem{}
 	item.GroupId = params.GroupId
@@ -69,11 +76,13 @@ func (h *Handler) Schema(c echo.Context) (err error) {
 	if group, ok := groupmgr.Groups[item.GroupId]; !ok {
 		output[ERROR_INFO] = "Can not find group"
 		return c.JSON(http.StatusBadRequest, output)
-	} else if group.Item.OwnerPubKey != group.Item.UserSignPubkey {
-		output[ERROR_INFO] = "Only group owner can add or remove schema to group"
+	} else if !group.HasPermission(quorumpb.TrxType_SCHEMA) {
+		output[ERROR_INFO] = "Only group owner or admin can add or remove schema to group"
 		return c.JSON(http.StatusBadRequest, output)
 	} else {
 		item.GroupOwnerPubkey = group.Item.OwnerPubKey
+		//schema updated by admin is signed by admin
+		item.SignerPubkey = group.Item.UserSignPubkey
 
 		if params.Action == "add" {
 			item.Action = quorumpb.ActionType_ADD
@@ -84,12 +93,7 @@ func (h *Handler) Schema(c echo.Context) (err error) {
 			return c.JSON(http.StatusBadRequest, output)
 		}
 
-		var buffer bytes.Buffer
-		buffer.Write([]byte(item.GroupId))
-		buffer.Write([]byte(item.Type))
-		buffer.Write([]byte(item.Rule))
-		buffer.Write([]byte(item.GroupOwnerPubkey))
-		hash := chain.Hash(buffer.Bytes())
+		hash := chain.GetSchemaItemHash(item)
 
 		ks := nodectx.GetNodeCtx().Keystore
 		signature, err := ks.SignByKeyName(item.GroupId, hash)
@@ -108,7 +112,7 @@ func (h *Handler) Schema(c echo.Context) (err error) {
 			return c.JSON(http.StatusBadRequest, output)
 		}
 
-		schemaResult := &SchemaResult{GroupId: item.GroupId, OwnerPubkey: item.GroupOwnerPubkey, SchemaType: item.Type, SchemaRule: item.Rule, Action: item.Action.String(), Sign: item.GroupOwnerSign, TrxId: trxId}
+		schemaResult := &SchemaResult{GroupId: item.GroupId, OwnerPubkey: item.GroupOwnerPubkey, SignerPubkey: item.SignerPubkey, SchemaType: item.Type, SchemaRule: item.Rule, Action: item.Action.String(), Sign: item.GroupOwnerSign, TrxId: trxId}
 
 		return c.JSON(http.StatusOK, schemaResult)
 	}
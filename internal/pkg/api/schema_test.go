@@ -107,10 +107,22 @@ func TestGetAddRemoveSchema(t *testing.T) {
 		t.Fatalf("getSchemaList failed: len(schemaList) %d != 0", len(schemaList))
 	}
 
-	// add schema
-	_type := "schema_type"
-	rule := "test-schema"
+	// add invalid schema
+	_type := "quorum.pb.Object"
 	memo := "memo"
+	invalidSchemaParam := SchemaParam{
+		GroupId: group.GroupId,
+		Action:  "add",
+		Type:    _type,
+		Rule:    "test-schema",
+		Memo:    memo,
+	}
+	if _, err := addOrRemoveSchema(peerapi, invalidSchemaParam); err == nil {
+		t.Fatalf("addOrRemoveSchema with invalid rule should fail, payload: %+v", invalidSchemaParam)
+	}
+
+	// add schema
+	rule := `{"type": "object", "required": ["type", "content"]}`
 	schemaParam := SchemaParam{
 		GroupId: group.GroupId,
 		Action:  "add",
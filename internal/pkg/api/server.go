@@ -8,6 +8,7 @@ import (
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	chain "github.com/rumsystem/quorum/internal/pkg/chain"
 	"github.com/rumsystem/quorum/internal/pkg/cli"
 	localcrypto "github.com/rumsystem/quorum/internal/pkg/crypto"
 	"github.com/rumsystem/quorum/internal/pkg/options"
@@ -25,47 +26,88 @@ func StartAPIServer(config cli.Config, signalch chan os.Signal, h *Handler, apph
 	quitch = signalch
 	e := echo.New()
 	e.Binder = new(CustomBinder)
-	e.Use(middleware.JWTWithConfig(appapi.CustomJWTConfig(nodeopt.JWTKey)))
+	jwtAuth := middleware.JWTWithConfig(appapi.CustomJWTConfig(nodeopt.JWTKey))
+	e.Use(jwtAuth)
+	//scope required by the route for the remote token
+	readScope := appapi.ScopeAuth(appapi.SCOPE_READ)
+	postScope := appapi.ScopeAuth(appapi.SCOPE_POST)
+	adminScope := appapi.ScopeAuth(appapi.SCOPE_ADMIN)
+	nodeScope := appapi.ScopeAuth(appapi.SCOPE_NODE)
 	r := e.Group("/api")
 	a := e.Group("/app/api")
-	r.GET("/quit", quitapp)
+	r.GET("/quit", quitapp, nodeScope)
 	if isbootstrapnode == false {
-		r.POST("/v1/group", h.CreateGroup())
-		r.POST("/v1/group/join", h.JoinGroup())
-		r.POST("/v1/group/leave", h.LeaveGroup)
-		r.POST("/v1/group/clear", h.ClearGroupData)
-		r.POST("/v1/group/content", h.PostToGroup)
-		r.POST("/v1/group/profile", h.UpdateProfile)
-		r.POST("/v1/network/peers", h.AddPeers)
-		r.POST("/v1/group/deniedlist", h.MgrGrpBlkList)
-		r.POST("/v1/group/producer", h.GroupProducer)
-		r.POST("/v1/group/announce", h.Announce)
-		r.POST("/v1/group/schema", h.Schema)
-		r.POST("/v1/group/:group_id/startsync", h.StartSync)
-		r.GET("/v1/node", h.GetNodeInfo)
-		r.GET("/v1/network", h.GetNetwork(&node.Host, node.Info, nodeopt, ethaddr))
-		r.POST("/v1/psping", h.PSPingPeer(node))
-		r.GET("/v1/block/:group_id/:block_id", h.GetBlockById)
-		r.GET("/v1/trx/:group_id/:trx_id", h.GetTrx)
-		r.GET("/v1/groups", h.GetGroups)
-		r.GET("/v1/group/:group_id/content", h.GetGroupCtn)
-		r.GET("/v1/group/:group_id/deniedlist", h.GetDeniedUserList)
-		r.GET("/v1/group/:group_id/producers", h.GetGroupProducers)
-		r.GET("/v1/group/:group_id/announced/users", h.GetAnnouncedGroupUsers)
-		r.GET("/v1/group/:group_id/announced/producers", h.GetAnnouncedGroupProducer)
-		r.GET("/v1/group/:group_id/app/schema", h.GetGroupAppSchema)
+		r.POST("/v1/group", h.CreateGroup(), adminScope)
+		r.POST("/v1/group/join", h.JoinGroup(), adminScope)
+		r.POST("/v1/group/leave", h.LeaveGroup, adminScope)
+		r.POST("/v1/group/clear", h.ClearGroupData, adminScope)
+		r.POST("/v1/group/import", h.ImportGroup, adminScope)
+		r.POST("/v1/group/content", h.PostToGroup, postScope)
+		r.POST("/v1/group/profile", h.UpdateProfile, postScope)
+		r.POST("/v1/network/peers", h.AddPeers, nodeScope)
+		r.POST("/v1/group/deniedlist", h.MgrGrpBlkList, adminScope)
+		r.POST("/v1/group/producer", h.GroupProducer, adminScope)
+		r.POST("/v1/group/announce", h.Announce, adminScope)
+		r.POST("/v1/group/schema", h.Schema, adminScope)
+		r.POST("/v1/group/stake", h.GroupStake, adminScope)
+		r.POST("/v1/group/key", h.GroupKey, adminScope)
+		r.POST("/v1/group/role", h.GroupRole, adminScope)
+		r.POST("/v1/group/:group_id/startsync", h.StartSync, adminScope)
+		r.GET("/v1/group/:group_id/export", h.ExportGroup, adminScope)
+		r.GET("/v1/group/:group_id/fsck", h.CheckGroup, adminScope)
+		r.POST("/v1/group/:group_id/fsck/repair", h.RepairGroup, adminScope)
+		r.POST("/v1/group/:group_id/announced/users/:pubkey/approve", h.ApproveAnnouncedUser, adminScope)
+		r.POST("/v1/group/:group_id/announced/users/:pubkey/reject", h.RejectAnnouncedUser, adminScope)
+		r.GET("/v1/node", h.GetNodeInfo, readScope)
+		r.GET("/v1/network", h.GetNetwork(&node.Host, node.Info, nodeopt, ethaddr), readScope)
+		r.POST("/v1/psping", h.PSPingPeer(node), nodeScope)
+		r.GET("/v1/block/:group_id/:block_id", h.GetBlockById, readScope)
+		r.GET("/v1/trx/:group_id/:trx_id", h.GetTrx, readScope)
+		r.GET("/v1/groups", h.GetGroups, readScope)
+		r.GET("/v1/group/:group_id/content", h.GetGroupCtn, readScope)
+		r.POST("/v1/group/:group_id/files", h.UploadFile, postScope, middleware.BodyLimit(fmt.Sprintf("%dM", chain.MAX_FILE_SIZE/1024/1024+1)))
+		r.GET("/v1/group/:group_id/files/:trx_id", h.DownloadFile, readScope)
+		r.GET("/v1/group/:group_id/deniedlist", h.GetDeniedUserList, readScope)
+		r.GET("/v1/group/:group_id/producers", h.GetGroupProducers, readScope)
+		r.GET("/v1/group/:group_id/stakes", h.GetGroupStakes, readScope)
+		r.GET("/v1/group/:group_id/keys", h.GetGroupKeys, readScope)
+		r.GET("/v1/group/:group_id/roles", h.GetGroupRoles, readScope)
+		r.GET("/v1/group/:group_id/profiles", h.GetGroupProfiles, readScope)
+		r.GET("/v1/group/:group_id/profiles/:pubkey", h.GetGroupProfile, readScope)
+		r.GET("/v1/group/:group_id/announced/users", h.GetAnnouncedGroupUsers, readScope)
+		r.GET("/v1/group/:group_id/announced/producers", h.GetAnnouncedGroupProducer, readScope)
+		r.GET("/v1/group/:group_id/app/schema", h.GetGroupAppSchema, readScope)
+		r.GET("/v1/events", h.Events, readScope)
+		r.POST("/v1/webhooks", h.CreateWebhook, adminScope)
+		r.GET("/v1/webhooks", h.GetWebhooks, adminScope)
+		r.GET("/v1/webhooks/:webhook_id", h.GetWebhook, adminScope)
+		r.PUT("/v1/webhooks/:webhook_id", h.UpdateWebhook, adminScope)
+		r.DELETE("/v1/webhooks/:webhook_id", h.DeleteWebhook, adminScope)
+		r.GET("/v1/webhooks/:webhook_id/deadletters", h.GetWebhookDeadLetters, adminScope)
 
-		a.POST("/v1/group/:group_id/content", apph.ContentByPeers)
+		a.POST("/v1/group/:group_id/content", apph.ContentByPeers, readScope)
+		a.GET("/v1/group/:group_id/search", apph.SearchGroupContent, readScope)
+		a.GET("/v1/group/:group_id/content/:trx_id/replies", apph.GetContentReplies, readScope)
 		a.POST("/v1/token/apply", apph.ApplyToken)
 		a.POST("/v1/token/refresh", apph.RefreshToken)
+		a.GET("/v1/token", apph.GetTokens, nodeScope)
+		a.POST("/v1/token", apph.CreateToken, nodeScope)
+		a.POST("/v1/token/revoke", apph.RevokeToken, nodeScope)
 	} else {
-		r.GET("/v1/node", h.GetBootstrapNodeInfo)
+		r.GET("/v1/node", h.GetBootstrapNodeInfo, readScope)
 	}
 
 	certPath, keyPath, err := utils.GetTLSCerts()
 	if err != nil {
 		panic(err)
 	}
+	if config.GRPCListenAddresses != "" {
+		go func() {
+			if err := StartGrpcServer(config.GRPCListenAddresses, e, jwtAuth, certPath, keyPath); err != nil {
+				grpc_log.Fatalf("start gRPC api failed: %s", err)
+			}
+		}()
+	}
 	e.Logger.Fatal(e.StartTLS(config.APIListenAddresses, certPath, keyPath))
 }
 
@@ -0,0 +1,162 @@
+package api
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	chain "github.com/rumsystem/quorum/internal/pkg/chain"
+	"github.com/rumsystem/quorum/internal/pkg/nodectx"
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+)
+
+type GrpStakeParam struct {
+	Action         string `from:"action"          json:"action"           validate:"required,oneof=add remove"`
+	ProducerPubkey string `from:"producer_pubkey" json:"producer_pubkey"  validate:"required"`
+	GroupId        string `from:"group_id"        json:"group_id"         validate:"required"`
+	Stake          int64  `from:"stake"           json:"stake"            validate:"gte=0"`
+	Memo           string `from:"memo"            json:"memo"`
+}
+
+type GrpStakeResult struct {
+	GroupId        string `json:"group_id" validate:"required"`
+	ProducerPubkey string `json:"producer_pubkey" validate:"required"`
+	Stake          int64  `json:"stake"`
+	OwnerPubkey    string `json:"owner_pubkey" validate:"required"`
+	Sign           string `json:"sign" validate:"required"`
+	TrxId          string `json:"trx_id" validate:"required"`
+	Memo           string `json:"memo"`
+	Action         string `json:"action" validate:"required,oneof=ADD REMOVE"`
+}
+
+type StakeListItem struct {
+	ProducerPubkey string
+	Stake          int64
+	Slashed        bool
+	OwnerPubkey    string
+	OwnerSign      string
+	TimeStamp      int64
+	Memo           string
+}
+
+// @Tags Management
+// @Summary GroupStake
+// @Description set or remove the stake of a group producer (pos group)
+// @Accept json
+// @Produce json
+// @Param data body GrpStakeParam true "GrpStakeParam"
+// @Success 200 {object} GrpStakeResult
+// @Router /api/v1/group/stake [post]
+func (h *Handler) GroupStake(c echo.Context) (err error) {
+	output := make(map[string]string)
+	validate := validator.New()
+	params := new(GrpStakeParam)
+
+	if err = c.Bind(params); err != nil {
+		output[ERROR_INFO] = err.Error()
+		return c.JSON(http.StatusBadRequest, output)
+	}
+
+	if err = validate.Struct(params); err != nil {
+		output[ERROR_INFO] = err.Error()
+		return c.JSON(http.StatusBadRequest, output)
+	}
+
+	groupmgr := chain.GetGroupMgr()
+	if group, ok := groupmgr.Groups[params.GroupId]; !ok {
+		output[ERROR_INFO] = "Can not find group"
+		return c.JSON(http.StatusBadRequest, output)
+	} else if group.Item.OwnerPubKey != group.Item.UserSignPubkey {
+		output[ERROR_INFO] = "Only group owner can set producer stake"
+		return c.JSON(http.StatusBadRequest, output)
+	} else if group.Item.ConsenseType != quorumpb.GroupConsenseType_POS {
+		output[ERROR_INFO] = "Stake is only supported by pos group"
+		return c.JSON(http.StatusBadRequest, output)
+	} else {
+		item := &quorumpb.StakeItem{}
+		item.GroupId = params.GroupId
+		item.ProducerPubkey = params.ProducerPubkey
+		item.Stake = params.Stake
+		item.GroupOwnerPubkey = group.Item.OwnerPubKey
+
+		if params.Action == "add" {
+			item.Action = quorumpb.ActionType_ADD
+		} else if params.Action == "remove" {
+			item.Action = quorumpb.ActionType_REMOVE
+		} else {
+			output[ERROR_INFO] = "Unknown action"
+			return c.JSON(http.StatusBadRequest, output)
+		}
+
+		hash := chain.GetStakeHash(item)
+		ks := nodectx.GetNodeCtx().Keystore
+		signature, err := ks.SignByKeyName(item.GroupId, hash)
+
+		if err != nil {
+			output[ERROR_INFO] = err.Error()
+			return c.JSON(http.StatusBadRequest, output)
+		}
+
+		item.GroupOwnerSign = hex.EncodeToString(signature)
+		item.Memo = params.Memo
+		item.TimeStamp = time.Now().UnixNano()
+		trxId, err := group.UpdStake(item)
+
+		if err != nil {
+			output[ERROR_INFO] = err.Error()
+			return c.JSON(http.StatusBadRequest, output)
+		}
+
+		stakeResult := &GrpStakeResult{GroupId: item.GroupId, ProducerPubkey: item.ProducerPubkey, Stake: item.Stake, OwnerPubkey: item.GroupOwnerPubkey, Sign: item.GroupOwnerSign, Action: item.Action.String(), Memo: item.Memo, TrxId: trxId}
+
+		return c.JSON(http.StatusOK, stakeResult)
+	}
+}
+
+// @Tags Management
+// @Summary GetGroupStakes
+// @Description Get the stake registry of a group
+// @Produce json
+// @Param group_id path string  true "Group Id"
+// @Success 200 {array} StakeListItem
+// @Router /api/v1/group/{group_id}/stakes [get]
+func (h *Handler) GetGroupStakes(c echo.Context) (err error) {
+	output := make(map[string]string)
+	groupid := c.Param("group_id")
+
+	if groupid == "" {
+		output[ERROR_INFO] = "group_id can't be nil."
+		return c.JSON(http.StatusBadRequest, output)
+	}
+
+	groupmgr := chain.GetGroupMgr()
+	if group, ok := groupmgr.Groups[groupid]; ok {
+		stakeList, err := group.GetStakes()
+		if err != nil {
+			output[ERROR_INFO] = err.Error()
+			return c.JSON(http.StatusBadRequest, output)
+		}
+
+		stakeResultList := []*StakeListItem{}
+		for _, stake := range stakeList {
+			var item *StakeListItem
+			item = &StakeListItem{}
+			item.ProducerPubkey = stake.ProducerPubkey
+			item.Stake = stake.Stake
+			item.Slashed = stake.Slashed
+			item.OwnerPubkey = stake.GroupOwnerPubkey
+			item.OwnerSign = stake.GroupOwnerSign
+			item.TimeStamp = stake.TimeStamp
+			item.Memo = stake.Memo
+			stakeResultList = append(stakeResultList, item)
+		}
+
+		return c.JSON(http.StatusOK, stakeResultList)
+	} else {
+		output[ERROR_INFO] = fmt.Sprintf("Group %s not exist", groupid)
+		return c.JSON(http.StatusBadRequest, output)
+	}
+}
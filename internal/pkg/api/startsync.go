@@ -34,7 +34,7 @@ func (h *Handler) StartSync(c echo.Context) (err error) {
 		return c.JSON(http.StatusBadRequest, output)
 	}
 
-	if group.ChainCtx.Syncer.Status == chain.SYNCING_BACKWARD || group.ChainCtx.Syncer.Status == chain.SYNCING_FORWARD {
+	if group.ChainCtx.Syncer.Status == chain.SYNCING_BACKWARD || group.ChainCtx.Syncer.Status == chain.SYNCING_FORWARD || group.ChainCtx.Syncer.Status == chain.SYNCING_SNAPSHOT {
 		errorInfo := "GROUP_ALREADY_IN_SYNCING"
 		startSyncResult := &StartSyncResult{GroupId: group.Item.GroupId, Error: errorInfo}
 		return c.JSON(http.StatusBadRequest, startSyncResult)
@@ -0,0 +1,238 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	guuid "github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	chain "github.com/rumsystem/quorum/internal/pkg/chain"
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+	"github.com/rumsystem/quorum/internal/pkg/webhook"
+)
+
+type WebhookParam struct {
+	Url      string   `from:"url"       json:"url"       validate:"required,url"`
+	GroupId  string   `from:"group_id"  json:"group_id"  validate:"required"`
+	TrxTypes []string `from:"trx_types" json:"trx_types" validate:"required,min=1,dive,oneof=POST ANNOUNCE PRODUCER AUTH SCHEMA"`
+	Secret   string   `from:"secret"    json:"secret"`
+	Memo     string   `from:"memo"      json:"memo"`
+}
+
+type WebhookResult struct {
+	WebhookId string   `json:"webhook_id" validate:"required"`
+	Url       string   `json:"url" validate:"required"`
+	GroupId   string   `json:"group_id" validate:"required"`
+	TrxTypes  []string `json:"trx_types" validate:"required"`
+	Secret    string   `json:"secret" validate:"required"`
+	Memo      string   `json:"memo"`
+	TimeStamp int64    `json:"timestamp"`
+}
+
+type WebhookDeadLetterItem struct {
+	DeliveryId string `json:"delivery_id"`
+	GroupId    string `json:"group_id"`
+	TrxId      string `json:"trx_id"`
+	TrxType    string `json:"trx_type"`
+	Payload    string `json:"payload"`
+	Attempts   int32  `json:"attempts"`
+	LastError  string `json:"last_error"`
+	TimeStamp  int64  `json:"timestamp"`
+}
+
+// @Tags Webhook
+// @Summary CreateWebhook
+// @Description Subscribe applied trxs of a group, the trxs are posted to url with HMAC-SHA256 signature of the secret
+// @Accept json
+// @Produce json
+// @Param data body WebhookParam true "WebhookParam"
+// @Success 200 {object} WebhookResult
+// @Router /api/v1/webhooks [post]
+func (h *Handler) CreateWebhook(c echo.Context) (err error) {
+	output := make(map[string]string)
+	item := &quorumpb.WebhookItem{WebhookId: guuid.New().String()}
+	if err := bindWebhookParam(c, item); err != nil {
+		output[ERROR_INFO] = err.Error()
+		return c.JSON(http.StatusBadRequest, output)
+	}
+
+	if err := webhook.GetWebhookMgr().AddWebhook(item); err != nil {
+		output[ERROR_INFO] = err.Error()
+		return c.JSON(http.StatusBadRequest, output)
+	}
+	return c.JSON(http.StatusOK, webhookResult(item))
+}
+
+// @Tags Webhook
+// @Summary UpdateWebhook
+// @Description Update the url, group, trx types, secret or memo of a webhook
+// @Accept json
+// @Produce json
+// @Param webhook_id path string true "Webhook Id"
+// @Param data body WebhookParam true "WebhookParam"
+// @Success 200 {object} WebhookResult
+// @Router /api/v1/webhooks/{webhook_id} [put]
+func (h *Handler) UpdateWebhook(c echo.Context) (err error) {
+	output := make(map[string]string)
+	item, err := getWebhook(c)
+	if err != nil {
+		output[ERROR_INFO] = err.Error()
+		return c.JSON(http.StatusBadRequest, output)
+	}
+
+	if err := bindWebhookParam(c, item); err != nil {
+		output[ERROR_INFO] = err.Error()
+		return c.JSON(http.StatusBadRequest, output)
+	}
+
+	if err := webhook.GetWebhookMgr().AddWebhook(item); err != nil {
+		output[ERROR_INFO] = err.Error()
+		return c.JSON(http.StatusBadRequest, output)
+	}
+	return c.JSON(http.StatusOK, webhookResult(item))
+}
+
+// @Tags Webhook
+// @Summary DeleteWebhook
+// @Description Delete a webhook with its dead letters
+// @Produce json
+// @Param webhook_id path string true "Webhook Id"
+// @Success 200 {object} WebhookResult
+// @Router /api/v1/webhooks/{webhook_id} [delete]
+func (h *Handler) DeleteWebhook(c echo.Context) (err error) {
+	output := make(map[string]string)
+	item, err := getWebhook(c)
+	if err != nil {
+		output[ERROR_INFO] = err.Error()
+		return c.JSON(http.StatusBadRequest, output)
+	}
+
+	if err := webhook.GetWebhookMgr().RmWebhook(item.WebhookId); err != nil {
+		output[ERROR_INFO] = err.Error()
+		return c.JSON(http.StatusBadRequest, output)
+	}
+	return c.JSON(http.StatusOK, webhookResult(item))
+}
+
+// @Tags Webhook
+// @Summary GetWebhooks
+// @Description Get all webhooks of the node
+// @Produce json
+// @Success 200 {array} WebhookResult
+// @Router /api/v1/webhooks [get]
+func (h *Handler) GetWebhooks(c echo.Context) (err error) {
+	output := make(map[string]string)
+	items, err := webhook.GetWebhookMgr().GetWebhooks()
+	if err != nil {
+		output[ERROR_INFO] = err.Error()
+		return c.JSON(http.StatusBadRequest, output)
+	}
+
+	webhookList := []*WebhookResult{}
+	for _, item := range items {
+		webhookList = append(webhookList, webhookResult(item))
+	}
+	return c.JSON(http.StatusOK, webhookList)
+}
+
+// @Tags Webhook
+// @Summary GetWebhook
+// @Description Get a webhook
+// @Produce json
+// @Param webhook_id path string true "Webhook Id"
+// @Success 200 {object} WebhookResult
+// @Router /api/v1/webhooks/{webhook_id} [get]
+func (h *Handler) GetWebhook(c echo.Context) (err error) {
+	output := make(map[string]string)
+	item, err := getWebhook(c)
+	if err != nil {
+		output[ERROR_INFO] = err.Error()
+		return c.JSON(http.StatusBadRequest, output)
+	}
+	return c.JSON(http.StatusOK, webhookResult(item))
+}
+
+// @Tags Webhook
+// @Summary GetWebhookDeadLetters
+// @Description Get the deliveries of a webhook which are failed after all retries
+// @Produce json
+// @Param webhook_id path string true "Webhook Id"
+// @Success 200 {array} WebhookDeadLetterItem
+// @Router /api/v1/webhooks/{webhook_id}/deadletters [get]
+func (h *Handler) GetWebhookDeadLetters(c echo.Context) (err error) {
+	output := make(map[string]string)
+	item, err := getWebhook(c)
+	if err != nil {
+		output[ERROR_INFO] = err.Error()
+		return c.JSON(http.StatusBadRequest, output)
+	}
+
+	letters, err := webhook.GetWebhookMgr().GetDeadLetters(item.WebhookId)
+	if err != nil {
+		output[ERROR_INFO] = err.Error()
+		return c.JSON(http.StatusBadRequest, output)
+	}
+
+	letterList := []*WebhookDeadLetterItem{}
+	for _, letter := range letters {
+		letterList = append(letterList, &WebhookDeadLetterItem{DeliveryId: letter.DeliveryId, GroupId: letter.GroupId, TrxId: letter.TrxId, TrxType: letter.TrxType.String(), Payload: string(letter.Payload), Attempts: letter.Attempts, LastError: letter.LastError, TimeStamp: letter.TimeStamp})
+	}
+	return c.JSON(http.StatusOK, letterList)
+}
+
+//bindWebhookParam validates the params and sets them to the webhook item
+func bindWebhookParam(c echo.Context, item *quorumpb.WebhookItem) error {
+	validate := validator.New()
+	params := new(WebhookParam)
+	if err := c.Bind(params); err != nil {
+		return err
+	}
+	if err := validate.Struct(params); err != nil {
+		return err
+	}
+
+	if _, ok := chain.GetGroupMgr().Groups[params.GroupId]; !ok {
+		return fmt.Errorf("Group %s not exist", params.GroupId)
+	}
+
+	item.Url = params.Url
+	item.GroupId = params.GroupId
+	item.TrxTypes = nil
+	for _, trxType := range params.TrxTypes {
+		item.TrxTypes = append(item.TrxTypes, quorumpb.TrxType(quorumpb.TrxType_value[trxType]))
+	}
+	item.Memo = params.Memo
+	if params.Secret != "" {
+		item.Secret = params.Secret
+	} else if item.Secret == "" {
+		secret, err := webhook.GenerateSecret()
+		if err != nil {
+			return err
+		}
+		item.Secret = secret
+	}
+	item.TimeStamp = time.Now().UnixNano()
+	return nil
+}
+
+func getWebhook(c echo.Context) (*quorumpb.WebhookItem, error) {
+	webhookId := c.Param("webhook_id")
+	item, err := webhook.GetWebhookMgr().GetWebhook(webhookId)
+	if err != nil {
+		return nil, err
+	}
+	if item == nil {
+		return nil, fmt.Errorf("Webhook %s not exist", webhookId)
+	}
+	return item, nil
+}
+
+func webhookResult(item *quorumpb.WebhookItem) *WebhookResult {
+	result := &WebhookResult{WebhookId: item.WebhookId, Url: item.Url, GroupId: item.GroupId, Secret: item.Secret, Memo: item.Memo, TimeStamp: item.TimeStamp}
+	for _, trxType := range item.TrxTypes {
+		result.TrxTypes = append(result.TrxTypes, trxType.String())
+	}
+	return result
+}
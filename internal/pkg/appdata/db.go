@@ -117,13 +117,24 @@ func getKey(prefix string, seqid uint64, tailing string) ([]byte, error) {
 	return orderedcode.Append(nil, prefix, "-", orderedcode.Infinity, uint64(seqid), "_", tailing)
 }
 
-func (appdb *AppDb) AddMetaByTrx(blockId string, groupid string, trxs []*quorumpb.Trx) error {
-	var err error
+//ParsedBlock is the index data parsed from the trxs of a block
+type ParsedBlock struct {
+	BlockId   string
+	Trxs      []*quorumpb.Trx //POST trxs indexed by sequence and sender
+	Docs      []*SearchDoc
+	Replies   []*Reply
+	Reactions []*Reaction
+	Pending   []*Reaction //reactions to the posts not indexed yet
+}
 
+//AddMetaByTrx indexes the POST trxs of the block by sequence and sender, the docs for full-text search, the replies
+//and the reactions in a txn. Pending reactions are indexed when the post comes, and the reactions of deleted posts
+//and the deleted replies are removed
+func (appdb *AppDb) AddMetaByTrx(groupid string, block *ParsedBlock) error {
 	seqkey := SEQ_PREFIX + CNT_PREFIX + GRP_PREFIX + groupid
 
 	keylist := [][]byte{}
-	for _, trx := range trxs {
+	for _, trx := range block.Trxs {
 		if trx.Type == quorumpb.TrxType_POST {
 			seqid, err := appdb.GetSeqId(seqkey)
 			if err != nil {
@@ -138,22 +149,61 @@ func (appdb *AppDb) AddMetaByTrx(blockId string, groupid string, trxs []*quorump
 		}
 	}
 
-	keys := [][]byte{}
-	values := [][]byte{}
+	txn, err := appdb.Db.NewTxn()
+	if err != nil {
+		return err
+	}
+	defer txn.Discard()
 
 	for _, key := range keylist {
-		keys = append(keys, key)
-		values = append(values, nil)
+		if err := txn.Set(key, nil); err != nil {
+			return err
+		}
+	}
+
+	if err := indexDocs(txn, groupid, block.Docs); err != nil {
+		return err
+	}
+
+	if err := indexReplies(txn, groupid, block.Replies); err != nil {
+		return err
+	}
+
+	if err := addPendingReactions(txn, groupid, block.Pending); err != nil {
+		return err
+	}
+	reactions := block.Reactions
+	for _, trx := range block.Trxs {
+		if trx.Type != quorumpb.TrxType_POST {
+			continue
+		}
+		pending, err := popPendingReactions(txn, groupid, trx.TrxId)
+		if err != nil {
+			return err
+		}
+		reactions = append(reactions, pending...)
+	}
+	if err := indexReactions(txn, groupid, reactions); err != nil {
+		return err
+	}
+	for _, doc := range block.Docs {
+		if doc.Deleted {
+			if err := removeReactions(txn, groupid, doc.TrxId); err != nil {
+				return err
+			}
+			if err := removeReply(txn, groupid, doc.TrxId); err != nil {
+				return err
+			}
+		}
 	}
 
 	valuename := "HighestBlockId"
 	groupLastestBlockidkey := fmt.Sprintf("%s%s_%s", STATUS_PREFIX, groupid, valuename)
-	keys = append(keys, []byte(groupLastestBlockidkey))
-	values = append(values, []byte(blockId))
-
-	err = appdb.Db.BatchWrite(keys, values)
+	if err := txn.Set([]byte(groupLastestBlockidkey), []byte(block.BlockId)); err != nil {
+		return err
+	}
 
-	return err
+	return txn.Commit()
 }
 
 func (appdb *AppDb) Release() error {
@@ -0,0 +1,195 @@
+package appdata
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/rumsystem/quorum/internal/pkg/storage"
+)
+
+const RCT_PREFIX string = "rct_" //reaction of a sender, rct_<groupid>_<trxid>_<sender>
+const RCS_PREFIX string = "rcs_" //reaction counts of post, rcs_<groupid>_<trxid>
+const RCP_PREFIX string = "rcp_" //pending reaction to the post not indexed yet, rcp_<groupid>_<trxid>_<reaction trxid>
+
+//Reaction is a Like, Dislike or emoji reaction to a POST, empty Reaction is the undo of the reaction
+type Reaction struct {
+	TrxId     string
+	PostTrxId string
+	Sender    string
+	TimeStamp int64
+	Reaction  string
+}
+
+//senderReaction is the latest reaction of a sender to a post, kept after undo so an earlier reaction
+//packaged later is ignored
+type senderReaction struct {
+	Reaction  string `json:"r"`
+	TimeStamp int64  `json:"t"`
+	TrxId     string `json:"x"`
+}
+
+func getSenderReactionKey(groupid string, trxid string, sender string) []byte {
+	return []byte(fmt.Sprintf("%s%s_%s_%s", RCT_PREFIX, groupid, trxid, sender))
+}
+
+func getReactionCountsKey(groupid string, trxid string) []byte {
+	return []byte(fmt.Sprintf("%s%s_%s", RCS_PREFIX, groupid, trxid))
+}
+
+func getPendingReactionPrefix(groupid string, trxid string) string {
+	return fmt.Sprintf("%s%s_%s_", RCP_PREFIX, groupid, trxid)
+}
+
+//indexReactions writes the reactions and the reaction counts of the posts in txn, a reaction replaces the
+//earlier reaction of the sender to the post, and the reaction already indexed or earlier is skipped
+func indexReactions(txn storage.Txn, groupid string, reactions []*Reaction) error {
+	for _, reaction := range reactions {
+		key := getSenderReactionKey(groupid, reaction.PostTrxId, reaction.Sender)
+		prev, err := getSenderReaction(txn, groupid, reaction.PostTrxId, reaction.Sender)
+		if err != nil {
+			return err
+		}
+		if prev != nil && (prev.TimeStamp > reaction.TimeStamp || (prev.TimeStamp == reaction.TimeStamp && prev.TrxId >= reaction.TrxId)) {
+			continue
+		}
+
+		counts, err := getReactionCounts(txn, groupid, reaction.PostTrxId)
+		if err != nil {
+			return err
+		}
+		if prev != nil && prev.Reaction != "" {
+			if counts[prev.Reaction]--; counts[prev.Reaction] <= 0 {
+				delete(counts, prev.Reaction)
+			}
+		}
+		if reaction.Reaction != "" {
+			counts[reaction.Reaction]++
+		}
+
+		value, err := json.Marshal(&senderReaction{Reaction: reaction.Reaction, TimeStamp: reaction.TimeStamp, TrxId: reaction.TrxId})
+		if err != nil {
+			return err
+		}
+		if err := txn.Set(key, value); err != nil {
+			return err
+		}
+		value, err = json.Marshal(counts)
+		if err != nil {
+			return err
+		}
+		if err := txn.Set(getReactionCountsKey(groupid, reaction.PostTrxId), value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//addPendingReactions keeps the reactions to the posts not indexed yet, they are indexed when the post comes
+func addPendingReactions(txn storage.Txn, groupid string, reactions []*Reaction) error {
+	for _, reaction := range reactions {
+		value, err := json.Marshal(reaction)
+		if err != nil {
+			return err
+		}
+		key := getPendingReactionPrefix(groupid, reaction.PostTrxId) + reaction.TrxId
+		if err := txn.Set([]byte(key), value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//popPendingReactions returns the pending reactions to the post and removes them
+func popPendingReactions(txn storage.Txn, groupid string, trxid string) ([]*Reaction, error) {
+	reactions := []*Reaction{}
+	keys := [][]byte{}
+	err := txn.PrefixForeach([]byte(getPendingReactionPrefix(groupid, trxid)), func(k []byte, v []byte, err error) error {
+		if err != nil {
+			return err
+		}
+		reaction := &Reaction{}
+		if err := json.Unmarshal(v, reaction); err != nil {
+			return err
+		}
+		reactions = append(reactions, reaction)
+		keys = append(keys, append([]byte{}, k...))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		if err := txn.Delete(key); err != nil {
+			return nil, err
+		}
+	}
+	return reactions, nil
+}
+
+//removeReactions deletes the reaction counts, the reactions of senders and the pending reactions of the post
+func removeReactions(txn storage.Txn, groupid string, trxid string) error {
+	keys := [][]byte{getReactionCountsKey(groupid, trxid)}
+	for _, prefix := range []string{string(getSenderReactionKey(groupid, trxid, "")), getPendingReactionPrefix(groupid, trxid)} {
+		p := []byte(prefix)
+		err := txn.PrefixForeachKey(p, p, false, func(k []byte, err error) error {
+			if err != nil {
+				return err
+			}
+			keys = append(keys, append([]byte{}, k...))
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	for _, key := range keys {
+		if err := txn.Delete(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func getSenderReaction(db indexReader, groupid string, trxid string, sender string) (*senderReaction, error) {
+	key := getSenderReactionKey(groupid, trxid, sender)
+	exist, err := db.IsExist(key)
+	if err != nil || !exist {
+		return nil, err
+	}
+	value, err := db.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	reaction := &senderReaction{}
+	err = json.Unmarshal(value, reaction)
+	return reaction, err
+}
+
+func getReactionCounts(db indexReader, groupid string, trxid string) (map[string]int64, error) {
+	counts := make(map[string]int64)
+	key := getReactionCountsKey(groupid, trxid)
+	exist, err := db.IsExist(key)
+	if err != nil || !exist {
+		return counts, err
+	}
+	value, err := db.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(value, &counts)
+	return counts, err
+}
+
+//GetReactions returns the counts of reactions to the POST, and the reaction of the sender, empty if the sender
+//has not reacted
+func (appdb *AppDb) GetReactions(groupid string, trxid string, sender string) (map[string]int64, string, error) {
+	counts, err := getReactionCounts(appdb.Db, groupid, trxid)
+	if err != nil {
+		return nil, "", err
+	}
+	reaction, err := getSenderReaction(appdb.Db, groupid, trxid, sender)
+	if err != nil || reaction == nil {
+		return counts, "", err
+	}
+	return counts, reaction.Reaction, nil
+}
@@ -0,0 +1,114 @@
+package appdata
+
+import (
+	"testing"
+
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+)
+
+func TestReactions(t *testing.T) {
+	appdb := newTestAppDb(t)
+
+	blocks := [][]*Reaction{
+		{
+			{TrxId: "r1", PostTrxId: "trx1", Sender: "alice", TimeStamp: 100, Reaction: "Like"},
+			{TrxId: "r2", PostTrxId: "trx1", Sender: "bob", TimeStamp: 110, Reaction: "Like"},
+			{TrxId: "r3", PostTrxId: "trx1", Sender: "carol", TimeStamp: 120, Reaction: "👍"},
+			//a later reaction replaces the earlier one of the sender
+			{TrxId: "r4", PostTrxId: "trx1", Sender: "alice", TimeStamp: 130, Reaction: "Dislike"},
+		},
+		{
+			//earlier reaction packaged later is ignored
+			{TrxId: "r5", PostTrxId: "trx1", Sender: "alice", TimeStamp: 105, Reaction: "👍"},
+			//undo
+			{TrxId: "r6", PostTrxId: "trx1", Sender: "bob", TimeStamp: 140, Reaction: ""},
+			{TrxId: "r7", PostTrxId: "trx1", Sender: "bob", TimeStamp: 135, Reaction: "Like"},
+		},
+	}
+	for i, reactions := range blocks {
+		if err := appdb.AddMetaByTrx("group1", &ParsedBlock{BlockId: "block", Reactions: reactions}); err != nil {
+			t.Fatalf("add meta of block %d err: %s", i, err)
+		}
+	}
+	//indexed reactions are skipped
+	if err := appdb.AddMetaByTrx("group1", &ParsedBlock{BlockId: "block", Reactions: blocks[0]}); err != nil {
+		t.Fatalf("add meta err: %s", err)
+	}
+
+	want := map[string]int64{"Dislike": 1, "👍": 1}
+	mine := map[string]string{"alice": "Dislike", "bob": "", "carol": "👍", "dave": ""}
+	for sender, reaction := range mine {
+		counts, got, err := appdb.GetReactions("group1", "trx1", sender)
+		if err != nil {
+			t.Fatalf("get reactions err: %s", err)
+		}
+		if len(counts) != len(want) {
+			t.Errorf("reaction counts should be %v, got %v", want, counts)
+		}
+		for k, v := range want {
+			if counts[k] != v {
+				t.Errorf("reaction counts should be %v, got %v", want, counts)
+			}
+		}
+		if got != reaction {
+			t.Errorf("reaction of %s should be %q, got %q", sender, reaction, got)
+		}
+	}
+
+	if counts, _, err := appdb.GetReactions("group1", "trx2", "alice"); err != nil || len(counts) != 0 {
+		t.Errorf("post without reactions should have no counts, got %v (%v)", counts, err)
+	}
+}
+
+func TestPendingAndDeletedReactions(t *testing.T) {
+	appdb := newTestAppDb(t)
+
+	//reactions to a post not indexed yet are counted when the post comes
+	pending := &ParsedBlock{BlockId: "block1", Pending: []*Reaction{
+		{TrxId: "r1", PostTrxId: "trx1", Sender: "alice", TimeStamp: 100, Reaction: "Like"},
+		{TrxId: "r2", PostTrxId: "trx1", Sender: "bob", TimeStamp: 110, Reaction: "Like"},
+	}}
+	if err := appdb.AddMetaByTrx("group1", pending); err != nil {
+		t.Fatalf("add meta err: %s", err)
+	}
+	if counts, _, _ := appdb.GetReactions("group1", "trx1", "alice"); len(counts) != 0 {
+		t.Errorf("pending reactions should not be counted, got %v", counts)
+	}
+
+	post := &ParsedBlock{BlockId: "block2", Trxs: []*quorumpb.Trx{{TrxId: "trx1", Type: quorumpb.TrxType_POST, SenderPubkey: "carol"}}}
+	if err := appdb.AddMetaByTrx("group1", post); err != nil {
+		t.Fatalf("add meta err: %s", err)
+	}
+	counts, mine, err := appdb.GetReactions("group1", "trx1", "alice")
+	if err != nil || counts["Like"] != 2 || mine != "Like" {
+		t.Errorf("pending reactions should be counted after the post, got %v, %q (%v)", counts, mine, err)
+	}
+	//pending reactions are counted once
+	if err := appdb.AddMetaByTrx("group1", &ParsedBlock{BlockId: "block3", Trxs: post.Trxs}); err != nil {
+		t.Fatalf("add meta err: %s", err)
+	}
+	if counts, _, _ := appdb.GetReactions("group1", "trx1", "alice"); counts["Like"] != 2 {
+		t.Errorf("pending reactions should be counted once, got %v", counts)
+	}
+
+	//reactions are removed with the post
+	deleted := &ParsedBlock{BlockId: "block4", Docs: []*SearchDoc{{TrxId: "trx1", Deleted: true}}, Pending: []*Reaction{
+		{TrxId: "r3", PostTrxId: "trx1", Sender: "dave", TimeStamp: 120, Reaction: "Like"},
+	}}
+	if err := appdb.AddMetaByTrx("group1", deleted); err != nil {
+		t.Fatalf("add meta err: %s", err)
+	}
+	counts, mine, err = appdb.GetReactions("group1", "trx1", "alice")
+	if err != nil || len(counts) != 0 || mine != "" {
+		t.Errorf("reactions of deleted post should be removed, got %v, %q (%v)", counts, mine, err)
+	}
+	found := false
+	p := []byte(RCP_PREFIX)
+	appdb.Db.PrefixForeachKey(p, p, false, func(k []byte, err error) error {
+		found = true
+		return nil
+	})
+	if found {
+		t.Errorf("pending reactions of deleted post should be removed")
+	}
+}
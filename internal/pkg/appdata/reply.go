@@ -0,0 +1,193 @@
+package appdata
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+	"github.com/rumsystem/quorum/internal/pkg/storage"
+)
+
+const RPL_PREFIX string = "rpl_" //replies, rpl_<groupid>_<parent trxid>_<timestamp>_<trxid>
+const RPC_PREFIX string = "rpc_" //count of direct replies, rpc_<groupid>_<trxid>
+const RPP_PREFIX string = "rpp_" //parent of a reply, rpp_<groupid>_<trxid>, value is <parent trxid>_<timestamp>
+
+//Reply is a POST replied to an earlier POST of the same group
+type Reply struct {
+	TrxId       string
+	ParentTrxId string
+	Sender      string
+	TimeStamp   int64
+}
+
+//NewReply returns the reply of a POST object, nil if the object is not a reply to a POST of the group
+func NewReply(groupid string, trx *quorumpb.Trx, obj *quorumpb.Object) *Reply {
+	if obj.Inreplyto == nil || obj.Inreplyto.Trxid == "" || obj.Inreplyto.Trxid == trx.TrxId {
+		return nil
+	}
+	if obj.Inreplyto.Groupid != "" && obj.Inreplyto.Groupid != groupid {
+		return nil
+	}
+	return &Reply{TrxId: trx.TrxId, ParentTrxId: obj.Inreplyto.Trxid, Sender: trx.SenderPubkey, TimeStamp: trx.TimeStamp}
+}
+
+func getReplyPrefix(groupid string, parentTrxId string) string {
+	return fmt.Sprintf("%s%s_%s_", RPL_PREFIX, groupid, parentTrxId)
+}
+
+//timestamp is zero padded, so the replies are ordered by time
+func getReplyKey(groupid string, reply *Reply) []byte {
+	return []byte(fmt.Sprintf("%s%020d_%s", getReplyPrefix(groupid, reply.ParentTrxId), reply.TimeStamp, reply.TrxId))
+}
+
+func getReplyCountKey(groupid string, trxid string) []byte {
+	return []byte(fmt.Sprintf("%s%s_%s", RPC_PREFIX, groupid, trxid))
+}
+
+func getReplyParentKey(groupid string, trxid string) []byte {
+	return []byte(fmt.Sprintf("%s%s_%s", RPP_PREFIX, groupid, trxid))
+}
+
+//indexReplies writes the replies and the reply counts of the parents in txn, the replies already indexed are skipped
+func indexReplies(txn storage.Txn, groupid string, replies []*Reply) error {
+	for _, reply := range replies {
+		key := getReplyKey(groupid, reply)
+		exist, err := txn.IsExist(key)
+		if err != nil {
+			return err
+		}
+		if exist {
+			continue
+		}
+		if err := txn.Set(key, []byte(reply.Sender)); err != nil {
+			return err
+		}
+		parent := fmt.Sprintf("%s_%d", reply.ParentTrxId, reply.TimeStamp)
+		if err := txn.Set(getReplyParentKey(groupid, reply.TrxId), []byte(parent)); err != nil {
+			return err
+		}
+		if err := setReplyCount(txn, groupid, reply.ParentTrxId, 1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//removeReply removes the reply of a deleted POST and decreases the reply count of its parent in txn, nothing is
+//removed if the POST is not a reply
+func removeReply(txn storage.Txn, groupid string, trxid string) error {
+	parentKey := getReplyParentKey(groupid, trxid)
+	exist, err := txn.IsExist(parentKey)
+	if err != nil || !exist {
+		return err
+	}
+	value, err := txn.Get(parentKey)
+	if err != nil {
+		return err
+	}
+	idx := strings.LastIndex(string(value), "_")
+	if idx <= 0 {
+		return errors.New("INVALID_REPLY_PARENT")
+	}
+	timestamp, err := strconv.ParseInt(string(value[idx+1:]), 10, 64)
+	if err != nil {
+		return err
+	}
+	reply := &Reply{TrxId: trxid, ParentTrxId: string(value[:idx]), TimeStamp: timestamp}
+
+	if err := txn.Delete(getReplyKey(groupid, reply)); err != nil {
+		return err
+	}
+	if err := txn.Delete(parentKey); err != nil {
+		return err
+	}
+	return setReplyCount(txn, groupid, reply.ParentTrxId, -1)
+}
+
+//setReplyCount adds delta to the reply count of the POST, the count is removed when it is down to zero
+func setReplyCount(txn storage.Txn, groupid string, trxid string, delta int64) error {
+	count, err := getReplyCount(txn, groupid, trxid)
+	if err != nil {
+		return err
+	}
+	count += delta
+	if count <= 0 {
+		return txn.Delete(getReplyCountKey(groupid, trxid))
+	}
+	return txn.Set(getReplyCountKey(groupid, trxid), []byte(strconv.FormatInt(count, 10)))
+}
+
+func getReplyCount(db indexReader, groupid string, trxid string) (int64, error) {
+	key := getReplyCountKey(groupid, trxid)
+	exist, err := db.IsExist(key)
+	if err != nil || !exist {
+		return 0, err
+	}
+	value, err := db.Get(key)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(string(value), 10, 64)
+}
+
+//GetReplyCount returns the count of direct replies of the POST
+func (appdb *AppDb) GetReplyCount(groupid string, trxid string) (int64, error) {
+	return getReplyCount(appdb.Db, groupid, trxid)
+}
+
+//GetReplies returns the direct replies of the POST ordered by time, starts after the reply starttrx
+func (appdb *AppDb) GetReplies(groupid string, parentTrxId string, starttrx string, num int, reverse bool) ([]*Reply, error) {
+	prefix := getReplyPrefix(groupid, parentTrxId)
+	replies := []*Reply{}
+
+	p := []byte(prefix)
+	if reverse {
+		p = append(p, 0xff)
+	}
+
+	runcollector := starttrx == ""
+	err := appdb.Db.PrefixForeachKey(p, []byte(prefix), reverse, func(k []byte, err error) error {
+		if err != nil {
+			return err
+		}
+
+		key := string(k[len(prefix):])
+		idx := strings.Index(key, "_")
+		if idx <= 0 {
+			return nil
+		}
+		timestamp, err := strconv.ParseInt(key[:idx], 10, 64)
+		if err != nil {
+			return nil
+		}
+		trxid := key[idx+1:]
+
+		if runcollector {
+			replies = append(replies, &Reply{TrxId: trxid, ParentTrxId: parentTrxId, TimeStamp: timestamp})
+		}
+		if trxid == starttrx { //start collecting after this item
+			runcollector = true
+		}
+		if len(replies) == num {
+			// use this to break loop
+			return errors.New("OK")
+		}
+		return nil
+	})
+
+	if err != nil && err.Error() != "OK" {
+		return nil, err
+	}
+
+	//values are loaded after the keys are scanned
+	for _, reply := range replies {
+		value, err := appdb.Db.Get(getReplyKey(groupid, reply))
+		if err != nil {
+			return nil, err
+		}
+		reply.Sender = string(value)
+	}
+	return replies, nil
+}
@@ -0,0 +1,116 @@
+package appdata
+
+import (
+	"strings"
+	"testing"
+
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+)
+
+func replyTrxIds(t *testing.T, appdb *AppDb, parent string, starttrx string, num int, reverse bool) string {
+	replies, err := appdb.GetReplies("group1", parent, starttrx, num, reverse)
+	if err != nil {
+		t.Fatalf("get replies of %s err: %s", parent, err)
+	}
+	trxids := []string{}
+	for _, reply := range replies {
+		trxids = append(trxids, reply.TrxId)
+	}
+	return strings.Join(trxids, ",")
+}
+
+func TestReplies(t *testing.T) {
+	appdb := newTestAppDb(t)
+
+	trx := &quorumpb.Trx{TrxId: "trx2", SenderPubkey: "bob", TimeStamp: 200}
+	if reply := NewReply("group1", trx, &quorumpb.Object{Inreplyto: &quorumpb.Reply{Trxid: "trx1", Groupid: "group2"}}); reply != nil {
+		t.Errorf("reply to other group should be ignored")
+	}
+	if reply := NewReply("group1", trx, &quorumpb.Object{}); reply != nil {
+		t.Errorf("object without inreplyto is not a reply")
+	}
+
+	replies := []*Reply{
+		NewReply("group1", trx, &quorumpb.Object{Inreplyto: &quorumpb.Reply{Trxid: "trx1"}}),
+		{TrxId: "trx3", ParentTrxId: "trx1", Sender: "carol", TimeStamp: 300},
+		{TrxId: "trx4", ParentTrxId: "trx2", Sender: "alice", TimeStamp: 400},
+		{TrxId: "trx5", ParentTrxId: "trx1", Sender: "bob", TimeStamp: 1000},
+	}
+	if err := appdb.AddMetaByTrx("group1", &ParsedBlock{BlockId: "block1", Replies: replies}); err != nil {
+		t.Fatalf("add meta err: %s", err)
+	}
+	//indexed replies are skipped
+	if err := appdb.AddMetaByTrx("group1", &ParsedBlock{BlockId: "block1", Replies: replies[:2]}); err != nil {
+		t.Fatalf("add meta err: %s", err)
+	}
+
+	counts := map[string]int64{"trx1": 3, "trx2": 1, "trx3": 0}
+	for trxid, want := range counts {
+		if count, err := appdb.GetReplyCount("group1", trxid); err != nil || count != want {
+			t.Errorf("reply count of %s should be %d, got %d (%v)", trxid, want, count, err)
+		}
+	}
+
+	cases := []struct {
+		starttrx string
+		num      int
+		reverse  bool
+		want     string
+	}{
+		{"", 10, false, "trx2,trx3,trx5"},
+		{"", 10, true, "trx5,trx3,trx2"},
+		{"", 2, false, "trx2,trx3"},
+		{"trx3", 2, false, "trx5"},
+		{"trx5", 2, true, "trx3,trx2"},
+	}
+	for _, c := range cases {
+		if got := replyTrxIds(t, appdb, "trx1", c.starttrx, c.num, c.reverse); got != c.want {
+			t.Errorf("replies from %q num %d reverse %v should be %s, got %s", c.starttrx, c.num, c.reverse, c.want, got)
+		}
+	}
+
+	replies, err := appdb.GetReplies("group1", "trx2", "", 10, false)
+	if err != nil || len(replies) != 1 || replies[0].Sender != "alice" || replies[0].TimeStamp != 400 {
+		t.Errorf("replies of trx2 should be trx4 by alice at 400, got %+v (%v)", replies, err)
+	}
+}
+
+func TestDeleteReply(t *testing.T) {
+	appdb := newTestAppDb(t)
+
+	replies := []*Reply{
+		{TrxId: "trx2", ParentTrxId: "trx1", Sender: "bob", TimeStamp: 200},
+		{TrxId: "trx3", ParentTrxId: "trx1", Sender: "carol", TimeStamp: 300},
+		{TrxId: "trx4", ParentTrxId: "trx2", Sender: "alice", TimeStamp: 400},
+	}
+	if err := appdb.AddMetaByTrx("group1", &ParsedBlock{BlockId: "block1", Replies: replies}); err != nil {
+		t.Fatalf("add meta err: %s", err)
+	}
+
+	//the post replied is not a reply, deleting it keeps its replies
+	deleted := []*SearchDoc{{TrxId: "trx3", Deleted: true}, {TrxId: "trx4", Deleted: true}, {TrxId: "trx1", Deleted: true}}
+	if err := appdb.AddMetaByTrx("group1", &ParsedBlock{BlockId: "block2", Docs: deleted}); err != nil {
+		t.Fatalf("add meta err: %s", err)
+	}
+
+	if got := replyTrxIds(t, appdb, "trx1", "", 10, false); got != "trx2" {
+		t.Errorf("replies of trx1 should be trx2, got %s", got)
+	}
+	if got := replyTrxIds(t, appdb, "trx2", "", 10, false); got != "" {
+		t.Errorf("replies of trx2 should be removed, got %s", got)
+	}
+	counts := map[string]int64{"trx1": 1, "trx2": 0}
+	for trxid, want := range counts {
+		if count, err := appdb.GetReplyCount("group1", trxid); err != nil || count != want {
+			t.Errorf("reply count of %s should be %d, got %d (%v)", trxid, want, count, err)
+		}
+	}
+
+	//a reply deleted again does not change the count
+	if err := appdb.AddMetaByTrx("group1", &ParsedBlock{BlockId: "block3", Docs: deleted[:1]}); err != nil {
+		t.Fatalf("add meta err: %s", err)
+	}
+	if count, _ := appdb.GetReplyCount("group1", "trx1"); count != 1 {
+		t.Errorf("reply count of trx1 should be 1 after deleted again, got %d", count)
+	}
+}
@@ -0,0 +1,494 @@
+package appdata
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+	"github.com/rumsystem/quorum/internal/pkg/storage"
+)
+
+const IDX_PREFIX string = "idx_" //term postings, idx_<groupid>_<term>\x00\x01<trxid>
+const IDD_PREFIX string = "idd_" //indexed docs, idd_<groupid>_<trxid>
+const IDS_PREFIX string = "ids_" //index stats of group, ids_<groupid>
+
+const MAX_TERM_LENGTH int = 64      //longer words are truncated
+const FIELD_POSITION_GAP int = 1000 //position gap between fields, so phrases never match across fields
+const MAX_PREFIX_TERMS int = 1000   //terms expanded from a prefix query
+
+//BM25 parameters
+const bm25K1 float64 = 1.2
+const bm25B float64 = 0.75
+
+//SearchDoc is the decrypted content of a POST trx to be indexed, the indexed doc of an edited post is replaced,
+//and of a deleted post is removed
+type SearchDoc struct {
+	TrxId     string
+	Sender    string
+	TimeStamp int64
+	Fields    []string
+	Edited    bool
+	Deleted   bool
+}
+
+//SearchQuery filters the matched docs by senders and time, and returns the ranked docs from Offset
+type SearchQuery struct {
+	Query   string
+	Senders []string
+	From    int64
+	To      int64
+	Offset  int
+	Limit   int
+}
+
+type SearchResult struct {
+	TrxId     string
+	Sender    string
+	TimeStamp int64
+	Score     float64
+}
+
+type posting struct {
+	Positions []int `json:"p"`
+}
+
+type indexedDoc struct {
+	Sender    string   `json:"s"`
+	TimeStamp int64    `json:"t"`
+	Length    int      `json:"l"`
+	Terms     []string `json:"w,omitempty"` //terms of the postings, to remove the doc
+}
+
+//indexReader is implemented by both the db and the txn
+type indexReader interface {
+	Get(key []byte) ([]byte, error)
+	IsExist([]byte) (bool, error)
+}
+
+type indexStats struct {
+	Docs   int64 `json:"d"`
+	Length int64 `json:"l"`
+}
+
+//clause is a word or a quoted phrase of the query, the last token is matched as prefix if it ends with *
+type clause struct {
+	tokens []string
+	prefix bool
+}
+
+//NewSearchDoc returns the doc of the name, summary, content and tags of a POST object
+func NewSearchDoc(trx *quorumpb.Trx, obj *quorumpb.Object) *SearchDoc {
+	doc := &SearchDoc{TrxId: trx.TrxId, Sender: trx.SenderPubkey, TimeStamp: trx.TimeStamp}
+	doc.Fields = append(doc.Fields, obj.Name, obj.Summary, obj.Content)
+	for _, tag := range obj.Tag {
+		doc.Fields = append(doc.Fields, tag.Name)
+	}
+	return doc
+}
+
+//Tokenize splits text to lower case words, each CJK character is a word
+func Tokenize(text string) []string {
+	tokens := []string{}
+	word := []rune{}
+	flush := func() {
+		if len(word) > 0 {
+			if len(word) > MAX_TERM_LENGTH {
+				word = word[:MAX_TERM_LENGTH]
+			}
+			tokens = append(tokens, string(word))
+			word = []rune{}
+		}
+	}
+	for _, r := range text {
+		switch {
+		case isCJK(r):
+			flush()
+			tokens = append(tokens, string(r))
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.Is(unicode.Mn, r):
+			word = append(word, unicode.ToLower(r))
+		default:
+			flush()
+		}
+	}
+	flush()
+	return tokens
+}
+
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
+
+func getPostingPrefix(groupid string, token string) string {
+	return fmt.Sprintf("%s%s_%s", IDX_PREFIX, groupid, token)
+}
+
+func getPostingKey(groupid string, token string, trxid string) []byte {
+	return []byte(getPostingPrefix(groupid, token) + term + trxid)
+}
+
+func getIndexedDocKey(groupid string, trxid string) []byte {
+	return []byte(fmt.Sprintf("%s%s_%s", IDD_PREFIX, groupid, trxid))
+}
+
+func getIndexStatsKey(groupid string) []byte {
+	return []byte(IDS_PREFIX + groupid)
+}
+
+//indexDocs writes the postings, docs and group stats of the docs in txn, the docs already indexed are
+//skipped, the docs of edited posts are reindexed and the docs of deleted posts are removed
+func indexDocs(txn storage.Txn, groupid string, docs []*SearchDoc) error {
+	if len(docs) == 0 {
+		return nil
+	}
+
+	stats, err := getIndexStats(txn, groupid)
+	if err != nil {
+		return err
+	}
+
+	changed := false
+	for _, doc := range docs {
+		docKey := getIndexedDocKey(groupid, doc.TrxId)
+		exist, err := txn.IsExist(docKey)
+		if err != nil {
+			return err
+		}
+		if doc.Edited || doc.Deleted {
+			if exist {
+				length, err := removeDoc(txn, groupid, doc.TrxId)
+				if err != nil {
+					return err
+				}
+				stats.Docs--
+				stats.Length -= int64(length)
+				changed = true
+			}
+			if doc.Deleted {
+				continue
+			}
+		} else if exist {
+			continue
+		}
+
+		postings := make(map[string]*posting)
+		length := 0
+		pos := 0
+		for _, field := range doc.Fields {
+			tokens := Tokenize(field)
+			for i, token := range tokens {
+				if _, ok := postings[token]; !ok {
+					postings[token] = &posting{}
+				}
+				postings[token].Positions = append(postings[token].Positions, pos+i)
+			}
+			length += len(tokens)
+			pos += len(tokens) + FIELD_POSITION_GAP
+		}
+
+		terms := []string{}
+		for token, p := range postings {
+			value, err := json.Marshal(p)
+			if err != nil {
+				return err
+			}
+			if err := txn.Set(getPostingKey(groupid, token, doc.TrxId), value); err != nil {
+				return err
+			}
+			terms = append(terms, token)
+		}
+		sort.Strings(terms)
+
+		value, err := json.Marshal(&indexedDoc{Sender: doc.Sender, TimeStamp: doc.TimeStamp, Length: length, Terms: terms})
+		if err != nil {
+			return err
+		}
+		if err := txn.Set(docKey, value); err != nil {
+			return err
+		}
+
+		stats.Docs++
+		stats.Length += int64(length)
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+	value, err := json.Marshal(stats)
+	if err != nil {
+		return err
+	}
+	return txn.Set(getIndexStatsKey(groupid), value)
+}
+
+//removeDoc deletes the postings and the indexed doc, and returns the length of the doc. Postings of the docs
+//indexed without terms are found by scanning the postings of group
+func removeDoc(txn storage.Txn, groupid string, trxid string) (int, error) {
+	doc, err := getIndexedDoc(txn, groupid, trxid)
+	if err != nil {
+		return 0, err
+	}
+
+	keys := [][]byte{}
+	if len(doc.Terms) > 0 {
+		for _, token := range doc.Terms {
+			keys = append(keys, getPostingKey(groupid, token, trxid))
+		}
+	} else {
+		p := []byte(fmt.Sprintf("%s%s_", IDX_PREFIX, groupid))
+		err := txn.PrefixForeachKey(p, p, false, func(k []byte, err error) error {
+			if err != nil {
+				return err
+			}
+			if strings.HasSuffix(string(k), term+trxid) {
+				keys = append(keys, append([]byte{}, k...))
+			}
+			return nil
+		})
+		if err != nil {
+			return 0, err
+		}
+	}
+	keys = append(keys, getIndexedDocKey(groupid, trxid))
+
+	for _, key := range keys {
+		if err := txn.Delete(key); err != nil {
+			return 0, err
+		}
+	}
+	return doc.Length, nil
+}
+
+func getIndexStats(db indexReader, groupid string) (*indexStats, error) {
+	stats := &indexStats{}
+	key := getIndexStatsKey(groupid)
+	exist, err := db.IsExist(key)
+	if err != nil || !exist {
+		return stats, err
+	}
+	value, err := db.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(value, stats)
+	return stats, err
+}
+
+func getIndexedDoc(db indexReader, groupid string, trxid string) (*indexedDoc, error) {
+	value, err := db.Get(getIndexedDocKey(groupid, trxid))
+	if err != nil {
+		return nil, err
+	}
+	doc := &indexedDoc{}
+	err = json.Unmarshal(value, doc)
+	return doc, err
+}
+
+//parseQuery splits the query to words and quoted phrases, a word or phrase ends with * is a prefix query
+func parseQuery(q string) ([]*clause, error) {
+	clauses := []*clause{}
+	addClause := func(text string) {
+		c := &clause{}
+		if strings.HasSuffix(text, "*") {
+			c.prefix = true
+			text = strings.TrimRight(text, "*")
+		}
+		if c.tokens = Tokenize(text); len(c.tokens) > 0 {
+			clauses = append(clauses, c)
+		}
+	}
+
+	for q = strings.TrimSpace(q); q != ""; q = strings.TrimSpace(q) {
+		if q[0] == '"' {
+			end := strings.IndexByte(q[1:], '"')
+			if end < 0 {
+				return nil, errors.New("unclosed quote in query")
+			}
+			phrase := q[1 : end+1]
+			q = q[end+2:]
+			if strings.HasPrefix(q, "*") {
+				phrase += "*"
+				q = q[1:]
+			}
+			addClause(phrase)
+			continue
+		}
+		end := strings.IndexAny(q, " \t\"")
+		if end < 0 {
+			end = len(q)
+		}
+		addClause(q[:end])
+		q = q[end:]
+	}
+
+	if len(clauses) == 0 {
+		return nil, errors.New("empty query")
+	}
+	return clauses, nil
+}
+
+//getPostings returns the positions of the term in docs, or of all terms starts with it for prefix
+func (appdb *AppDb) getPostings(groupid string, token string, prefix bool) (map[string][]int, error) {
+	result := make(map[string][]int)
+	p := getPostingPrefix(groupid, token)
+	if !prefix {
+		p += term
+	}
+
+	terms := make(map[string]bool)
+	err := appdb.Db.PrefixForeach([]byte(p), func(k []byte, v []byte, err error) error {
+		if err != nil {
+			return err
+		}
+		idx := strings.LastIndex(string(k), term)
+		if idx < 0 {
+			return nil
+		}
+		matchedToken, trxid := string(k[:idx]), string(k[idx+len(term):])
+		if !terms[matchedToken] {
+			if len(terms) == MAX_PREFIX_TERMS {
+				//keys are ordered by term, the rest are all new terms
+				return errors.New("OK")
+			}
+			terms[matchedToken] = true
+		}
+
+		item := &posting{}
+		if err := json.Unmarshal(v, item); err != nil {
+			return err
+		}
+		result[trxid] = append(result[trxid], item.Positions...)
+		return nil
+	})
+	if err != nil && err.Error() == "OK" {
+		err = nil
+	}
+	return result, err
+}
+
+//matchClause returns the count of the clause in docs
+func (appdb *AppDb) matchClause(groupid string, c *clause) (map[string]int, error) {
+	postings := []map[string][]int{}
+	for i, token := range c.tokens {
+		p, err := appdb.getPostings(groupid, token, c.prefix && i == len(c.tokens)-1)
+		if err != nil {
+			return nil, err
+		}
+		postings = append(postings, p)
+	}
+
+	freqs := make(map[string]int)
+	for trxid, positions := range postings[0] {
+		if len(postings) == 1 {
+			freqs[trxid] = len(positions)
+			continue
+		}
+		//phrase, the i-th token should be at the position start+i
+		count := 0
+		for _, start := range positions {
+			matched := true
+			for i := 1; i < len(postings) && matched; i++ {
+				matched = containsInt(postings[i][trxid], start+i)
+			}
+			if matched {
+				count++
+			}
+		}
+		if count > 0 {
+			freqs[trxid] = count
+		}
+	}
+	return freqs, nil
+}
+
+func containsInt(items []int, n int) bool {
+	for _, item := range items {
+		if item == n {
+			return true
+		}
+	}
+	return false
+}
+
+//SearchGroupContent returns the docs which match all words and phrases of the query, ranked by BM25
+func (appdb *AppDb) SearchGroupContent(groupid string, query *SearchQuery) ([]*SearchResult, error) {
+	clauses, err := parseQuery(query.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := getIndexStats(appdb.Db, groupid)
+	if err != nil {
+		return nil, err
+	}
+	results := []*SearchResult{}
+	if stats.Docs == 0 {
+		return results, nil
+	}
+	avgLength := float64(stats.Length) / float64(stats.Docs)
+
+	var matched map[string]bool
+	clauseFreqs := []map[string]int{}
+	for _, c := range clauses {
+		freqs, err := appdb.matchClause(groupid, c)
+		if err != nil {
+			return nil, err
+		}
+		clauseFreqs = append(clauseFreqs, freqs)
+
+		docs := make(map[string]bool)
+		for trxid := range freqs {
+			if matched == nil || matched[trxid] {
+				docs[trxid] = true
+			}
+		}
+		matched = docs
+	}
+
+	sendermap := make(map[string]bool)
+	for _, s := range query.Senders {
+		sendermap[s] = true
+	}
+	for trxid := range matched {
+		doc, err := getIndexedDoc(appdb.Db, groupid, trxid)
+		if err != nil {
+			return nil, err
+		}
+		if len(sendermap) > 0 && !sendermap[doc.Sender] {
+			continue
+		}
+		if (query.From > 0 && doc.TimeStamp < query.From) || (query.To > 0 && doc.TimeStamp > query.To) {
+			continue
+		}
+
+		score := 0.0
+		for _, freqs := range clauseFreqs {
+			df := float64(len(freqs))
+			tf := float64(freqs[trxid])
+			idf := math.Log(1 + (float64(stats.Docs)-df+0.5)/(df+0.5))
+			score += idf * tf * (bm25K1 + 1) / (tf + bm25K1*(1-bm25B+bm25B*float64(doc.Length)/avgLength))
+		}
+		results = append(results, &SearchResult{TrxId: trxid, Sender: doc.Sender, TimeStamp: doc.TimeStamp, Score: score})
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].TimeStamp > results[j].TimeStamp
+	})
+
+	if query.Offset >= len(results) {
+		return []*SearchResult{}, nil
+	}
+	results = results[query.Offset:]
+	if query.Limit > 0 && len(results) > query.Limit {
+		results = results[:query.Limit]
+	}
+	return results, nil
+}
@@ -0,0 +1,161 @@
+package appdata
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/rumsystem/quorum/internal/pkg/storage"
+)
+
+func newTestAppDb(t *testing.T) *AppDb {
+	db := storage.QSBadger{}
+	if err := db.Init(t.TempDir() + "/appdb"); err != nil {
+		t.Fatalf("db init err: %s", err)
+	}
+	appdb := NewAppDb()
+	appdb.Db = &db
+	t.Cleanup(appdb.Close)
+	return appdb
+}
+
+func searchTrxIds(t *testing.T, appdb *AppDb, query *SearchQuery) []string {
+	results, err := appdb.SearchGroupContent("group1", query)
+	if err != nil {
+		t.Fatalf("search %q err: %s", query.Query, err)
+	}
+	trxids := []string{}
+	for _, result := range results {
+		trxids = append(trxids, result.TrxId)
+	}
+	return trxids
+}
+
+func TestSearchGroupContent(t *testing.T) {
+	appdb := newTestAppDb(t)
+	docs := []*SearchDoc{
+		{TrxId: "trx1", Sender: "alice", TimeStamp: 100, Fields: []string{"Hello World", "", "the quick brown fox jumps over the lazy dog"}},
+		{TrxId: "trx2", Sender: "bob", TimeStamp: 200, Fields: []string{"", "", "brown quick fox, quick quick"}},
+		{TrxId: "trx3", Sender: "alice", TimeStamp: 300, Fields: []string{"", "", "你好世界，quickly"}},
+	}
+	if err := appdb.AddMetaByTrx("group1", &ParsedBlock{BlockId: "block1", Docs: docs}); err != nil {
+		t.Fatalf("add meta err: %s", err)
+	}
+	//indexed docs are skipped
+	if err := appdb.AddMetaByTrx("group1", &ParsedBlock{BlockId: "block1", Docs: docs}); err != nil {
+		t.Fatalf("add meta err: %s", err)
+	}
+	if stats, _ := getIndexStats(appdb.Db, "group1"); stats.Docs != 3 {
+		t.Errorf("indexed docs should be 3, got %d", stats.Docs)
+	}
+
+	cases := []struct {
+		query *SearchQuery
+		want  []string
+	}{
+		{&SearchQuery{Query: "quick"}, []string{"trx2", "trx1"}},
+		{&SearchQuery{Query: "QUICK fox"}, []string{"trx2", "trx1"}},
+		{&SearchQuery{Query: `"quick brown"`}, []string{"trx1"}},
+		{&SearchQuery{Query: `"world the"`}, []string{}},
+		{&SearchQuery{Query: "quick*"}, []string{"trx2", "trx3", "trx1"}},
+		{&SearchQuery{Query: "世界"}, []string{"trx3"}},
+		{&SearchQuery{Query: "界世"}, []string{}},
+		{&SearchQuery{Query: "quick", Senders: []string{"alice"}}, []string{"trx1"}},
+		{&SearchQuery{Query: "quick*", From: 150, To: 300}, []string{"trx2", "trx3"}},
+		{&SearchQuery{Query: "quick*", Offset: 1, Limit: 1}, []string{"trx3"}},
+	}
+	for _, c := range cases {
+		got := searchTrxIds(t, appdb, c.query)
+		if len(got) != len(c.want) {
+			t.Errorf("search %+v should return %v, got %v", c.query, c.want, got)
+			continue
+		}
+		for i := range got {
+			if got[i] != c.want[i] {
+				t.Errorf("search %+v should return %v, got %v", c.query, c.want, got)
+				break
+			}
+		}
+	}
+
+	if _, err := appdb.SearchGroupContent("group1", &SearchQuery{Query: `"unclosed`}); err == nil {
+		t.Errorf("unclosed quote should be rejected")
+	}
+}
+
+func TestReindexGroupContent(t *testing.T) {
+	appdb := newTestAppDb(t)
+	docs := []*SearchDoc{
+		{TrxId: "trx1", Sender: "alice", TimeStamp: 100, Fields: []string{"", "", "hello quick fox"}},
+		{TrxId: "trx2", Sender: "bob", TimeStamp: 200, Fields: []string{"", "", "hello lazy dog"}},
+	}
+	if err := appdb.AddMetaByTrx("group1", &ParsedBlock{BlockId: "block1", Docs: docs}); err != nil {
+		t.Fatalf("add meta err: %s", err)
+	}
+
+	//docs indexed without terms are removed by scanning the postings
+	legacy, err := json.Marshal(&indexedDoc{Sender: "bob", TimeStamp: 200, Length: 3})
+	if err != nil {
+		t.Fatalf("marshal doc err: %s", err)
+	}
+	if err := appdb.Db.Set(getIndexedDocKey("group1", "trx2"), legacy); err != nil {
+		t.Fatalf("set doc err: %s", err)
+	}
+
+	updates := []*SearchDoc{
+		{TrxId: "trx1", Sender: "alice", TimeStamp: 100, Fields: []string{"", "", "hello slow turtle"}, Edited: true},
+		{TrxId: "trx2", Deleted: true},
+	}
+	if err := appdb.AddMetaByTrx("group1", &ParsedBlock{BlockId: "block2", Docs: updates}); err != nil {
+		t.Fatalf("add meta err: %s", err)
+	}
+
+	cases := []struct {
+		query string
+		want  []string
+	}{
+		{"hello", []string{"trx1"}},
+		{"quick", []string{}},
+		{"turtle", []string{"trx1"}},
+		{"dog", []string{}},
+	}
+	for _, c := range cases {
+		got := searchTrxIds(t, appdb, &SearchQuery{Query: c.query})
+		if strings.Join(got, ",") != strings.Join(c.want, ",") {
+			t.Errorf("search %q should return %v, got %v", c.query, c.want, got)
+		}
+	}
+
+	stats, err := getIndexStats(appdb.Db, "group1")
+	if err != nil {
+		t.Fatalf("get stats err: %s", err)
+	}
+	if stats.Docs != 1 || stats.Length != 3 {
+		t.Errorf("stats should be 1 doc of length 3, got %d docs of length %d", stats.Docs, stats.Length)
+	}
+}
+
+func TestPrefixTermsLimit(t *testing.T) {
+	appdb := newTestAppDb(t)
+	words := []string{}
+	for i := 0; i <= MAX_PREFIX_TERMS; i++ {
+		words = append(words, fmt.Sprintf("word%04d", i))
+	}
+	docs := []*SearchDoc{
+		{TrxId: "trx1", Sender: "alice", TimeStamp: 100, Fields: []string{"", "", strings.Join(words[:MAX_PREFIX_TERMS], " ")}},
+		{TrxId: "trx2", Sender: "bob", TimeStamp: 200, Fields: []string{"", "", words[MAX_PREFIX_TERMS]}},
+	}
+	if err := appdb.AddMetaByTrx("group1", &ParsedBlock{BlockId: "block1", Docs: docs}); err != nil {
+		t.Fatalf("add meta err: %s", err)
+	}
+
+	//terms over the limit are not expanded
+	postings, err := appdb.getPostings("group1", "word", true)
+	if err != nil {
+		t.Fatalf("get postings err: %s", err)
+	}
+	if len(postings) != 1 || len(postings["trx1"]) != MAX_PREFIX_TERMS {
+		t.Errorf("prefix should expand to %d terms of trx1, got %d docs, %d positions of trx1", MAX_PREFIX_TERMS, len(postings), len(postings["trx1"]))
+	}
+}
@@ -46,13 +46,113 @@ func highestBlockIdToStr(HighestBlockId []string) string {
 */
 func (appsync *AppSync) ParseBlockTrxs(groupid string, block *quorumpb.Block) ([]*quorumpb.Block, error) {
 	appsynclog.Infof("ParseBlockTrxs %d trx(s) on group %s", len(block.Trxs), groupid)
-	err := appsync.appdb.AddMetaByTrx(block.BlockId, groupid, block.Trxs)
+	err := appsync.appdb.AddMetaByTrx(groupid, appsync.parsePostTrxs(groupid, block))
 	if err != nil {
 		appsynclog.Errorf("ParseBlockTrxs on group %s err:  ", groupid, err)
 	}
 	return appsync.dbmgr.GetSubBlock(block.BlockId, appsync.nodename)
 }
 
+//parsePostTrxs returns the trxs of new posts, the docs to be indexed, the replies and the reactions of the block,
+//the trxs can not be decrypted are not indexed. Update, Delete and reaction activities are not posts, the post
+//Update or Delete applied to is reindexed or removed
+func (appsync *AppSync) parsePostTrxs(groupid string, block *quorumpb.Block) *ParsedBlock {
+	parsed := &ParsedBlock{BlockId: block.BlockId}
+	group, ok := appsync.groupmgr.Groups[groupid]
+	if !ok {
+		parsed.Trxs = block.Trxs
+		return parsed
+	}
+	for _, trx := range block.Trxs {
+		if trx.Type != quorumpb.TrxType_POST {
+			continue
+		}
+		data, err := chain.DecryptTrxData(group.Item, trx, appsync.nodename)
+		if err != nil {
+			appsynclog.Debugf("<%s> decrypt trx <%s> for search index failed: %s", groupid, trx.TrxId, err)
+			parsed.Trxs = append(parsed.Trxs, trx)
+			continue
+		}
+
+		if activity := chain.GetPostActivity(trx.TrxId, data); activity != nil {
+			if chain.IsReaction(activity) {
+				appsync.addReaction(parsed, groupid, trx, activity)
+				continue
+			}
+			if doc := appsync.getUpdatedDoc(groupid, trx, activity); doc != nil {
+				parsed.Docs = append(parsed.Docs, doc)
+			}
+			continue
+		}
+
+		parsed.Trxs = append(parsed.Trxs, trx)
+		ctnobj, _, err := quorumpb.BytesToMessage(trx.TrxId, data)
+		if err != nil {
+			continue
+		}
+		if obj, ok := ctnobj.(*quorumpb.Object); ok {
+			parsed.Docs = append(parsed.Docs, NewSearchDoc(trx, obj))
+			if reply := NewReply(groupid, trx, obj); reply != nil {
+				parsed.Replies = append(parsed.Replies, reply)
+			}
+		}
+	}
+	return parsed
+}
+
+//addReaction adds the reaction of the trx to the parsed block, invalid reaction and the reaction to a deleted post
+//are skipped, the reaction to a post not found is pending until the post is indexed
+func (appsync *AppSync) addReaction(parsed *ParsedBlock, groupid string, trx *quorumpb.Trx, activity *quorumpb.Activity) {
+	if activity.Object == nil || activity.Object.Id == "" {
+		return
+	}
+	if activity.Type == chain.POST_EMOJI_REACT && (activity.Content == "" || len(activity.Content) > chain.MAX_REACTION_LENGTH) {
+		return
+	}
+	reaction := &Reaction{TrxId: trx.TrxId, PostTrxId: activity.Object.Id, Sender: trx.SenderPubkey, TimeStamp: trx.TimeStamp, Reaction: chain.GetReaction(activity)}
+	post, err := appsync.dbmgr.GetPost(groupid, activity.Object.Id, appsync.nodename)
+	if err != nil {
+		if err.Error() == "POST_NOT_FOUND" {
+			parsed.Pending = append(parsed.Pending, reaction)
+		} else {
+			appsynclog.Warningf("<%s> get post of reaction <%s> failed: %s", groupid, trx.TrxId, err)
+		}
+		return
+	}
+	if post.Deleted {
+		return
+	}
+	reaction.PostTrxId = post.TrxId
+	parsed.Reactions = append(parsed.Reactions, reaction)
+}
+
+//getUpdatedDoc returns the doc of the post updated or deleted by the trx, the activity rejected or overridden
+//by a later one is skipped
+func (appsync *AppSync) getUpdatedDoc(groupid string, trx *quorumpb.Trx, activity *quorumpb.Activity) *SearchDoc {
+	if activity.Object == nil {
+		return nil
+	}
+	post, err := appsync.dbmgr.GetPost(groupid, activity.Object.Id, appsync.nodename)
+	if err != nil || post.UpdatedTrxId != trx.TrxId {
+		return nil
+	}
+	if post.Deleted {
+		return &SearchDoc{TrxId: post.TrxId, Deleted: true}
+	}
+
+	ctnobj, _, err := quorumpb.BytesToMessage(post.TrxId, post.Content)
+	if err != nil {
+		return nil
+	}
+	obj, ok := ctnobj.(*quorumpb.Object)
+	if !ok {
+		return nil
+	}
+	doc := NewSearchDoc(&quorumpb.Trx{TrxId: post.TrxId, SenderPubkey: post.PublisherPubkey, TimeStamp: post.TimeStamp}, obj)
+	doc.Edited = true
+	return doc
+}
+
 func (appsync *AppSync) RunSync(groupid string, lastBlockId string, newBlockId string) {
 	var blocks []*quorumpb.Block
 	subblocks, err := appsync.dbmgr.GetSubBlock(lastBlockId, appsync.nodename)
@@ -0,0 +1,78 @@
+package chain
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+	"github.com/rumsystem/quorum/internal/pkg/storage"
+	"google.golang.org/protobuf/proto"
+)
+
+//GetAnnounceResultHash returns the hash signed by group owner to approve or reject an announced item
+func GetAnnounceResultHash(item *quorumpb.AnnounceItem) []byte {
+	var buffer bytes.Buffer
+	buffer.Write([]byte(item.GroupId))
+	buffer.Write([]byte(item.SignPubkey))
+	buffer.Write([]byte(item.EncryptPubkey))
+	buffer.Write([]byte(item.Type.String()))
+	buffer.Write([]byte(item.Result.String()))
+	buffer.Write([]byte(item.OwnerPubkey))
+	return Hash(buffer.Bytes())
+}
+
+//applyAnnounceTrx saves an announced item, if the user is already approved (by a result arrived before the
+//announce), cipher key of current key epoch is wrapped to the user after it is saved
+func applyAnnounceTrx(dbMgr *storage.DbMgr, trx *quorumpb.Trx, grpItem *quorumpb.GroupItem, cIface ChainMolassesIface, nodename string) error {
+	if err := dbMgr.UpdateAnnounce(trx, nodename); err != nil {
+		return err
+	}
+
+	item := &quorumpb.AnnounceItem{}
+	if err := proto.Unmarshal(trx.Data, item); err != nil {
+		return err
+	}
+	if item.Type != quorumpb.AnnounceType_AS_USER {
+		return nil
+	}
+	if announced, err := dbMgr.GetAnnouncedUser(item.GroupId, item.SignPubkey, nodename); err == nil && announced.Result == quorumpb.ApproveType_APPROVED {
+		dbMgr.OnCommit(func() { rewrapGroupKey(grpItem, cIface, nodename) })
+	}
+	return nil
+}
+
+//applyAnnounceResultTrx saves the result of an announced user, the result must be signed by group owner.
+//Cipher key of current key epoch is wrapped to the approved user after it is saved
+func applyAnnounceResultTrx(dbMgr *storage.DbMgr, trx *quorumpb.Trx, grpItem *quorumpb.GroupItem, cIface ChainMolassesIface, nodename string) error {
+	if trx.SenderPubkey != grpItem.OwnerPubKey {
+		return errors.New("ANNOUNCE_RESULT trx not sent by group owner")
+	}
+
+	item := &quorumpb.AnnounceItem{}
+	if err := proto.Unmarshal(trx.Data, item); err != nil {
+		return err
+	}
+	if item.GroupId != grpItem.GroupId || item.OwnerPubkey != grpItem.OwnerPubKey {
+		return errors.New("ANNOUNCE_RESULT item mismatch")
+	}
+	if item.Type != quorumpb.AnnounceType_AS_USER || item.Result == quorumpb.ApproveType_ANNOUNCED {
+		return errors.New("ANNOUNCE_RESULT result invalid")
+	}
+
+	ownerSign, err := hex.DecodeString(item.OwnerSignature)
+	if err != nil {
+		return err
+	}
+	if valid, err := verifyBySignPubkey(item.OwnerPubkey, GetAnnounceResultHash(item), ownerSign); err != nil || !valid {
+		return errors.New("ANNOUNCE_RESULT owner sign invalid")
+	}
+
+	if err := dbMgr.UpdateAnnounceResult(trx, nodename); err != nil {
+		return err
+	}
+	if item.Result == quorumpb.ApproveType_APPROVED {
+		dbMgr.OnCommit(func() { rewrapGroupKey(grpItem, cIface, nodename) })
+	}
+	return nil
+}
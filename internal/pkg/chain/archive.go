@@ -0,0 +1,178 @@
+package chain
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"time"
+
+	logging "github.com/ipfs/go-log/v2"
+	p2pcrypto "github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/rumsystem/quorum/internal/pkg/nodectx"
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+var archive_log = logging.Logger("archive")
+
+const GROUP_ARCHIVE_VERSION int32 = 1
+
+//ExportGroup returns the archive of the group, blocks are in the order of the block chunks from the genesis
+//block, the user keys and chain info of this node are not exported
+func ExportGroup(item *quorumpb.GroupItem) (*quorumpb.GroupArchive, error) {
+	archive_log.Debugf("<%s> ExportGroup called", item.GroupId)
+	dbMgr := nodectx.GetDbMgr()
+	nodename := nodectx.GetNodeCtx().Name
+
+	archive := newGroupArchive(item)
+	blockIds := []string{item.GenesisBlock.BlockId}
+	for len(blockIds) > 0 {
+		var blockId string
+		blockId, blockIds = blockIds[0], blockIds[1:]
+		subBlocks, err := dbMgr.GetSubBlock(blockId, nodename)
+		if err != nil {
+			return nil, err
+		}
+		for _, block := range subBlocks {
+			archive.Blocks = append(archive.Blocks, block)
+			blockIds = append(blockIds, block.BlockId)
+		}
+	}
+
+	archive_log.Infof("<%s> exported <%d> blocks", item.GroupId, len(archive.Blocks))
+	return archive, nil
+}
+
+//newGroupArchive returns an archive without blocks, the user keys and chain info of this node are removed from item
+func newGroupArchive(item *quorumpb.GroupItem) *quorumpb.GroupArchive {
+	groupItem := proto.Clone(item).(*quorumpb.GroupItem)
+	groupItem.UserSignPubkey = ""
+	groupItem.UserEncryptPubkey = ""
+	groupItem.HighestBlockId = ""
+	groupItem.HighestHeight = 0
+	groupItem.LastUpdate = 0
+	return &quorumpb.GroupArchive{Version: GROUP_ARCHIVE_VERSION, GroupItem: groupItem, TimeStamp: time.Now().UnixNano()}
+}
+
+//VerifyGroupArchive verifies the hash and signature of the genesis block and every block with its parent,
+//it works without node context
+func VerifyGroupArchive(archive *quorumpb.GroupArchive) error {
+	if archive.Version != GROUP_ARCHIVE_VERSION {
+		return fmt.Errorf("unsupported archive version %d", archive.Version)
+	}
+	item := archive.GroupItem
+	if item == nil || item.GenesisBlock == nil {
+		return errors.New("group item or genesis block is missing")
+	}
+
+	genesisBlock := item.GenesisBlock
+	if genesisBlock.GroupId != item.GroupId {
+		return errors.New("group id of genesis block mismatch")
+	}
+	if genesisBlock.ProducerPubKey != item.OwnerPubKey {
+		return errors.New("genesis block is not produced by group owner")
+	}
+	if err := verifyBlockSign(genesisBlock); err != nil {
+		return fmt.Errorf("invalid genesis block: %s", err.Error())
+	}
+
+	blocks := map[string]*quorumpb.Block{genesisBlock.BlockId: genesisBlock}
+	for _, block := range archive.Blocks {
+		if block.GroupId != item.GroupId {
+			return fmt.Errorf("block <%s> is not a block of group <%s>", block.BlockId, item.GroupId)
+		}
+		if _, ok := blocks[block.BlockId]; ok {
+			return fmt.Errorf("duplicated block <%s>", block.BlockId)
+		}
+		parent, ok := blocks[block.PrevBlockId]
+		if !ok {
+			return fmt.Errorf("parent of block <%s> is not before it", block.BlockId)
+		}
+		valid, err := IsBlockValid(block, parent)
+		if err != nil {
+			return fmt.Errorf("invalid block <%s>: %s", block.BlockId, err.Error())
+		}
+		if !valid {
+			return fmt.Errorf("invalid signature of block <%s>", block.BlockId)
+		}
+		blocks[block.BlockId] = block
+	}
+	return nil
+}
+
+//verifyBlockSign verifies the hash and signature of a block without its parent
+func verifyBlockSign(block *quorumpb.Block) error {
+	blockWithoutHash := proto.Clone(block).(*quorumpb.Block)
+	blockWithoutHash.Hash = nil
+	blockWithoutHash.Signature = nil
+	blockWithoutHash.Commits = nil
+
+	bbytes, err := proto.Marshal(blockWithoutHash)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(Hash(bbytes), block.Hash) {
+		return errors.New("Hash for new block is invalid")
+	}
+
+	serializedpub, err := p2pcrypto.ConfigDecodeKey(block.ProducerPubKey)
+	if err != nil {
+		return err
+	}
+	pubkey, err := p2pcrypto.UnmarshalPublicKey(serializedpub)
+	if err != nil {
+		return err
+	}
+	verify, err := pubkey.Verify(block.Hash, block.Signature)
+	if err != nil {
+		return err
+	}
+	if !verify {
+		return errors.New("invalid signature")
+	}
+	return nil
+}
+
+//ImportGroup verifies the archive, creates the group by item which has the user keys of this node, and
+//rebuilds the group state by replaying the trxs of every block. The group is removed if any block failed
+func (groupmgr *GroupMgr) ImportGroup(archive *quorumpb.GroupArchive, item *quorumpb.GroupItem) (*Group, error) {
+	archive_log.Debugf("<%s> ImportGroup called", item.GroupId)
+	if _, ok := groupmgr.Groups[item.GroupId]; ok {
+		return nil, errors.New("GROUP_ALREADY_EXIST")
+	}
+	if err := VerifyGroupArchive(archive); err != nil {
+		return nil, err
+	}
+
+	group := &Group{}
+	if err := group.CreateGrp(item); err != nil {
+		return nil, err
+	}
+
+	for _, block := range archive.Blocks {
+		//the consensus is recreated when producers are updated by the trxs just applied
+		chainCtx := group.ChainCtx
+		if _, ok := chainCtx.ProducerPool[block.ProducerPubKey]; !ok {
+			err := fmt.Errorf("block <%s> is produced by unregistered producer <%s>", block.BlockId, block.ProducerPubKey)
+			groupmgr.removeImportedGroup(group)
+			return nil, err
+		}
+		if err := chainCtx.Consensus.User().AddBlock(block); err != nil {
+			groupmgr.removeImportedGroup(group)
+			return nil, fmt.Errorf("replay block <%s> failed: %s", block.BlockId, err.Error())
+		}
+	}
+
+	groupmgr.Groups[item.GroupId] = group
+	archive_log.Infof("<%s> imported <%d> blocks, height <%d>", item.GroupId, len(archive.Blocks), group.Item.HighestHeight)
+	return group, nil
+}
+
+func (groupmgr *GroupMgr) removeImportedGroup(group *Group) {
+	if err := group.ClearGroup(); err != nil {
+		archive_log.Warningf("<%s> clear imported group data failed: %s", group.Item.GroupId, err.Error())
+	}
+	if err := nodectx.GetDbMgr().RmGroup(group.Item); err != nil {
+		archive_log.Warningf("<%s> remove imported group failed: %s", group.Item.GroupId, err.Error())
+	}
+}
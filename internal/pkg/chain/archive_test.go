@@ -0,0 +1,149 @@
+package chain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/libp2p/go-libp2p"
+	p2pcrypto "github.com/libp2p/go-libp2p-core/crypto"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/rumsystem/quorum/internal/pkg/nodectx"
+	"github.com/rumsystem/quorum/internal/pkg/p2p"
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+//newTestArchive returns the archive of a new group with 2 blocks after the genesis block, the group is not saved
+//so it can be imported
+func newTestArchive(t *testing.T) (*quorumpb.GroupArchive, *quorumpb.GroupItem) {
+	grpItem := newTestGroup(t)
+	if err := nodectx.GetDbMgr().RmGroup(grpItem); err != nil {
+		t.Fatalf("remove group err: %s", err)
+	}
+
+	serializedpub, err := p2pcrypto.ConfigDecodeKey(grpItem.OwnerPubKey)
+	if err != nil {
+		t.Fatalf("decode owner pubkey err: %s", err)
+	}
+	pubkey, err := p2pcrypto.UnmarshalPublicKey(serializedpub)
+	if err != nil {
+		t.Fatalf("unmarshal owner pubkey err: %s", err)
+	}
+	grpItem.GenesisBlock, err = CreateGenesisBlock(grpItem.GroupId, pubkey)
+	if err != nil {
+		t.Fatalf("create genesis block err: %s", err)
+	}
+	grpItem.HighestBlockId = grpItem.GenesisBlock.BlockId
+
+	archive := newGroupArchive(grpItem)
+	parent := grpItem.GenesisBlock
+	for i := 0; i < 2; i++ {
+		block, err := CreateBlock(parent, nil, serializedpub)
+		if err != nil {
+			t.Fatalf("create block err: %s", err)
+		}
+		block.ProducerPubKey = grpItem.OwnerPubKey
+		if block.Hash, block.Signature, err = resignTestBlock(block, grpItem.GroupId); err != nil {
+			t.Fatalf("sign block err: %s", err)
+		}
+		archive.Blocks = append(archive.Blocks, block)
+		parent = block
+	}
+	return archive, grpItem
+}
+
+//newTestNode sets a node with pubsub to the node ctx, so the channels of groups can be joined
+func newTestNode(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	host, err := libp2p.New(ctx, libp2p.ListenAddrStrings("/ip4/127.0.0.1/tcp/0"))
+	if err != nil {
+		t.Fatalf("create host err: %s", err)
+	}
+	t.Cleanup(func() { host.Close() })
+	ps, err := pubsub.NewGossipSub(ctx, host)
+	if err != nil {
+		t.Fatalf("create pubsub err: %s", err)
+	}
+	nodectx.GetNodeCtx().Node = &p2p.Node{PeerID: host.ID(), Host: host, Pubsub: ps}
+}
+
+//resignTestBlock returns the hash and signature by keyname of the block changed after it is created
+func resignTestBlock(block *quorumpb.Block, keyname string) ([]byte, []byte, error) {
+	unsigned := proto.Clone(block).(*quorumpb.Block)
+	unsigned.Hash = nil
+	unsigned.Signature = nil
+	bbytes, err := proto.Marshal(unsigned)
+	if err != nil {
+		return nil, nil, err
+	}
+	hash := Hash(bbytes)
+	signature, err := nodectx.GetNodeCtx().Keystore.SignByKeyName(keyname, hash)
+	return hash, signature, err
+}
+
+func TestVerifyGroupArchive(t *testing.T) {
+	archive, _ := newTestArchive(t)
+	if err := VerifyGroupArchive(archive); err != nil {
+		t.Fatalf("valid archive is rejected, %s", err)
+	}
+
+	other, _ := newTestKeys(t, "other")
+	cases := map[string]func(*quorumpb.GroupArchive){
+		"unsupported version": func(a *quorumpb.GroupArchive) { a.Version = GROUP_ARCHIVE_VERSION + 1 },
+		"no genesis block":    func(a *quorumpb.GroupArchive) { a.GroupItem.GenesisBlock = nil },
+		"genesis not by owner": func(a *quorumpb.GroupArchive) {
+			a.GroupItem.OwnerPubKey = other
+		},
+		"tampered genesis": func(a *quorumpb.GroupArchive) { a.GroupItem.GenesisBlock.TimeStamp++ },
+		"tampered block":   func(a *quorumpb.GroupArchive) { a.Blocks[1].TimeStamp++ },
+		"parent after":     func(a *quorumpb.GroupArchive) { a.Blocks[0], a.Blocks[1] = a.Blocks[1], a.Blocks[0] },
+		"duplicated block": func(a *quorumpb.GroupArchive) { a.Blocks = append(a.Blocks, a.Blocks[1]) },
+		"other group":      func(a *quorumpb.GroupArchive) { a.Blocks[1].GroupId = "other" },
+	}
+	for name, modify := range cases {
+		forged := proto.Clone(archive).(*quorumpb.GroupArchive)
+		modify(forged)
+		if err := VerifyGroupArchive(forged); err == nil {
+			t.Errorf("%s: archive is accepted", name)
+		}
+	}
+}
+
+func TestImportGroup(t *testing.T) {
+	archive, grpItem := newTestArchive(t)
+	newTestNode(t)
+	groupmgr := InitGroupMgr(nodectx.GetDbMgr())
+
+	//blocks of a producer not registered are rejected, and the group is removed
+	forged := proto.Clone(archive).(*quorumpb.GroupArchive)
+	other, _ := newTestKeys(t, "other")
+	forged.Blocks[1].ProducerPubKey = other
+	var err error
+	if forged.Blocks[1].Hash, forged.Blocks[1].Signature, err = resignTestBlock(forged.Blocks[1], "other"); err != nil {
+		t.Fatalf("sign block err: %s", err)
+	}
+	if err := VerifyGroupArchive(forged); err != nil {
+		t.Fatalf("archive signed by other producer is rejected, %s", err)
+	}
+	if _, err := groupmgr.ImportGroup(forged, proto.Clone(grpItem).(*quorumpb.GroupItem)); err == nil {
+		t.Fatalf("archive with a block of unregistered producer is imported")
+	}
+	if _, ok := groupmgr.Groups[grpItem.GroupId]; ok {
+		t.Errorf("group failed to import is added")
+	}
+	if exist, _ := nodectx.GetDbMgr().GroupInfoDb.IsExist([]byte(grpItem.GroupId)); exist {
+		t.Errorf("group failed to import is not removed")
+	}
+
+	group, err := groupmgr.ImportGroup(archive, proto.Clone(grpItem).(*quorumpb.GroupItem))
+	if err != nil {
+		t.Fatalf("import group err: %s", err)
+	}
+	if group.Item.HighestHeight != 2 || group.Item.HighestBlockId != archive.Blocks[1].BlockId {
+		t.Errorf("got height %d, top block %s, want 2, %s", group.Item.HighestHeight, group.Item.HighestBlockId, archive.Blocks[1].BlockId)
+	}
+	if _, err := groupmgr.ImportGroup(archive, grpItem); err == nil {
+		t.Errorf("group is imported twice")
+	}
+}
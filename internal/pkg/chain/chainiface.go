@@ -6,9 +6,14 @@ type ChainMolassesIface interface {
 	GetChainCtx() *Chain
 	GetUserTrxMgr() *TrxMgr
 	GetProducerTrxMgr() *TrxMgr
+	GetSyncTrxMgr(providerPubkey string) *TrxMgr
+	GetStreamTrxMgr(trxId string) (*TrxMgr, bool)
 	UpdChainInfo(height int64, blockId string) error
+	UpdGroupKey(item *quorumpb.GroupKeyItem) error
 	UpdProducerList()
 	CreateConsensus()
 	IsSyncerReady() bool
 	SyncBackward(block *quorumpb.Block) error
+	VerifyBlockTrxs(block *quorumpb.Block) error
+	RejectTrx(trx *quorumpb.Trx, err error)
 }
@@ -0,0 +1,55 @@
+package chain
+
+import (
+	"github.com/rumsystem/quorum/internal/pkg/eventbus"
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+)
+
+//publishTrxApplied publishes TRX_APPLIED of a trx packaged in block, and the group state changed by it
+func publishTrxApplied(trx *quorumpb.Trx) {
+	eventbus.Publish(&eventbus.Event{Type: eventbus.TRX_APPLIED, GroupId: trx.GroupId, TrxId: trx.TrxId, TrxType: trx.Type.String(), Sender: trx.SenderPubkey})
+
+	var eventType eventbus.EventType
+	switch trx.Type {
+	case quorumpb.TrxType_PRODUCER:
+		eventType = eventbus.PRODUCER_UPDATED
+	case quorumpb.TrxType_ANNOUNCE, quorumpb.TrxType_ANNOUNCE_RESULT:
+		eventType = eventbus.ANNOUNCE_UPDATED
+	case quorumpb.TrxType_AUTH:
+		eventType = eventbus.DENYLIST_UPDATED
+	case quorumpb.TrxType_SCHEMA:
+		eventType = eventbus.SCHEMA_UPDATED
+	case quorumpb.TrxType_ROLE:
+		eventType = eventbus.ROLE_UPDATED
+	default:
+		return
+	}
+	eventbus.Publish(&eventbus.Event{Type: eventType, GroupId: trx.GroupId, TrxId: trx.TrxId, TrxType: trx.Type.String(), Sender: trx.SenderPubkey})
+}
+
+func publishPostAdded(trx *quorumpb.Trx) {
+	eventbus.Publish(&eventbus.Event{Type: eventbus.POST_ADDED, GroupId: trx.GroupId, TrxId: trx.TrxId, TrxType: trx.Type.String(), Sender: trx.SenderPubkey, TimeStamp: trx.TimeStamp})
+}
+
+//publishPostUpdated publishes POST_UPDATED of the post edited or deleted by the trx
+func publishPostUpdated(trx *quorumpb.Trx, post *quorumpb.PostItem) {
+	status := "edited"
+	if post.Deleted {
+		status = "deleted"
+	}
+	eventbus.Publish(&eventbus.Event{Type: eventbus.POST_UPDATED, GroupId: trx.GroupId, TrxId: post.TrxId, TrxType: trx.Type.String(), Sender: trx.SenderPubkey, Status: status, TimeStamp: trx.TimeStamp})
+}
+
+//GetSyncerStatusName returns the name of syncer status shown to clients
+func GetSyncerStatusName(status int8) string {
+	switch status {
+	case SYNCING_FORWARD, SYNCING_BACKWARD, SYNCING_SNAPSHOT:
+		return "SYNCING"
+	case SYNC_FAILED:
+		return "SYNC_FAILED"
+	case IDLE:
+		return "IDLE"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,281 @@
+package chain
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	logging "github.com/ipfs/go-log/v2"
+	"github.com/libp2p/go-libp2p-core/peer"
+	localcrypto "github.com/rumsystem/quorum/internal/pkg/crypto"
+	"github.com/rumsystem/quorum/internal/pkg/nodectx"
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+var file_log = logging.Logger("file")
+
+const FILE_CHUNK_SIZE int = 256 * 1024       //size of plain chunks
+const MAX_FILE_SIZE int = 100 * 1024 * 1024  //(100Mb)
+const FILE_FETCH_WORKERS int = 8             //chunks fetched from peers at the same time
+const FILE_FETCH_TIMEOUT time.Duration = 600 //600s for all chunks of a file
+
+//CreateFileManifest splits the file into chunks, encrypts them by the cipher key of current key epoch and saves
+//them by the hash of encrypted chunk. The returned manifest is posted to the group, other nodes fetch the chunks
+//from peers by the hashes in it
+func CreateFileManifest(grpItem *quorumpb.GroupItem, name string, mediaType string, data []byte, nodename string) (*quorumpb.FileManifest, error) {
+	if len(data) == 0 {
+		return nil, errors.New("FILE_EMPTY")
+	}
+	if len(data) > MAX_FILE_SIZE {
+		return nil, fmt.Errorf("File size over %dMb", MAX_FILE_SIZE/1024/1024)
+	}
+
+	cipherKey, err := getCipherKey(grpItem, grpItem.KeyEpoch, nodename)
+	if err != nil {
+		return nil, err
+	}
+
+	sum := sha256.Sum256(data)
+	manifest := &quorumpb.FileManifest{Name: name, MediaType: mediaType, Size: int64(len(data)), Sha256: hex.EncodeToString(sum[:]), ChunkSize: int64(FILE_CHUNK_SIZE), KeyEpoch: grpItem.KeyEpoch}
+
+	dbMgr := nodectx.GetDbMgr()
+	for offset := 0; offset < len(data); offset += FILE_CHUNK_SIZE {
+		end := offset + FILE_CHUNK_SIZE
+		if end > len(data) {
+			end = len(data)
+		}
+		encrypted, err := localcrypto.AesEncrypt(data[offset:end], cipherKey)
+		if err != nil {
+			return nil, err
+		}
+		hash := getChunkHash(encrypted)
+		if err := dbMgr.SaveFileChunk(grpItem.GroupId, hash, encrypted, nodename); err != nil {
+			RmFileChunks(grpItem, manifest, nodename)
+			return nil, err
+		}
+		manifest.Chunks = append(manifest.Chunks, hash)
+	}
+
+	file_log.Debugf("<%s> file <%s> split to %d chunks", grpItem.GroupId, manifest.Sha256, len(manifest.Chunks))
+	return manifest, nil
+}
+
+//GetFileManifest returns the file manifest posted by the trx
+func GetFileManifest(grpItem *quorumpb.GroupItem, trx *quorumpb.Trx, nodename string) (*quorumpb.FileManifest, error) {
+	if trx.Type != quorumpb.TrxType_POST {
+		return nil, errors.New("NOT_A_FILE")
+	}
+	content, _, deleted, err := GetPostContent(grpItem, trx, nodename)
+	if err != nil {
+		return nil, err
+	}
+	if deleted {
+		return nil, errors.New("POST_DELETED")
+	}
+	ctnobj, _, err := quorumpb.BytesToMessage(trx.TrxId, content)
+	if err != nil {
+		return nil, err
+	}
+	manifest, ok := ctnobj.(*quorumpb.FileManifest)
+	if !ok {
+		return nil, errors.New("NOT_A_FILE")
+	}
+	return manifest, nil
+}
+
+//checkFileManifest makes sure the chunks of the manifest are able to make up a file of the size
+func checkFileManifest(manifest *quorumpb.FileManifest) error {
+	if manifest.Size <= 0 || manifest.Size > int64(MAX_FILE_SIZE) || manifest.ChunkSize <= 0 {
+		return errors.New("INVALID_FILE_MANIFEST")
+	}
+	if int64(len(manifest.Chunks)) != (manifest.Size+manifest.ChunkSize-1)/manifest.ChunkSize {
+		return errors.New("INVALID_FILE_MANIFEST")
+	}
+	return nil
+}
+
+//RmFileChunks removes the chunks of the manifest saved by this node, it is called if the manifest fails to be posted
+func RmFileChunks(grpItem *quorumpb.GroupItem, manifest *quorumpb.FileManifest, nodename string) {
+	dbMgr := nodectx.GetDbMgr()
+	for _, hash := range manifest.Chunks {
+		if err := dbMgr.RmFileChunk(grpItem.GroupId, hash, nodename); err != nil {
+			file_log.Warningf("<%s> remove chunk <%s> failed <%s>", grpItem.GroupId, hash, err.Error())
+		}
+	}
+}
+
+//FetchFile makes sure all chunks of the manifest are saved locally, chunks not saved are fetched from peers of the
+//group in parallel and cached after the hashes are verified. The file is verified by its size and hash after
+//chunks are decrypted, so WriteFile can write it out without keeping it in memory
+func FetchFile(ctx context.Context, grpItem *quorumpb.GroupItem, manifest *quorumpb.FileManifest, nodename string) error {
+	if err := checkFileManifest(manifest); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, FILE_FETCH_TIMEOUT*time.Second)
+	defer cancel()
+
+	//chunks are fetched by workers, failure of any chunk stops the others
+	hashes := make(chan string)
+	errs := make(chan error, FILE_FETCH_WORKERS)
+	var wg sync.WaitGroup
+	for i := 0; i < FILE_FETCH_WORKERS; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for hash := range hashes {
+				if _, err := getFileChunk(ctx, grpItem.GroupId, hash, nodename); err != nil {
+					errs <- err
+					cancel()
+					return
+				}
+			}
+		}()
+	}
+send:
+	for _, hash := range manifest.Chunks {
+		select {
+		case hashes <- hash:
+		case <-ctx.Done():
+			break send
+		}
+	}
+	close(hashes)
+	wg.Wait()
+	close(errs)
+	if err := <-errs; err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	hash := sha256.New()
+	size, err := writeFileChunks(grpItem, manifest, hash, nodename)
+	if err != nil {
+		return err
+	}
+	if size != manifest.Size || hex.EncodeToString(hash.Sum(nil)) != manifest.Sha256 {
+		return errors.New("FILE_HASH_MISMATCH")
+	}
+	return nil
+}
+
+//WriteFile writes the file fetched by FetchFile, chunks are decrypted and written one by one
+func WriteFile(grpItem *quorumpb.GroupItem, manifest *quorumpb.FileManifest, w io.Writer, nodename string) error {
+	_, err := writeFileChunks(grpItem, manifest, w, nodename)
+	return err
+}
+
+//writeFileChunks decrypts the chunks saved locally and writes them in order, returns the size written
+func writeFileChunks(grpItem *quorumpb.GroupItem, manifest *quorumpb.FileManifest, w io.Writer, nodename string) (int64, error) {
+	cipherKey, err := getCipherKey(grpItem, manifest.KeyEpoch, nodename)
+	if err != nil {
+		return 0, err
+	}
+
+	var size int64
+	for i, hash := range manifest.Chunks {
+		encrypted, err := getLocalFileChunk(grpItem.GroupId, hash, nodename)
+		if err != nil {
+			return size, err
+		}
+		if encrypted == nil {
+			return size, errors.New("FILE_CHUNK_NOT_FOUND")
+		}
+		chunk, err := localcrypto.AesDecode(encrypted, cipherKey)
+		if err != nil {
+			return size, err
+		}
+		if i < len(manifest.Chunks)-1 && int64(len(chunk)) != manifest.ChunkSize {
+			return size, errors.New("INVALID_FILE_CHUNK")
+		}
+		if _, err := w.Write(chunk); err != nil {
+			return size, err
+		}
+		size += int64(len(chunk))
+	}
+	return size, nil
+}
+
+func getChunkHash(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+//getLocalFileChunk returns the encrypted chunk saved locally, nil if it is not saved or does not match the hash
+func getLocalFileChunk(groupId string, hash string, nodename string) ([]byte, error) {
+	data, err := nodectx.GetDbMgr().GetFileChunk(groupId, hash, nodename)
+	if err != nil || data == nil {
+		return nil, err
+	}
+	if getChunkHash(data) != hash {
+		file_log.Warningf("<%s> chunk <%s> saved is corrupted", groupId, hash)
+		return nil, nil
+	}
+	return data, nil
+}
+
+//getFileChunk returns the encrypted chunk saved locally, or requests it from peers of the group one by one
+func getFileChunk(ctx context.Context, groupId string, hash string, nodename string) ([]byte, error) {
+	dbMgr := nodectx.GetDbMgr()
+	data, err := getLocalFileChunk(groupId, hash, nodename)
+	if err != nil {
+		return nil, err
+	}
+	if data != nil {
+		return data, nil
+	}
+
+	node := nodectx.GetNodeCtx().Node
+	if node == nil || node.FileService == nil {
+		return nil, errors.New("FILE_CHUNK_NOT_FOUND")
+	}
+
+	req, err := proto.Marshal(&quorumpb.ReqFileChunk{GroupId: groupId, Hash: hash})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range getFilePeers(groupId) {
+		resp, err := node.FileService.Request(ctx, p, req)
+		if err != nil {
+			file_log.Debugf("<%s> request chunk <%s> from peer <%s> failed <%s>", groupId, hash, p, err.Error())
+			continue
+		}
+		chunk := &quorumpb.FileChunk{}
+		if err := proto.Unmarshal(resp, chunk); err != nil {
+			continue
+		}
+		//chunk is content addressed, data from any peer is accepted if the hash matches
+		if len(chunk.Data) == 0 || getChunkHash(chunk.Data) != hash {
+			continue
+		}
+		if err := dbMgr.SaveFileChunk(groupId, hash, chunk.Data, nodename); err != nil {
+			file_log.Warningf("<%s> cache chunk <%s> failed <%s>", groupId, hash, err.Error())
+		}
+		return chunk.Data, nil
+	}
+	return nil, errors.New("FILE_CHUNK_NOT_FOUND")
+}
+
+//getFilePeers returns the peers in user and producer channels of the group
+func getFilePeers(groupId string) []peer.ID {
+	node := nodectx.GetNodeCtx().Node
+	var peers []peer.ID
+	seen := make(map[peer.ID]bool)
+	for _, channelId := range []string{USER_CHANNEL_PREFIX + groupId, PRODUCER_CHANNEL_PREFIX + groupId} {
+		for _, p := range node.Pubsub.ListPeers(channelId) {
+			if !seen[p] {
+				seen[p] = true
+				peers = append(peers, p)
+			}
+		}
+	}
+	return peers
+}
@@ -0,0 +1,116 @@
+package chain
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"testing"
+
+	"github.com/rumsystem/quorum/internal/pkg/nodectx"
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+//newTestFile creates the manifest of a file with 2 full chunks and a partial one
+func newTestFile(t *testing.T, grpItem *quorumpb.GroupItem) ([]byte, *quorumpb.FileManifest) {
+	data := make([]byte, FILE_CHUNK_SIZE*2+100)
+	if _, err := rand.Read(data); err != nil {
+		t.Fatalf("read random data err: %s", err)
+	}
+	manifest, err := CreateFileManifest(grpItem, "test.bin", "application/octet-stream", data, "")
+	if err != nil {
+		t.Fatalf("create file manifest err: %s", err)
+	}
+	return data, manifest
+}
+
+func TestCreateFileManifest(t *testing.T) {
+	grpItem := newTestGroup(t)
+	dbMgr := nodectx.GetDbMgr()
+	data, manifest := newTestFile(t, grpItem)
+
+	if manifest.Size != int64(len(data)) || len(manifest.Chunks) != 3 {
+		t.Fatalf("got size %d, %d chunks, want %d, 3 chunks", manifest.Size, len(manifest.Chunks), len(data))
+	}
+	if err := checkFileManifest(manifest); err != nil {
+		t.Errorf("manifest created is invalid, %s", err)
+	}
+	for _, hash := range manifest.Chunks {
+		chunk, err := dbMgr.GetFileChunk(grpItem.GroupId, hash, "")
+		if err != nil || chunk == nil || getChunkHash(chunk) != hash {
+			t.Errorf("chunk %s is not saved", hash)
+		}
+	}
+
+	RmFileChunks(grpItem, manifest, "")
+	for _, hash := range manifest.Chunks {
+		if chunk, _ := dbMgr.GetFileChunk(grpItem.GroupId, hash, ""); chunk != nil {
+			t.Errorf("chunk %s is not removed", hash)
+		}
+	}
+
+	if _, err := CreateFileManifest(grpItem, "empty", "", nil, ""); err == nil {
+		t.Errorf("manifest of an empty file is created")
+	}
+}
+
+func TestCheckFileManifest(t *testing.T) {
+	valid := &quorumpb.FileManifest{Size: 10, ChunkSize: 4, Chunks: []string{"a", "b", "c"}}
+	if err := checkFileManifest(valid); err != nil {
+		t.Fatalf("valid manifest is rejected, %s", err)
+	}
+
+	cases := map[string]func(*quorumpb.FileManifest){
+		"zero size":       func(m *quorumpb.FileManifest) { m.Size = 0 },
+		"over max size":   func(m *quorumpb.FileManifest) { m.Size = int64(MAX_FILE_SIZE) + 1 },
+		"zero chunk size": func(m *quorumpb.FileManifest) { m.ChunkSize = 0 },
+		"missing chunk":   func(m *quorumpb.FileManifest) { m.Chunks = m.Chunks[:2] },
+		"extra chunk":     func(m *quorumpb.FileManifest) { m.Chunks = append(m.Chunks, "d") },
+	}
+	for name, modify := range cases {
+		manifest := proto.Clone(valid).(*quorumpb.FileManifest)
+		modify(manifest)
+		if err := checkFileManifest(manifest); err == nil {
+			t.Errorf("%s: manifest is accepted", name)
+		}
+	}
+}
+
+func TestFetchFile(t *testing.T) {
+	grpItem := newTestGroup(t)
+	data, manifest := newTestFile(t, grpItem)
+
+	if err := FetchFile(context.Background(), grpItem, manifest, ""); err != nil {
+		t.Fatalf("fetch file err: %s", err)
+	}
+	var buf bytes.Buffer
+	if err := WriteFile(grpItem, manifest, &buf, ""); err != nil {
+		t.Fatalf("write file err: %s", err)
+	}
+	if !bytes.Equal(buf.Bytes(), data) {
+		t.Errorf("file written does not match the file uploaded")
+	}
+
+	//the file hash is checked after chunks are decrypted
+	forged := proto.Clone(manifest).(*quorumpb.FileManifest)
+	forged.Sha256 = getChunkHash([]byte("other"))
+	if err := FetchFile(context.Background(), grpItem, forged, ""); err == nil || err.Error() != "FILE_HASH_MISMATCH" {
+		t.Errorf("got err %v for a wrong file hash, want FILE_HASH_MISMATCH", err)
+	}
+	forged = proto.Clone(manifest).(*quorumpb.FileManifest)
+	forged.Size--
+	if err := FetchFile(context.Background(), grpItem, forged, ""); err == nil {
+		t.Errorf("file with a wrong size is fetched")
+	}
+
+	//a corrupted chunk saved locally is not used, and there is no peer to fetch it from
+	if err := nodectx.GetDbMgr().SaveFileChunk(grpItem.GroupId, manifest.Chunks[1], []byte("corrupted"), ""); err != nil {
+		t.Fatalf("save file chunk err: %s", err)
+	}
+	if err := FetchFile(context.Background(), grpItem, manifest, ""); err == nil || err.Error() != "FILE_CHUNK_NOT_FOUND" {
+		t.Errorf("got err %v for a corrupted chunk, want FILE_CHUNK_NOT_FOUND", err)
+	}
+	if err := WriteFile(grpItem, manifest, &bytes.Buffer{}, ""); err == nil {
+		t.Errorf("file with a corrupted chunk is written")
+	}
+}
@@ -0,0 +1,135 @@
+package chain
+
+import (
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"time"
+
+	logging "github.com/ipfs/go-log/v2"
+	"github.com/rumsystem/quorum/internal/pkg/nodectx"
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+	"github.com/rumsystem/quorum/internal/pkg/storage"
+	"google.golang.org/protobuf/proto"
+)
+
+var fsck_log = logging.Logger("fsck")
+
+//CheckGroup reports the storage inconsistencies of the group
+func (groupmgr *GroupMgr) CheckGroup(groupId string) (*storage.FsckReport, error) {
+	group, ok := groupmgr.Groups[groupId]
+	if !ok {
+		return nil, fmt.Errorf("Group %s not exist", groupId)
+	}
+	return nodectx.GetDbMgr().CheckGroup(group.Item, group.ChainCtx.nodename)
+}
+
+//RepairGroup rebuilds the group by replaying the valid blocks connected to the genesis block. The blocks are
+//saved as an archive to <datapath>_<groupid>.<time>.qar before the group data is removed, so the group can
+//be imported again if the replay failed. It returns the repaired group and the path of the archive
+func (groupmgr *GroupMgr) RepairGroup(groupId string) (*Group, string, error) {
+	fsck_log.Debugf("<%s> RepairGroup called", groupId)
+	group, ok := groupmgr.Groups[groupId]
+	if !ok {
+		return nil, "", fmt.Errorf("Group %s not exist", groupId)
+	}
+	dbMgr := nodectx.GetDbMgr()
+	nodename := group.ChainCtx.nodename
+
+	archive, err := collectGroupArchive(group.Item, nodename)
+	if err != nil {
+		return nil, "", err
+	}
+	if err := VerifyGroupArchive(archive); err != nil {
+		return nil, "", err
+	}
+	archiveBytes, err := proto.Marshal(archive)
+	if err != nil {
+		return nil, "", err
+	}
+	archivePath := fmt.Sprintf("%s_%s.%s.qar", dbMgr.DataPath, groupId, time.Now().Format("20060102150405"))
+	if err := ioutil.WriteFile(archivePath, archiveBytes, 0600); err != nil {
+		return nil, "", err
+	}
+	fsck_log.Infof("<%s> <%d> blocks are saved to %s", groupId, len(archive.Blocks), archivePath)
+
+	//the cipher keys of previous key epochs are removed with the group data, but can not be recovered by replay
+	cipherKeys := make(map[int64]string)
+	for epoch := int64(0); epoch < group.Item.KeyEpoch; epoch++ {
+		if cipherKey, err := dbMgr.GetGroupCipherKey(groupId, epoch, nodename); err == nil {
+			cipherKeys[epoch] = cipherKey
+		}
+	}
+	saveCipherKeys := func() {
+		for epoch, cipherKey := range cipherKeys {
+			if err := dbMgr.SaveGroupCipherKey(groupId, epoch, cipherKey, nodename); err != nil {
+				fsck_log.Warningf("<%s> save cipher key of key epoch <%d> failed: %s", groupId, epoch, err.Error())
+			}
+		}
+	}
+
+	item := proto.Clone(group.Item).(*quorumpb.GroupItem)
+	item.HighestBlockId = item.GenesisBlock.BlockId
+	item.HighestHeight = 0
+	item.LastUpdate = time.Now().UnixNano()
+
+	//group item is removed only after the group data is cleared, if clear failed the group is still loaded
+	//by the next start and can be repaired again
+	group.ChainCtx.StopSync()
+	group.ChainCtx.LeaveChannel()
+	if err := group.ClearGroup(); err != nil {
+		return nil, archivePath, fmt.Errorf("clear group data failed: %s, the group can be imported from %s after it is left", err.Error(), archivePath)
+	}
+	if err := nodectx.GetDbMgr().RmGroup(group.Item); err != nil {
+		return nil, archivePath, err
+	}
+	delete(groupmgr.Groups, groupId)
+	saveCipherKeys()
+
+	newGroup, err := groupmgr.ImportGroup(archive, item)
+	if err != nil {
+		saveCipherKeys()
+		return nil, archivePath, fmt.Errorf("replay failed: %s, the group can be imported from %s", err.Error(), archivePath)
+	}
+	return newGroup, archivePath, nil
+}
+
+//collectGroupArchive returns the archive of the valid blocks connected to the genesis block by PrevBlockId.
+//Blocks in chain and in cache are both collected, and the parent and sub blocks in chunks are not trusted
+func collectGroupArchive(item *quorumpb.GroupItem, nodename string) (*quorumpb.GroupArchive, error) {
+	dbMgr := nodectx.GetDbMgr()
+	children := make(map[string][]*quorumpb.Block)
+	collected := make(map[string]bool)
+	for _, cached := range []bool{false, true} {
+		chunks, _, err := dbMgr.GetGroupBlockChunks(item.GroupId, cached, nodename)
+		if err != nil {
+			return nil, err
+		}
+		for _, chunk := range chunks {
+			block := chunk.BlockItem
+			if collected[block.BlockId] || block.BlockId == item.GenesisBlock.BlockId {
+				continue
+			}
+			collected[block.BlockId] = true
+			children[block.PrevBlockId] = append(children[block.PrevBlockId], block)
+		}
+	}
+
+	archive := newGroupArchive(item)
+	queue := []*quorumpb.Block{item.GenesisBlock}
+	for len(queue) > 0 {
+		parent := queue[0]
+		queue = queue[1:]
+		blocks := children[parent.BlockId]
+		sort.Slice(blocks, func(i, j int) bool { return blocks[i].TimeStamp < blocks[j].TimeStamp })
+		for _, block := range blocks {
+			if valid, err := IsBlockValid(block, parent); !valid {
+				fsck_log.Warningf("<%s> invalid block <%s> is dropped: %v", item.GroupId, block.BlockId, err)
+				continue
+			}
+			archive.Blocks = append(archive.Blocks, block)
+			queue = append(queue, block)
+		}
+	}
+	return archive, nil
+}
@@ -1,7 +1,6 @@
 package chain
 
 import (
-	"bytes"
 	"encoding/hex"
 	"errors"
 	"time"
@@ -9,6 +8,7 @@ import (
 	logging "github.com/ipfs/go-log/v2"
 	"github.com/rumsystem/quorum/internal/pkg/nodectx"
 	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+	"github.com/rumsystem/quorum/internal/pkg/storage"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -34,6 +34,10 @@ func (grp *Group) Init(item *quorumpb.GroupItem) {
 	grp.ChainCtx = &Chain{}
 	grp.ChainCtx.Init(grp)
 
+	if err := grp.ChainCtx.restoreChainInfo(); err != nil {
+		group_log.Warningf("<%s> restore chain info failed: %s", grp.Item.GroupId, err.Error())
+	}
+
 	//reload producers
 	grp.ChainCtx.UpdProducerList()
 	grp.ChainCtx.CreateConsensus()
@@ -45,7 +49,7 @@ func (grp *Group) Init(item *quorumpb.GroupItem) {
 func (grp *Group) Teardown() {
 	group_log.Debugf("<%s> Teardown called", grp.Item.GroupId)
 
-	if grp.ChainCtx.Syncer.Status == SYNCING_BACKWARD || grp.ChainCtx.Syncer.Status == SYNCING_FORWARD {
+	if grp.ChainCtx.Syncer.Status == SYNCING_BACKWARD || grp.ChainCtx.Syncer.Status == SYNCING_FORWARD || grp.ChainCtx.Syncer.Status == SYNCING_SNAPSHOT {
 		grp.ChainCtx.Syncer.stopWaitBlock()
 	}
 
@@ -74,12 +78,7 @@ func (grp *Group) CreateGrp(item *quorumpb.GroupItem) error {
 	pItem.GroupOwnerPubkey = item.OwnerPubKey
 	pItem.ProducerPubkey = item.OwnerPubKey
 
-	var buffer bytes.Buffer
-	buffer.Write([]byte(pItem.GroupId))
-	buffer.Write([]byte(pItem.ProducerPubkey))
-	buffer.Write([]byte(pItem.GroupOwnerPubkey))
-	hash := Hash(buffer.Bytes())
-
+	hash := GetProducerItemHash(pItem)
 	ks := nodectx.GetNodeCtx().Keystore
 	signature, err := ks.SignByKeyName(item.GroupId, hash)
 	if err != nil {
@@ -125,7 +124,7 @@ func (grp *Group) ClearGroup() error {
 
 func (grp *Group) StartSync() error {
 	group_log.Debugf("<%s> StartSync called", grp.Item.GroupId)
-	if grp.ChainCtx.Syncer.Status == SYNCING_BACKWARD || grp.ChainCtx.Syncer.Status == SYNCING_FORWARD {
+	if grp.ChainCtx.Syncer.Status == SYNCING_BACKWARD || grp.ChainCtx.Syncer.Status == SYNCING_FORWARD || grp.ChainCtx.Syncer.Status == SYNCING_SNAPSHOT {
 		return errors.New("Group is syncing, don't start again")
 	}
 
@@ -142,7 +141,7 @@ func (grp *Group) StartSync() error {
 
 func (grp *Group) StopSync() error {
 	group_log.Debugf("<%s> StopSync called", grp.Item.GroupId)
-	if grp.ChainCtx.Syncer.Status == SYNCING_BACKWARD || grp.ChainCtx.Syncer.Status == SYNCING_FORWARD {
+	if grp.ChainCtx.Syncer.Status == SYNCING_BACKWARD || grp.ChainCtx.Syncer.Status == SYNCING_FORWARD || grp.ChainCtx.Syncer.Status == SYNCING_SNAPSHOT {
 		grp.ChainCtx.StopSync()
 	}
 
@@ -150,9 +149,9 @@ func (grp *Group) StopSync() error {
 	return nil
 }
 
-func (grp *Group) GetGroupCtn(filter string) ([]*quorumpb.PostItem, error) {
+func (grp *Group) GetGroupCtn(query *storage.GrpCtntQuery) ([]*quorumpb.PostItem, string, error) {
 	group_log.Debugf("<%s> GetGroupCtn called", grp.Item.GroupId)
-	return nodectx.GetDbMgr().GetGrpCtnt(grp.Item.GroupId, filter, grp.ChainCtx.nodename)
+	return nodectx.GetDbMgr().QueryGrpCtnt(grp.Item.GroupId, query, grp.ChainCtx.nodename)
 }
 
 func (grp *Group) GetBlock(blockId string) (*quorumpb.Block, error) {
@@ -180,6 +179,11 @@ func (grp *Group) GetAnnouncedUser() ([]*quorumpb.AnnounceItem, error) {
 	return nodectx.GetDbMgr().GetAnnouncedUsersByGroup(grp.Item.GroupId, grp.ChainCtx.nodename)
 }
 
+func (grp *Group) GetAnnouncedUserByPubkey(pubkey string) (*quorumpb.AnnounceItem, error) {
+	group_log.Debugf("<%s> GetAnnouncedUserByPubkey called", grp.Item.GroupId)
+	return nodectx.GetDbMgr().GetAnnouncedUser(grp.Item.GroupId, pubkey, grp.ChainCtx.nodename)
+}
+
 func (grp *Group) GetSchemas() ([]*quorumpb.SchemaItem, error) {
 	group_log.Debugf("<%s> GetSchema called", grp.Item.GroupId)
 	return nodectx.GetDbMgr().GetAllSchemasByGroup(grp.Item.GroupId, grp.ChainCtx.nodename)
@@ -200,6 +204,11 @@ func (grp *Group) UpdAnnounce(item *quorumpb.AnnounceItem) (string, error) {
 	return grp.ChainCtx.Consensus.User().UpdAnnounce(item)
 }
 
+func (grp *Group) UpdAnnounceResult(item *quorumpb.AnnounceItem) (string, error) {
+	group_log.Debugf("<%s> UpdAnnounceResult called", grp.Item.GroupId)
+	return grp.ChainCtx.Consensus.User().UpdAnnounceResult(item)
+}
+
 func (grp *Group) UpdBlkList(item *quorumpb.DenyUserItem) (string, error) {
 	group_log.Debugf("<%s> UpdBlkList called", grp.Item.GroupId)
 	return grp.ChainCtx.Consensus.User().UpdBlkList(item)
@@ -215,6 +224,67 @@ func (grp *Group) UpdProducer(item *quorumpb.ProducerItem) (string, error) {
 	return grp.ChainCtx.Consensus.User().UpdProducer(item)
 }
 
+func (grp *Group) UpdStake(item *quorumpb.StakeItem) (string, error) {
+	group_log.Debugf("<%s> UpdStake called", grp.Item.GroupId)
+	return grp.ChainCtx.Consensus.User().UpdStake(item)
+}
+
+func (grp *Group) CreateGroupKeyItem(removedUsers []string, memo string) (*quorumpb.GroupKeyItem, error) {
+	group_log.Debugf("<%s> CreateGroupKeyItem called", grp.Item.GroupId)
+	return createGroupKeyItem(grp.Item, removedUsers, memo, grp.ChainCtx.nodename)
+}
+
+func (grp *Group) UpdGroupKey(item *quorumpb.GroupKeyItem) (string, error) {
+	group_log.Debugf("<%s> UpdGroupKey called", grp.Item.GroupId)
+	return grp.ChainCtx.Consensus.User().UpdGroupKey(item)
+}
+
+func (grp *Group) GetGroupKeys() ([]*quorumpb.GroupKeyItem, error) {
+	group_log.Debugf("<%s> GetGroupKeys called", grp.Item.GroupId)
+	return nodectx.GetDbMgr().GetGroupKeys(grp.Item.GroupId, grp.ChainCtx.nodename)
+}
+
+func (grp *Group) UpdRole(item *quorumpb.RoleItem) (string, error) {
+	group_log.Debugf("<%s> UpdRole called", grp.Item.GroupId)
+	return grp.ChainCtx.Consensus.User().UpdRole(item)
+}
+
+func (grp *Group) GetRoles() ([]*quorumpb.RoleItem, error) {
+	group_log.Debugf("<%s> GetRoles called", grp.Item.GroupId)
+	return nodectx.GetDbMgr().GetRoles(grp.Item.GroupId, grp.ChainCtx.nodename)
+}
+
+//GetProfiles returns the current profiles of the senders in group
+func (grp *Group) GetProfiles() ([]*quorumpb.ProfileItem, error) {
+	group_log.Debugf("<%s> GetProfiles called", grp.Item.GroupId)
+	return nodectx.GetDbMgr().GetProfiles(grp.Item.GroupId, grp.ChainCtx.nodename)
+}
+
+//GetProfile returns the current profile of the sender, nil if the sender has not posted a profile
+func (grp *Group) GetProfile(pubkey string) (*quorumpb.ProfileItem, error) {
+	return nodectx.GetDbMgr().GetProfile(grp.Item.GroupId, pubkey, grp.ChainCtx.nodename)
+}
+
+//GetProfileHistory returns all profiles posted by the sender, ordered by time
+func (grp *Group) GetProfileHistory(pubkey string) ([]*quorumpb.ProfileItem, error) {
+	group_log.Debugf("<%s> GetProfileHistory called", grp.Item.GroupId)
+	return nodectx.GetDbMgr().GetProfileHistory(grp.Item.GroupId, pubkey, grp.ChainCtx.nodename)
+}
+
+func (grp *Group) GetUserRole(userPubkey string) quorumpb.GroupRole {
+	return GetUserRole(grp.Item, userPubkey, grp.ChainCtx.nodename)
+}
+
+//HasPermission returns true if we have the role to send the trx type
+func (grp *Group) HasPermission(trxType quorumpb.TrxType) bool {
+	return CheckTrxPermission(grp.Item, grp.Item.UserSignPubkey, trxType, grp.ChainCtx.nodename) == nil
+}
+
+func (grp *Group) GetStakes() ([]*quorumpb.StakeItem, error) {
+	group_log.Debugf("<%s> GetStakes called", grp.Item.GroupId)
+	return nodectx.GetDbMgr().GetStakes(grp.Item.GroupId, grp.ChainCtx.nodename)
+}
+
 func (grp *Group) UpdSchema(item *quorumpb.SchemaItem) (string, error) {
 	group_log.Debugf("<%s> UpdSchema called", grp.Item.GroupId)
 	return grp.ChainCtx.Consensus.User().UpdSchema(item)
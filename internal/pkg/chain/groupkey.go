@@ -0,0 +1,338 @@
+package chain
+
+import (
+	"encoding/hex"
+	"errors"
+	"time"
+
+	localcrypto "github.com/rumsystem/quorum/internal/pkg/crypto"
+	"github.com/rumsystem/quorum/internal/pkg/nodectx"
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+	"github.com/rumsystem/quorum/internal/pkg/storage"
+	"google.golang.org/protobuf/proto"
+)
+
+//SEED_KEY_EPOCH is the epoch of the cipher key given by the group seed, it is never rotated
+const SEED_KEY_EPOCH int64 = 0
+
+//isEpochKeyTrx returns true if the trx data is encrypted by the cipher key of current key epoch. Trxs to sync the
+//chain are encrypted by the key epoch of the requester, so removed members holding the seed key can not read them.
+//Trxs to produce blocks and GROUP_KEY trx are encrypted by the seed key, so nodes without the latest key epoch are
+//still able to get new blocks and key epochs (trxs packaged in blocks keep their own epoch)
+func isEpochKeyTrx(trxType quorumpb.TrxType) bool {
+	switch trxType {
+	case quorumpb.TrxType_POST,
+		quorumpb.TrxType_AUTH,
+		quorumpb.TrxType_SCHEMA,
+		quorumpb.TrxType_PRODUCER,
+		quorumpb.TrxType_ANNOUNCE,
+		quorumpb.TrxType_ANNOUNCE_RESULT,
+		quorumpb.TrxType_ROLE,
+		quorumpb.TrxType_STAKE,
+		quorumpb.TrxType_REQ_BLOCK_FORWARD,
+		quorumpb.TrxType_REQ_BLOCK_BACKWARD,
+		quorumpb.TrxType_REQ_BLOCK_RESP,
+		quorumpb.TrxType_REQ_BLOCK_RANGE,
+		quorumpb.TrxType_REQ_BLOCK_RANGE_RESP,
+		quorumpb.TrxType_REQ_SNAPSHOT,
+		quorumpb.TrxType_SNAPSHOT:
+		return true
+	default:
+		return false
+	}
+}
+
+//isSyncAllowed returns false if the requester can not sync the chain from us. Blocked users are denied, and after
+//the group key is rotated only the owner, producers and approved users are served, users removed by a rotation are
+//rejected and still hold the keys of old epochs
+func isSyncAllowed(grpItem *quorumpb.GroupItem, pubkey string, nodename string) (bool, error) {
+	dbMgr := nodectx.GetDbMgr()
+	isBlocked, err := dbMgr.IsUserBlocked(grpItem.GroupId, pubkey)
+	if err != nil || isBlocked {
+		return false, err
+	}
+	if pubkey == grpItem.OwnerPubKey {
+		return true, nil
+	}
+
+	epoch, err := dbMgr.GetGroupKeyEpoch(grpItem.GroupId, nodename)
+	if err != nil {
+		return false, err
+	}
+	if epoch == SEED_KEY_EPOCH {
+		return true, nil
+	}
+
+	isProducer, err := dbMgr.IsProducer(grpItem.GroupId, pubkey, nodename)
+	if err != nil || isProducer {
+		return isProducer, err
+	}
+	isUser, err := dbMgr.IsUser(grpItem.GroupId, pubkey, nodename)
+	if err != nil || !isUser {
+		return false, err
+	}
+	announced, err := dbMgr.GetAnnouncedUser(grpItem.GroupId, pubkey, nodename)
+	if err != nil {
+		return false, err
+	}
+	return announced.Result == quorumpb.ApproveType_APPROVED, nil
+}
+
+//getCipherKey returns the cipher key of the key epoch
+func getCipherKey(grpItem *quorumpb.GroupItem, epoch int64, nodename string) ([]byte, error) {
+	if epoch == grpItem.KeyEpoch {
+		return hex.DecodeString(grpItem.CipherKey)
+	}
+
+	cipherKey, err := nodectx.GetDbMgr().GetGroupCipherKey(grpItem.GroupId, epoch, nodename)
+	if err != nil {
+		return nil, err
+	}
+	return hex.DecodeString(cipherKey)
+}
+
+//aesDecodeTrxData decrypts trx data by the cipher key of the key epoch the trx carries
+func aesDecodeTrxData(grpItem *quorumpb.GroupItem, trx *quorumpb.Trx, nodename string) ([]byte, error) {
+	ciperKey, err := getCipherKey(grpItem, trx.KeyEpoch, nodename)
+	if err != nil {
+		return nil, err
+	}
+	return localcrypto.AesDecode(trx.Data, ciperKey)
+}
+
+//decodeAppliedTrxData decrypts the data of a trx applied in the transaction of dbMgr, cipher keys saved by GROUP_KEY
+//trxs applied before it in the same transaction are not in the group item yet
+func decodeAppliedTrxData(dbMgr *storage.DbMgr, grpItem *quorumpb.GroupItem, trx *quorumpb.Trx, nodename string) ([]byte, error) {
+	if trx.KeyEpoch <= grpItem.KeyEpoch {
+		return aesDecodeTrxData(grpItem, trx, nodename)
+	}
+	cipherKey, err := dbMgr.GetGroupCipherKey(grpItem.GroupId, trx.KeyEpoch, nodename)
+	if err != nil {
+		return nil, err
+	}
+	ciperKey, err := hex.DecodeString(cipherKey)
+	if err != nil {
+		return nil, err
+	}
+	return localcrypto.AesDecode(trx.Data, ciperKey)
+}
+
+//DecryptTrxData decrypts trx data, POST of private group is decrypted by the group encrypt key,
+//other trxs are decrypted by the cipher key of its key epoch
+func DecryptTrxData(grpItem *quorumpb.GroupItem, trx *quorumpb.Trx, nodename string) ([]byte, error) {
+	if trx.Type == quorumpb.TrxType_POST && grpItem.EncryptType == quorumpb.GroupEncryptType_PRIVATE {
+		ks := localcrypto.GetKeystore()
+		return ks.Decrypt(grpItem.UserEncryptPubkey, trx.Data)
+	}
+	return aesDecodeTrxData(grpItem, trx, nodename)
+}
+
+//createGroupKeyItem generates the cipher key of next key epoch and wraps it to the encrypt key of
+//group owner, producers and all approved users except the removed users
+func createGroupKeyItem(grpItem *quorumpb.GroupItem, removedUsers []string, memo string, nodename string) (*quorumpb.GroupKeyItem, error) {
+	if grpItem.OwnerPubKey != grpItem.UserSignPubkey {
+		return nil, errors.New("ONLY_GROUP_OWNER_CAN_ROTATE_KEY")
+	}
+
+	dbMgr := nodectx.GetDbMgr()
+	latest, err := dbMgr.GetGroupKeyEpoch(grpItem.GroupId, nodename)
+	if err != nil {
+		return nil, err
+	}
+	if latest != grpItem.KeyEpoch {
+		return nil, errors.New("KEY_EPOCH_NOT_APPLIED")
+	}
+
+	removed := make(map[string]bool)
+	for _, pubkey := range removedUsers {
+		if pubkey == grpItem.OwnerPubKey {
+			return nil, errors.New("CAN_NOT_REMOVE_GROUP_OWNER")
+		}
+		removed[pubkey] = true
+	}
+
+	//sign pubkey -> encrypt pubkey
+	members := make(map[string]string)
+	members[grpItem.UserSignPubkey] = grpItem.UserEncryptPubkey
+
+	users, err := dbMgr.GetAnnouncedUsersByGroup(grpItem.GroupId, nodename)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range users {
+		if item.Result == quorumpb.ApproveType_APPROVED && !removed[item.SignPubkey] {
+			members[item.SignPubkey] = item.EncryptPubkey
+		}
+	}
+
+	producers, err := dbMgr.GetProducers(grpItem.GroupId, nodename)
+	if err != nil {
+		return nil, err
+	}
+	for _, producer := range producers {
+		if _, ok := members[producer.ProducerPubkey]; ok {
+			continue
+		}
+		announced, err := dbMgr.GetAnnouncedProducer(grpItem.GroupId, producer.ProducerPubkey, nodename)
+		if err != nil || announced.EncryptPubkey == "" {
+			//producer has no announced encrypt key, it can not decrypt trxs of new key epoch
+			chain_log.Warningf("<%s> producer <%s> has no announced encrypt key, skip", grpItem.GroupId, producer.ProducerPubkey)
+			continue
+		}
+		members[producer.ProducerPubkey] = announced.EncryptPubkey
+	}
+
+	cipherKey, err := localcrypto.CreateAesKey()
+	if err != nil {
+		return nil, err
+	}
+
+	ks := localcrypto.GetKeystore()
+	item := &quorumpb.GroupKeyItem{}
+	item.GroupId = grpItem.GroupId
+	item.Epoch = grpItem.KeyEpoch + 1
+	for signPubkey, encryptPubkey := range members {
+		wrapped, err := ks.EncryptTo([]string{encryptPubkey}, cipherKey)
+		if err != nil {
+			return nil, err
+		}
+		item.Keys = append(item.Keys, &quorumpb.WrappedKey{SignPubkey: signPubkey, Key: wrapped})
+	}
+	item.RemovedUsers = removedUsers
+	item.OwnerPubkey = grpItem.OwnerPubKey
+	item.TimeStamp = time.Now().UnixNano()
+	item.Memo = memo
+	return item, nil
+}
+
+//unwrapGroupKey returns the cipher key wrapped to us, empty string is returned if we are not a member of the key epoch
+func unwrapGroupKey(grpItem *quorumpb.GroupItem, item *quorumpb.GroupKeyItem) (string, error) {
+	for _, wrapped := range item.Keys {
+		if wrapped.SignPubkey != grpItem.UserSignPubkey {
+			continue
+		}
+
+		ks := localcrypto.GetKeystore()
+		cipherKey, err := ks.Decrypt(grpItem.GroupId, wrapped.Key)
+		if err != nil {
+			return "", err
+		}
+		return hex.EncodeToString(cipherKey), nil
+	}
+	return "", nil
+}
+
+//applyGroupKeyTrx saves the group key item sent by group owner and moves the group to the new key epoch
+func applyGroupKeyTrx(dbMgr *storage.DbMgr, trx *quorumpb.Trx, grpItem *quorumpb.GroupItem, cIface ChainMolassesIface, nodename string) error {
+	//only group owner can rotate group key
+	if trx.SenderPubkey != grpItem.OwnerPubKey {
+		return errors.New("GROUP_KEY trx not sent by group owner")
+	}
+
+	item := &quorumpb.GroupKeyItem{}
+	if err := proto.Unmarshal(trx.Data, item); err != nil {
+		return err
+	}
+	if item.GroupId != grpItem.GroupId || item.OwnerPubkey != grpItem.OwnerPubKey {
+		return errors.New("GROUP_KEY item mismatch")
+	}
+
+	if err := dbMgr.UpdateGroupKey(trx, nodename); err != nil {
+		return err
+	}
+
+	//cipher keys are saved in the transaction, so trxs of the new key epoch applied after it in the same transaction
+	//can be decrypted. The group item is moved to the new key epoch after commit
+	if item.Epoch > grpItem.KeyEpoch {
+		cipherKey, err := unwrapGroupKey(grpItem, item)
+		if err != nil {
+			return err
+		}
+		if cipherKey != "" {
+			if err := dbMgr.SaveGroupCipherKey(grpItem.GroupId, grpItem.KeyEpoch, grpItem.CipherKey, nodename); err != nil {
+				return &applyWriteError{err}
+			}
+			if err := dbMgr.SaveGroupCipherKey(grpItem.GroupId, item.Epoch, cipherKey, nodename); err != nil {
+				return &applyWriteError{err}
+			}
+		}
+	}
+
+	dbMgr.OnCommit(func() {
+		if err := cIface.UpdGroupKey(item); err != nil {
+			chain_log.Warningf("<%s> update group key of epoch <%d> failed, %s", grpItem.GroupId, item.Epoch, err.Error())
+			return
+		}
+		//users approved after the rotation is created are not in it
+		rewrapGroupKey(grpItem, cIface, nodename)
+	})
+	return nil
+}
+
+//createRewrapItem wraps the cipher key of current key epoch to approved users not in the key epoch, nil is returned
+//if all approved users are in it
+func createRewrapItem(grpItem *quorumpb.GroupItem, nodename string) (*quorumpb.GroupKeyItem, error) {
+	dbMgr := nodectx.GetDbMgr()
+	saved, err := dbMgr.GetGroupKey(grpItem.GroupId, grpItem.KeyEpoch, nodename)
+	if err != nil {
+		return nil, err
+	}
+	wrapped := make(map[string]bool)
+	for _, k := range saved.Keys {
+		wrapped[k.SignPubkey] = true
+	}
+
+	users, err := dbMgr.GetAnnouncedUsersByGroup(grpItem.GroupId, nodename)
+	if err != nil {
+		return nil, err
+	}
+	cipherKey, err := hex.DecodeString(grpItem.CipherKey)
+	if err != nil {
+		return nil, err
+	}
+
+	ks := localcrypto.GetKeystore()
+	item := &quorumpb.GroupKeyItem{}
+	for _, user := range users {
+		if user.Result != quorumpb.ApproveType_APPROVED || wrapped[user.SignPubkey] {
+			continue
+		}
+		key, err := ks.EncryptTo([]string{user.EncryptPubkey}, cipherKey)
+		if err != nil {
+			return nil, err
+		}
+		item.Keys = append(item.Keys, &quorumpb.WrappedKey{SignPubkey: user.SignPubkey, Key: key})
+	}
+	if len(item.Keys) == 0 {
+		return nil, nil
+	}
+
+	item.GroupId = grpItem.GroupId
+	item.Epoch = grpItem.KeyEpoch
+	item.OwnerPubkey = grpItem.OwnerPubKey
+	item.TimeStamp = time.Now().UnixNano()
+	item.Memo = "rewrap"
+	return item, nil
+}
+
+//rewrapGroupKey sends the cipher key of current key epoch to users approved after the rotation, only group owner
+//does it. It is called after an ANNOUNCE_RESULT or GROUP_KEY trx is applied
+func rewrapGroupKey(grpItem *quorumpb.GroupItem, cIface ChainMolassesIface, nodename string) {
+	if grpItem.OwnerPubKey != grpItem.UserSignPubkey || grpItem.KeyEpoch == SEED_KEY_EPOCH {
+		return
+	}
+
+	item, err := createRewrapItem(grpItem, nodename)
+	if err != nil {
+		chain_log.Warningf("<%s> rewrap cipher key of key epoch <%d> failed, %s", grpItem.GroupId, grpItem.KeyEpoch, err.Error())
+		return
+	}
+	if item == nil {
+		return
+	}
+
+	chain_log.Infof("<%s> rewrap cipher key of key epoch <%d> to <%d> users", grpItem.GroupId, item.Epoch, len(item.Keys))
+	if _, err := cIface.GetProducerTrxMgr().SendUpdGroupKeyTrx(item); err != nil {
+		chain_log.Warningf("<%s> send rewrapped group key failed, %s", grpItem.GroupId, err.Error())
+	}
+}
@@ -0,0 +1,110 @@
+package chain
+
+import (
+	"encoding/hex"
+	"testing"
+
+	localcrypto "github.com/rumsystem/quorum/internal/pkg/crypto"
+	"github.com/rumsystem/quorum/internal/pkg/nodectx"
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+func newTestGroupKeyTrx(t *testing.T, grpItem *quorumpb.GroupItem, item *quorumpb.GroupKeyItem) *quorumpb.Trx {
+	data, err := proto.Marshal(item)
+	if err != nil {
+		t.Fatalf("marshal group key item err: %s", err)
+	}
+	//trxs are applied with the decrypted data
+	return &quorumpb.Trx{TrxId: "gky", Type: quorumpb.TrxType_GROUP_KEY, GroupId: grpItem.GroupId, SenderPubkey: grpItem.OwnerPubKey, Data: data}
+}
+
+func TestApplyGroupKeyTrxDiscarded(t *testing.T) {
+	grpItem := newTestGroup(t)
+	cIface := newTestChainIface(grpItem)
+	seedKey := grpItem.CipherKey
+
+	item, err := createGroupKeyItem(grpItem, nil, "", "")
+	if err != nil {
+		t.Fatalf("create group key item err: %s", err)
+	}
+	newKey, err := unwrapGroupKey(grpItem, item)
+	if err != nil || newKey == "" {
+		t.Fatalf("unwrap group key err: %v", err)
+	}
+
+	dbMgr, err := nodectx.GetDbMgr().Begin()
+	if err != nil {
+		t.Fatalf("begin err: %s", err)
+	}
+	if err := applyGroupKeyTrx(dbMgr, newTestGroupKeyTrx(t, grpItem, item), grpItem, cIface, ""); err != nil {
+		t.Fatalf("apply group key trx err: %s", err)
+	}
+
+	//trx of the new key epoch applied later in the same transaction can be decrypted
+	key, _ := hex.DecodeString(newKey)
+	encrypted, err := localcrypto.AesEncrypt([]byte("hello"), key)
+	if err != nil {
+		t.Fatalf("encrypt err: %s", err)
+	}
+	trx := &quorumpb.Trx{TrxId: "post", Type: quorumpb.TrxType_SCHEMA, KeyEpoch: item.Epoch, Data: encrypted}
+	if data, err := decodeAppliedTrxData(dbMgr, grpItem, trx, ""); err != nil || string(data) != "hello" {
+		t.Errorf("decode trx of new key epoch in txn got %q, %v", data, err)
+	}
+
+	//the group is moved to the new key epoch after commit only
+	if cIface.keyUpdates != 0 || grpItem.KeyEpoch != SEED_KEY_EPOCH {
+		t.Errorf("group key updated before commit")
+	}
+	dbMgr.Discard()
+
+	if cIface.keyUpdates != 0 || grpItem.KeyEpoch != SEED_KEY_EPOCH || grpItem.CipherKey != seedKey {
+		t.Errorf("group key updated by a discarded txn")
+	}
+	if epoch, _ := nodectx.GetDbMgr().GetGroupKeyEpoch(grpItem.GroupId, ""); epoch != SEED_KEY_EPOCH {
+		t.Errorf("group key item of a discarded txn is saved, epoch %d", epoch)
+	}
+	if _, err := nodectx.GetDbMgr().GetGroupCipherKey(grpItem.GroupId, item.Epoch, ""); err == nil {
+		t.Errorf("cipher key of a discarded txn is saved")
+	}
+}
+
+func TestApplyGroupKeyTrxCommitted(t *testing.T) {
+	grpItem := newTestGroup(t)
+	cIface := newTestChainIface(grpItem)
+
+	item, err := createGroupKeyItem(grpItem, nil, "", "")
+	if err != nil {
+		t.Fatalf("create group key item err: %s", err)
+	}
+	//created concurrently, both are of the next key epoch
+	stale, err := createGroupKeyItem(grpItem, nil, "", "")
+	if err != nil {
+		t.Fatalf("create group key item err: %s", err)
+	}
+
+	dbMgr, err := nodectx.GetDbMgr().Begin()
+	if err != nil {
+		t.Fatalf("begin err: %s", err)
+	}
+	defer dbMgr.Discard()
+	if err := applyGroupKeyTrx(dbMgr, newTestGroupKeyTrx(t, grpItem, item), grpItem, cIface, ""); err != nil {
+		t.Fatalf("apply group key trx err: %s", err)
+	}
+	if err := dbMgr.Commit(); err != nil {
+		t.Fatalf("commit err: %s", err)
+	}
+
+	if cIface.keyUpdates != 1 || grpItem.KeyEpoch != item.Epoch {
+		t.Errorf("group is not moved to key epoch %d after commit, got %d", item.Epoch, grpItem.KeyEpoch)
+	}
+
+	stale.RemovedUsers = []string{"removed"}
+	if err := applyGroupKeyTrx(nodectx.GetDbMgr(), newTestGroupKeyTrx(t, grpItem, stale), grpItem, cIface, ""); err == nil {
+		t.Errorf("stale key epoch is applied")
+	}
+	saved, err := nodectx.GetDbMgr().GetGroupKey(grpItem.GroupId, item.Epoch, "")
+	if err != nil || !proto.Equal(saved, item) {
+		t.Errorf("group key item is replaced by the stale one")
+	}
+}
@@ -1,8 +1,10 @@
 package chain
 
 import (
+	"errors"
 	"fmt"
 	logging "github.com/ipfs/go-log/v2"
+	"github.com/rumsystem/quorum/internal/pkg/nodectx"
 	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
 	"github.com/rumsystem/quorum/internal/pkg/storage"
 	"google.golang.org/protobuf/proto"
@@ -26,9 +28,56 @@ func InitGroupMgr(dbMgr *storage.DbMgr) *GroupMgr {
 	groupMgr_log.Debug("InitGroupMgr called")
 	groupMgr = &GroupMgr{dbMgr: dbMgr}
 	groupMgr.Groups = make(map[string]*Group)
+
+	//serve sync requests from direct streams
+	if nodeCtx := nodectx.GetNodeCtx(); nodeCtx != nil && nodeCtx.Node != nil && nodeCtx.Node.SyncService != nil {
+		nodeCtx.Node.SyncService.SetRequestHandler(groupMgr.handleSyncRequest)
+	}
+	//serve file chunks to peers
+	if nodeCtx := nodectx.GetNodeCtx(); nodeCtx != nil && nodeCtx.Node != nil && nodeCtx.Node.FileService != nil {
+		nodeCtx.Node.FileService.SetRequestHandler(groupMgr.handleFileRequest)
+	}
 	return groupMgr
 }
 
+func (groupmgr *GroupMgr) handleSyncRequest(req []byte, respond func(resp []byte) error) error {
+	var pkg quorumpb.Package
+	if err := proto.Unmarshal(req, &pkg); err != nil {
+		return err
+	}
+	if pkg.Type != quorumpb.PackageType_TRX {
+		return errors.New("unsupported sync request")
+	}
+
+	trx := &quorumpb.Trx{}
+	if err := proto.Unmarshal(pkg.Data, trx); err != nil {
+		return err
+	}
+
+	group, ok := groupmgr.Groups[trx.GroupId]
+	if !ok {
+		return fmt.Errorf("group %s not exist", trx.GroupId)
+	}
+	return group.ChainCtx.HandleSyncRequest(trx, respond)
+}
+
+//handleFileRequest responds the encrypted file chunk of a joined group, Data is empty if the chunk is not found
+func (groupmgr *GroupMgr) handleFileRequest(req []byte) ([]byte, error) {
+	var item quorumpb.ReqFileChunk
+	if err := proto.Unmarshal(req, &item); err != nil {
+		return nil, err
+	}
+	if _, ok := groupmgr.Groups[item.GroupId]; !ok {
+		return nil, fmt.Errorf("group %s not exist", item.GroupId)
+	}
+
+	data, err := groupmgr.dbMgr.GetFileChunk(item.GroupId, item.Hash, nodectx.GetNodeCtx().Name)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(&quorumpb.FileChunk{GroupId: item.GroupId, Hash: item.Hash, Data: data})
+}
+
 //load and group and start syncing
 func (groupmgr *GroupMgr) SyncAllGroup() error {
 	groupMgr_log.Debug("SyncAllGroup called")
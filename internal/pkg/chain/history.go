@@ -0,0 +1,157 @@
+package chain
+
+import (
+	"bytes"
+	"errors"
+
+	logging "github.com/ipfs/go-log/v2"
+	"github.com/rumsystem/quorum/internal/pkg/nodectx"
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+	"github.com/rumsystem/quorum/internal/pkg/storage"
+	"google.golang.org/protobuf/proto"
+)
+
+var history_log = logging.Logger("history")
+
+//A node synced by a snapshot has the group state but not the posts before the snapshot block. The blocks before
+//it are synced from the genesis block to the cache, and connected to it when all ancestors of it are cached. Each
+//ancestor is verified by the hash of its child, so only the blocks of the chain signed by the snapshot are applied
+
+//saveHistoryBlock caches a block synced before the history block, blocks already in chain are ignored
+func saveHistoryBlock(block *quorumpb.Block, nodename string) error {
+	dbMgr := nodectx.GetDbMgr()
+	isSaved, err := dbMgr.IsBlockExist(block.BlockId, false, nodename)
+	if err != nil || isSaved {
+		return err
+	}
+	return dbMgr.AddBlock(block, true, nodename)
+}
+
+//getHistoryPath walks back from the history block through the cache until a block in chain is reached, and
+//returns the cached ancestors from the oldest one. It returns false if an ancestor is not cached yet
+func getHistoryPath(history *quorumpb.Block, nodename string) ([]*quorumpb.Block, bool, error) {
+	if valid, err := isBlockHashValid(history); !valid {
+		return nil, false, err
+	}
+
+	dbMgr := nodectx.GetDbMgr()
+	var path []*quorumpb.Block
+	child := history
+	for {
+		inChain, err := dbMgr.IsBlockExist(child.PrevBlockId, false, nodename)
+		if err != nil {
+			return nil, false, err
+		}
+		cached := false
+		if !inChain {
+			if cached, err = dbMgr.IsBlockExist(child.PrevBlockId, true, nodename); err != nil || !cached {
+				return nil, false, err
+			}
+		}
+
+		parent, err := dbMgr.GetBlock(child.PrevBlockId, cached, nodename)
+		if err != nil {
+			return nil, false, err
+		}
+
+		//the child is verified, the parent must be the block it points to
+		if !bytes.Equal(child.PreviousHash, parent.Hash) {
+			if cached {
+				//a block not in the chain of the history block, wait the right one
+				history_log.Warningf("<%s> remove invalid history block <%s> from cache", history.GroupId, parent.BlockId)
+				return nil, false, dbMgr.RmBlock(parent.BlockId, true, nodename)
+			}
+			return nil, false, errors.New("PreviousHash mismatch")
+		}
+		if inChain {
+			break
+		}
+		if valid, _ := isBlockHashValid(parent); !valid {
+			history_log.Warningf("<%s> remove invalid history block <%s> from cache", history.GroupId, parent.BlockId)
+			return nil, false, dbMgr.RmBlock(parent.BlockId, true, nodename)
+		}
+		path = append(path, parent)
+		child = parent
+	}
+
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path, true, nil
+}
+
+//connectHistory moves the blocks of the path from cache to chain and applies the posts in them, then connects the
+//history block to its parent
+func connectHistory(grpItem *quorumpb.GroupItem, path []*quorumpb.Block, nodename string) error {
+	for _, block := range path {
+		dbMgr, err := nodectx.GetDbMgr().Begin()
+		if err != nil {
+			return err
+		}
+		if err := applyHistoryTrxs(dbMgr, grpItem, block.Trxs, nodename); err != nil {
+			dbMgr.Discard()
+			return err
+		}
+		if err := dbMgr.AddBlock(block, false, nodename); err != nil {
+			dbMgr.Discard()
+			return err
+		}
+		if err := dbMgr.RmBlock(block.BlockId, true, nodename); err != nil {
+			dbMgr.Discard()
+			return err
+		}
+		if err := dbMgr.Commit(); err != nil {
+			return err
+		}
+	}
+
+	history_log.Infof("<%s> <%d> history blocks connected", grpItem.GroupId, len(path))
+	return nodectx.GetDbMgr().ConnectHistoryBlock(grpItem.GroupId, nodename)
+}
+
+//applyHistoryTrxs applies the POST trxs in a history block, the group state is taken from the snapshot, so other
+//trxs are saved only. Events are not published for history posts
+func applyHistoryTrxs(dbMgr *storage.DbMgr, grpItem *quorumpb.GroupItem, trxs []*quorumpb.Trx, nodename string) error {
+	for _, trx := range trxs {
+		isExist, err := dbMgr.IsTrxExist(trx.TrxId, nodename)
+		if err != nil {
+			return err
+		}
+		if isExist {
+			continue
+		}
+
+		if trx.Type == quorumpb.TrxType_POST {
+			if err := applyHistoryPost(dbMgr, grpItem, trx, nodename); err != nil {
+				return err
+			}
+		}
+		if err := dbMgr.AddTrx(trx, nodename); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//applyHistoryPost returns the error of writing db only, invalid posts are dropped
+func applyHistoryPost(dbMgr *storage.DbMgr, grpItem *quorumpb.GroupItem, trx *quorumpb.Trx, nodename string) error {
+	data, err := DecryptTrxData(grpItem, trx, nodename)
+	if err != nil {
+		//cipher key of the key epoch is not wrapped to us, save trx only
+		history_log.Debugf("<%s> history trx <%s> can not be decrypted, save trx only", grpItem.GroupId, trx.TrxId)
+		return nil
+	}
+
+	decrypted := proto.Clone(trx).(*quorumpb.Trx)
+	decrypted.Data = data
+	if err := checkAppliedTrxPermission(dbMgr, decrypted, grpItem, nodename); err != nil {
+		history_log.Debugf("<%s> history trx <%s> not applied, %s", grpItem.GroupId, trx.TrxId, err.Error())
+		return nil
+	}
+	if _, _, err := applyPostTrx(dbMgr, decrypted, grpItem, nodename); isApplyWriteError(err) {
+		return err
+	} else if err != nil {
+		history_log.Debugf("<%s> history POST trx <%s> dropped, %s", grpItem.GroupId, trx.TrxId, err.Error())
+	}
+	return nil
+}
@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"crypto/sha256"
 	"encoding/binary"
-	"encoding/hex"
 	"errors"
 	"sync"
 	"time"
@@ -16,6 +15,7 @@ import (
 	"github.com/rumsystem/quorum/internal/pkg/nodectx"
 	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
 	pubsubconn "github.com/rumsystem/quorum/internal/pkg/pubsubconn"
+	"github.com/rumsystem/quorum/internal/pkg/storage"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -48,6 +48,11 @@ type MolassesProducer struct {
 	nodename          string
 	cIface            ChainMolassesIface
 	groupId           string
+	selectCandidate   func(blockPool map[string]*quorumpb.Block) string
+	votes             map[string]map[string]*quorumpb.BlockVote //votetype_blockid -> voter -> vote
+	committed         map[string]bool                           //prev blockid -> commit vote sent
+	commitCh          chan string                               //blockid reached commit quorum
+	votemu            sync.Mutex                                //guards votes, committed and blockPool
 }
 
 func (producer *MolassesProducer) Init(item *quorumpb.GroupItem, nodename string, iface ChainMolassesIface) {
@@ -61,6 +66,10 @@ func (producer *MolassesProducer) Init(item *quorumpb.GroupItem, nodename string
 	producer.status = StatusIdle
 	producer.nodename = nodename
 	producer.groupId = item.GroupId
+	producer.selectCandidate = selectCandidateByHash
+	producer.votes = make(map[string]map[string]*quorumpb.BlockVote)
+	producer.committed = make(map[string]bool)
+	producer.commitCh = make(chan string, 8)
 
 	molaproducer_log.Infof("<%s> producer created", producer.groupId)
 }
@@ -81,6 +90,25 @@ func (producer *MolassesProducer) AddTrx(trx *quorumpb.Trx) {
 		return
 	}
 
+	//check if trx sender has the role to send the trx
+	if err := CheckTrxPermission(producer.grpItem, trx.SenderPubkey, trx.Type, producer.nodename); err != nil {
+		molaproducer_log.Warningf("<%s> %s trx <%s> dropped, %s", producer.groupId, trx.Type.String(), trx.TrxId, err.Error())
+		producer.cIface.RejectTrx(trx, err)
+		return
+	}
+
+	//check POST content against group schema and the permission to update or delete a post, private group POST
+	//can only be checked if producer can decrypt it
+	if trx.Type == quorumpb.TrxType_POST {
+		if decryptData, err := producer.decryptTrxData(trx); err == nil {
+			if _, _, err := checkPostTrx(nodectx.GetDbMgr(), trx, decryptData, producer.grpItem, producer.nodename); err != nil {
+				molaproducer_log.Warningf("<%s> POST trx <%s> dropped, %s", producer.groupId, trx.TrxId, err.Error())
+				producer.cIface.RejectTrx(trx, err)
+				return
+			}
+		}
+	}
+
 	molaproducer_log.Debugf("<%s> Molasses AddTrx called, add trx <%s>", producer.groupId, trx.TrxId)
 	producer.trxPool[trx.TrxId] = trx
 
@@ -163,7 +191,36 @@ func (producer *MolassesProducer) AddBlockToPool(block *quorumpb.Block) {
 	if producer.cIface.IsSyncerReady() {
 		return
 	}
+
+	producer.votemu.Lock()
+	defer producer.votemu.Unlock()
 	producer.blockPool[block.BlockId] = block
+
+	//votes may arrive before the block, check them again now the block is in pool
+	quorum := getQuorum(len(producer.cIface.GetChainCtx().ProducerPool))
+	for _, voteType := range []quorumpb.VoteType{quorumpb.VoteType_PREVOTE, quorumpb.VoteType_COMMIT} {
+		if len(producer.votes[voteType.String()+"_"+block.BlockId]) >= quorum {
+			producer.onVoteQuorum(voteType, block.BlockId, block.PrevBlockId)
+		}
+	}
+}
+
+//getPoolBlock returns the block in block pool, nil if not found
+func (producer *MolassesProducer) getPoolBlock(blockId string) *quorumpb.Block {
+	producer.votemu.Lock()
+	defer producer.votemu.Unlock()
+	return producer.blockPool[blockId]
+}
+
+//getBlockPool returns a copy of block pool
+func (producer *MolassesProducer) getBlockPool() map[string]*quorumpb.Block {
+	producer.votemu.Lock()
+	defer producer.votemu.Unlock()
+	blockPool := make(map[string]*quorumpb.Block, len(producer.blockPool))
+	for blkId, blk := range producer.blockPool {
+		blockPool[blkId] = blk
+	}
+	return blockPool
 }
 
 func (producer *MolassesProducer) AddProducedBlock(trx *quorumpb.Trx) error {
@@ -172,12 +229,7 @@ func (producer *MolassesProducer) AddProducedBlock(trx *quorumpb.Trx) error {
 		return nil
 	}
 
-	ciperKey, err := hex.DecodeString(producer.grpItem.CipherKey)
-	if err != nil {
-		return err
-	}
-
-	decryptData, err := localcrypto.AesDecode(trx.Data, ciperKey)
+	decryptData, err := aesDecodeTrxData(producer.grpItem, trx, producer.nodename)
 	if err != nil {
 		return err
 	}
@@ -221,41 +273,61 @@ func (producer *MolassesProducer) startMergeBlock() error {
 	t := <-mergeTimer.C
 	molaproducer_log.Debugf("<%s> merge timer ticker...<%s>", producer.groupId, t.UTC().String())
 
-	candidateBlkid := ""
-	var oHash []byte
-	for _, blk := range producer.blockPool {
-		nHash := sha256.Sum256(blk.Signature)
-		//comparing two hash bytes lexicographically
-		if bytes.Compare(oHash[:], nHash[:]) == -1 { //-1 means ohash < nhash, and we want keep the larger one
-			candidateBlkid = blk.BlockId
-			oHash = nHash[:]
+	candidateBlkid := producer.selectCandidate(producer.getBlockPool())
+	if candidateBlkid == "" {
+		molaproducer_log.Warningf("<%s> no candidate block in this round", producer.groupId)
+		producer.endMergeRound()
+		return nil
+	}
+
+	molaproducer_log.Debugf("<%s> candidate block decided, block Id : %s, prevote for it", producer.groupId, candidateBlkid)
+	candidate := producer.getPoolBlock(candidateBlkid)
+	producer.sendBlockVote(quorumpb.VoteType_PREVOTE, candidate.BlockId, candidate.PrevBlockId)
+
+	//wait until a block gets commit votes from 2f+1 producers
+	finalizedBlkid := ""
+	voteTimer := time.NewTimer(VOTE_TIMER * time.Second)
+	defer voteTimer.Stop()
+	for finalizedBlkid == "" {
+		select {
+		case blkId := <-producer.commitCh:
+			//ignore commit of previous rounds
+			if producer.getPoolBlock(blkId) != nil {
+				finalizedBlkid = blkId
+			}
+		case <-voteTimer.C:
+			molaproducer_log.Warningf("<%s> no block committed in <%d>s, drop this round", producer.groupId, VOTE_TIMER)
+			producer.returnTrxsToPool()
+			producer.endMergeRound()
+			return nil
 		}
 	}
 
-	molaproducer_log.Debugf("<%s> candidate block decided, block Id : %s", producer.groupId, candidateBlkid)
+	block := producer.getPoolBlock(finalizedBlkid)
+	block.Commits = producer.getCommits(finalizedBlkid)
 
 	surfix := ""
-	if producer.blockPool[candidateBlkid].ProducerPubKey == producer.grpItem.OwnerPubKey {
+	if block.ProducerPubKey == producer.grpItem.OwnerPubKey {
 		surfix = "OWNER"
 	} else {
 		surfix = "PRODUCER"
 	}
 
-	molaproducer_log.Debugf("<%s> winner <%s> (%s)", producer.groupId, producer.blockPool[candidateBlkid].ProducerPubKey, surfix)
-	err := producer.AddBlock(producer.blockPool[candidateBlkid])
+	molaproducer_log.Debugf("<%s> block <%s> committed, winner <%s> (%s)", producer.groupId, finalizedBlkid, block.ProducerPubKey, surfix)
+	err := producer.AddBlock(block)
 
 	if err != nil {
-		molaproducer_log.Errorf("<%s> save block <%s> error <%s>", producer.groupId, candidateBlkid, err)
+		molaproducer_log.Errorf("<%s> save block <%s> error <%s>", producer.groupId, finalizedBlkid, err)
 		if err.Error() == "PARENT_NOT_EXIST" {
-			molaproducer_log.Debugf("<%s> parent not found, sync backward for missing blocks from <%s>", producer.groupId, candidateBlkid, err)
-			producer.cIface.SyncBackward(producer.blockPool[candidateBlkid])
+			molaproducer_log.Debugf("<%s> parent not found, sync backward for missing blocks from <%s>", producer.groupId, finalizedBlkid, err)
+			producer.cIface.SyncBackward(block)
 		}
 	} else {
 		molaproducer_log.Debugf("<%s> block saved", producer.groupId)
 		//check if I am the winner
-		if producer.blockPool[candidateBlkid].ProducerPubKey == producer.grpItem.UserSignPubkey {
+		if block.ProducerPubKey == producer.grpItem.UserSignPubkey {
 			molaproducer_log.Debugf("<%s> winner send new block out", producer.groupId)
-			err := producer.cIface.GetUserTrxMgr().SendBlock(producer.blockPool[candidateBlkid])
+			err := producer.cIface.GetUserTrxMgr().SendBlock(block)
 			if err != nil {
 				molaproducer_log.Warnf("<%s> <%s>", producer.groupId, err.Error())
 			}
@@ -263,21 +335,163 @@ func (producer *MolassesProducer) startMergeBlock() error {
 	}
 
 	molaproducer_log.Debugf("<%s> merge done", producer.groupId)
-	producer.blockPool = make(map[string]*quorumpb.Block)
+	producer.endMergeRound()
 
 	return nil
 }
 
-func (producer *MolassesProducer) GetBlockForward(trx *quorumpb.Trx) error {
-	molaproducer_log.Debugf("<%s> GetBlockForward called", producer.groupId)
+//AddBlockVote handles prevote and commit votes from other producers
+func (producer *MolassesProducer) AddBlockVote(trx *quorumpb.Trx) error {
+	molaproducer_log.Debugf("<%s> AddBlockVote called", producer.groupId)
+	if producer.cIface.IsSyncerReady() {
+		return nil
+	}
 
-	var reqBlockItem quorumpb.ReqBlock
-	ciperKey, err := hex.DecodeString(producer.grpItem.CipherKey)
+	decryptData, err := aesDecodeTrxData(producer.grpItem, trx, producer.nodename)
 	if err != nil {
 		return err
 	}
 
-	decryptData, err := localcrypto.AesDecode(trx.Data, ciperKey)
+	vote := &quorumpb.BlockVote{}
+	if err := proto.Unmarshal(decryptData, vote); err != nil {
+		return err
+	}
+
+	if vote.VoterPubkey != trx.SenderPubkey {
+		return errors.New("Voter mismatch")
+	}
+
+	if _, ok := producer.cIface.GetChainCtx().ProducerPool[vote.VoterPubkey]; !ok {
+		molaproducer_log.Warningf("<%s> vote from unregisted producer <%s>, reject it", producer.groupId, vote.VoterPubkey)
+		return nil
+	}
+
+	valid, err := IsBlockVoteValid(vote)
+	if err != nil || !valid {
+		return errors.New("Invalid vote")
+	}
+
+	producer.addVote(vote)
+	return nil
+}
+
+func (producer *MolassesProducer) addVote(vote *quorumpb.BlockVote) {
+	producer.votemu.Lock()
+	defer producer.votemu.Unlock()
+
+	key := vote.Type.String() + "_" + vote.BlockId
+	if _, ok := producer.votes[key]; !ok {
+		producer.votes[key] = make(map[string]*quorumpb.BlockVote)
+	}
+
+	//my own vote is counted when it is sent
+	if _, ok := producer.votes[key][vote.VoterPubkey]; ok {
+		return
+	}
+	producer.votes[key][vote.VoterPubkey] = vote
+
+	quorum := getQuorum(len(producer.cIface.GetChainCtx().ProducerPool))
+	molaproducer_log.Debugf("<%s> block <%s> got <%d/%d> %s", producer.groupId, vote.BlockId, len(producer.votes[key]), quorum, vote.Type.String())
+	if len(producer.votes[key]) != quorum {
+		return
+	}
+	producer.onVoteQuorum(vote.Type, vote.BlockId, vote.PrevBlockId)
+}
+
+//onVoteQuorum is called with votemu held when a block gets votes from 2f+1 producers
+func (producer *MolassesProducer) onVoteQuorum(voteType quorumpb.VoteType, blockId string, prevBlockId string) {
+	switch voteType {
+	case quorumpb.VoteType_PREVOTE:
+		//2f+1 prevotes, commit the block, only one block can be committed on the same parent.
+		//block not received yet is committed when it is added to pool
+		if producer.committed[prevBlockId] {
+			return
+		}
+		if _, ok := producer.blockPool[blockId]; !ok {
+			return
+		}
+		producer.committed[prevBlockId] = true
+		go producer.sendBlockVote(quorumpb.VoteType_COMMIT, blockId, prevBlockId)
+	case quorumpb.VoteType_COMMIT:
+		//2f+1 commits, the block is final
+		select {
+		case producer.commitCh <- blockId:
+		default:
+		}
+	}
+}
+
+func (producer *MolassesProducer) sendBlockVote(voteType quorumpb.VoteType, blockId string, prevBlockId string) {
+	vote, err := CreateBlockVote(voteType, producer.groupId, blockId, prevBlockId, producer.grpItem.UserSignPubkey, producer.nodename)
+	if err != nil {
+		molaproducer_log.Errorf("<%s> create %s vote failed <%s>", producer.groupId, voteType.String(), err.Error())
+		return
+	}
+
+	producer.addVote(vote)
+	if err := producer.cIface.GetProducerTrxMgr().SendBlockVote(vote); err != nil {
+		molaproducer_log.Warnf("<%s> <%s>", producer.groupId, err.Error())
+	}
+}
+
+func (producer *MolassesProducer) getCommits(blockId string) []*quorumpb.BlockVote {
+	producer.votemu.Lock()
+	defer producer.votemu.Unlock()
+
+	var commits []*quorumpb.BlockVote
+	for _, vote := range producer.votes[quorumpb.VoteType_COMMIT.String()+"_"+blockId] {
+		commits = append(commits, vote)
+	}
+	return commits
+}
+
+//no block committed in this round, put trxs packaged in my own block back to trx pool,
+//trxs already on chain (committed by a block synced from others) are dropped
+func (producer *MolassesProducer) returnTrxsToPool() {
+	for _, blk := range producer.getBlockPool() {
+		if blk.ProducerPubKey != producer.grpItem.UserSignPubkey {
+			continue
+		}
+		for _, trx := range blk.Trxs {
+			if exist, err := nodectx.GetDbMgr().IsTrxExist(trx.TrxId, producer.nodename); exist || err != nil {
+				continue
+			}
+			producer.trxPool[trx.TrxId] = trx
+		}
+	}
+}
+
+func (producer *MolassesProducer) endMergeRound() {
+	producer.votemu.Lock()
+	for blkId, blk := range producer.blockPool {
+		delete(producer.votes, quorumpb.VoteType_PREVOTE.String()+"_"+blkId)
+		delete(producer.votes, quorumpb.VoteType_COMMIT.String()+"_"+blkId)
+		delete(producer.committed, blk.PrevBlockId)
+	}
+	producer.blockPool = make(map[string]*quorumpb.Block)
+	producer.votemu.Unlock()
+}
+
+//molasses rule, block with the largest sha256(signature) wins
+func selectCandidateByHash(blockPool map[string]*quorumpb.Block) string {
+	candidateBlkid := ""
+	var oHash []byte
+	for _, blk := range blockPool {
+		nHash := sha256.Sum256(blk.Signature)
+		//comparing two hash bytes lexicographically
+		if bytes.Compare(oHash[:], nHash[:]) == -1 { //-1 means ohash < nhash, and we want keep the larger one
+			candidateBlkid = blk.BlockId
+			oHash = nHash[:]
+		}
+	}
+	return candidateBlkid
+}
+
+func (producer *MolassesProducer) GetBlockForward(trx *quorumpb.Trx) error {
+	molaproducer_log.Debugf("<%s> GetBlockForward called", producer.groupId)
+
+	var reqBlockItem quorumpb.ReqBlock
+	decryptData, err := aesDecodeTrxData(producer.grpItem, trx, producer.nodename)
 	if err != nil {
 		return err
 	}
@@ -286,12 +500,10 @@ func (producer *MolassesProducer) GetBlockForward(trx *quorumpb.Trx) error {
 		return err
 	}
 
-	//check if requester is in group block list
-	isBlocked, _ := nodectx.GetDbMgr().IsUserBlocked(trx.GroupId, trx.SenderPubkey)
-
-	if isBlocked {
-		molaproducer_log.Debugf("<%s> user <%s> is blocked", producer.groupId, trx.SenderPubkey)
-		return nil
+	//check if requester is blocked or removed from group
+	if allowed, err := isSyncAllowed(producer.grpItem, trx.SenderPubkey, producer.nodename); !allowed {
+		molaproducer_log.Debugf("<%s> user <%s> is not allowed to sync", producer.groupId, trx.SenderPubkey)
+		return err
 	}
 
 	subBlocks, err := nodectx.GetDbMgr().GetSubBlock(reqBlockItem.BlockId, producer.nodename)
@@ -301,12 +513,12 @@ func (producer *MolassesProducer) GetBlockForward(trx *quorumpb.Trx) error {
 	}
 
 	channelId := SYNC_CHANNEL_PREFIX + producer.grpItem.GroupId + "_" + reqBlockItem.UserId
-	trxMgr, _ := producer.getSyncConn(channelId)
+	trxMgr, _ := producer.getRespConn(trx, channelId)
 
 	if len(subBlocks) != 0 {
 		for _, block := range subBlocks {
 			molaproducer_log.Debugf("<%s> send REQ_NEXT_BLOCK_RESP (BLOCK_IN_TRX)", producer.groupId)
-			err := trxMgr.SendReqBlockResp(&reqBlockItem, block, quorumpb.ReqBlkResult_BLOCK_IN_TRX)
+			err := trxMgr.SendReqBlockResp(&reqBlockItem, block, quorumpb.ReqBlkResult_BLOCK_IN_TRX, trx.KeyEpoch)
 			if err != nil {
 				molaproducer_log.Warnf(err.Error())
 			}
@@ -319,7 +531,7 @@ func (producer *MolassesProducer) GetBlockForward(trx *quorumpb.Trx) error {
 		emptyBlock.BlockId = guuid.New().String()
 		emptyBlock.ProducerPubKey = producer.grpItem.UserSignPubkey
 		molaproducer_log.Debugf("<%s> send REQ_NEXT_BLOCK_RESP (BLOCK_NOT_FOUND)", producer.groupId)
-		return trxMgr.SendReqBlockResp(&reqBlockItem, emptyBlock, quorumpb.ReqBlkResult_BLOCK_NOT_FOUND)
+		return trxMgr.SendReqBlockResp(&reqBlockItem, emptyBlock, quorumpb.ReqBlkResult_BLOCK_NOT_FOUND, trx.KeyEpoch)
 	}
 }
 
@@ -328,12 +540,7 @@ func (producer *MolassesProducer) GetBlockBackward(trx *quorumpb.Trx) error {
 
 	var reqBlockItem quorumpb.ReqBlock
 
-	ciperKey, err := hex.DecodeString(producer.grpItem.CipherKey)
-	if err != nil {
-		return err
-	}
-
-	decryptData, err := localcrypto.AesDecode(trx.Data, ciperKey)
+	decryptData, err := aesDecodeTrxData(producer.grpItem, trx, producer.nodename)
 	if err != nil {
 		return err
 	}
@@ -342,12 +549,10 @@ func (producer *MolassesProducer) GetBlockBackward(trx *quorumpb.Trx) error {
 		return err
 	}
 
-	//check if requester is in group block list
-	isBlocked, _ := nodectx.GetDbMgr().IsUserBlocked(trx.GroupId, trx.SenderPubkey)
-
-	if isBlocked {
-		molaproducer_log.Debugf("<%s> user <%s> is blocked", producer.groupId, trx.SenderPubkey)
-		return nil
+	//check if requester is blocked or removed from group
+	if allowed, err := isSyncAllowed(producer.grpItem, trx.SenderPubkey, producer.nodename); !allowed {
+		molaproducer_log.Debugf("<%s> user <%s> is not allowed to sync", producer.groupId, trx.SenderPubkey)
+		return err
 	}
 
 	isExist, err := nodectx.GetDbMgr().IsBlockExist(reqBlockItem.BlockId, false, producer.nodename)
@@ -368,7 +573,7 @@ func (producer *MolassesProducer) GetBlockBackward(trx *quorumpb.Trx) error {
 	}
 
 	channelId := SYNC_CHANNEL_PREFIX + producer.grpItem.GroupId + "_" + reqBlockItem.UserId
-	trxMgr, _ := producer.getSyncConn(channelId)
+	trxMgr, _ := producer.getRespConn(trx, channelId)
 
 	if isParentExit {
 		molaproducer_log.Debugf("<%s> send REQ_NEXT_BLOCK_RESP (BLOCK_IN_TRX)", producer.groupId)
@@ -376,15 +581,79 @@ func (producer *MolassesProducer) GetBlockBackward(trx *quorumpb.Trx) error {
 		if err != nil {
 			return err
 		}
-		return trxMgr.SendReqBlockResp(&reqBlockItem, parentBlock, quorumpb.ReqBlkResult_BLOCK_IN_TRX)
+		return trxMgr.SendReqBlockResp(&reqBlockItem, parentBlock, quorumpb.ReqBlkResult_BLOCK_IN_TRX, trx.KeyEpoch)
 	} else {
 		var emptyBlock *quorumpb.Block
 		emptyBlock = &quorumpb.Block{}
 		emptyBlock.BlockId = guuid.New().String()
 		emptyBlock.ProducerPubKey = producer.grpItem.UserSignPubkey
 		molaproducer_log.Debugf("<%s> send REQ_NEXT_BLOCK_RESP (BLOCK_NOT_FOUND)", producer.groupId)
-		return trxMgr.SendReqBlockResp(&reqBlockItem, emptyBlock, quorumpb.ReqBlkResult_BLOCK_NOT_FOUND)
+		return trxMgr.SendReqBlockResp(&reqBlockItem, emptyBlock, quorumpb.ReqBlkResult_BLOCK_NOT_FOUND, trx.KeyEpoch)
+	}
+}
+
+func (producer *MolassesProducer) GetBlockRange(trx *quorumpb.Trx) error {
+	var reqBlockRangeItem quorumpb.ReqBlockRange
+	decryptData, err := aesDecodeTrxData(producer.grpItem, trx, producer.nodename)
+	if err != nil {
+		return err
+	}
+
+	if err := proto.Unmarshal(decryptData, &reqBlockRangeItem); err != nil {
+		return err
+	}
+
+	//range is asked from another producer
+	if reqBlockRangeItem.ProviderPubkey != producer.grpItem.UserSignPubkey {
+		return nil
+	}
+
+	molaproducer_log.Debugf("<%s> GetBlockRange called, block <%s>, offset <%d>, count <%d>", producer.groupId, reqBlockRangeItem.BlockId, reqBlockRangeItem.Offset, reqBlockRangeItem.Count)
+
+	//check if requester is blocked or removed from group
+	if allowed, err := isSyncAllowed(producer.grpItem, trx.SenderPubkey, producer.nodename); !allowed {
+		molaproducer_log.Debugf("<%s> user <%s> is not allowed to sync", producer.groupId, trx.SenderPubkey)
+		return err
+	}
+
+	count := reqBlockRangeItem.Count
+	if count > BLOCK_RANGE_SIZE {
+		count = BLOCK_RANGE_SIZE
+	}
+
+	blocks, err := GetDescendantBlocks(reqBlockRangeItem.BlockId, reqBlockRangeItem.Offset, count, producer.nodename)
+	if err != nil {
+		return err
+	}
+
+	channelId := SYNC_CHANNEL_PREFIX + producer.grpItem.GroupId + "_" + reqBlockRangeItem.UserId
+	trxMgr, _ := producer.getRespConn(trx, channelId)
+
+	if len(blocks) == 0 {
+		molaproducer_log.Debugf("<%s> send REQ_BLOCK_RANGE_RESP (BLOCK_NOT_FOUND)", producer.groupId)
+		return trxMgr.SendReqBlockRangeResp(&reqBlockRangeItem, nil, quorumpb.ReqBlkResult_BLOCK_NOT_FOUND, true, trx.KeyEpoch)
+	}
+
+	//send blocks in batches to keep each trx small
+	for start := 0; start < len(blocks); start += BLOCK_RANGE_BATCH {
+		end := start + BLOCK_RANGE_BATCH
+		if end > len(blocks) {
+			end = len(blocks)
+		}
+		molaproducer_log.Debugf("<%s> send REQ_BLOCK_RANGE_RESP (BLOCK_IN_TRX), <%d> blocks", producer.groupId, end-start)
+		if err := trxMgr.SendReqBlockRangeResp(&reqBlockRangeItem, blocks[start:end], quorumpb.ReqBlkResult_BLOCK_IN_TRX, end == len(blocks), trx.KeyEpoch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//respond over the sync stream if the request came from one, otherwise over the sync channel of requester
+func (producer *MolassesProducer) getRespConn(trx *quorumpb.Trx, channelId string) (*TrxMgr, error) {
+	if trxMgr, ok := producer.cIface.GetStreamTrxMgr(trx.TrxId); ok {
+		return trxMgr, nil
 	}
+	return producer.getSyncConn(channelId)
 }
 
 func (producer *MolassesProducer) getSyncConn(channelId string) (*TrxMgr, error) {
@@ -420,7 +689,55 @@ func (producer *MolassesProducer) getSyncConn(channelId string) (*TrxMgr, error)
 }
 
 func (producer *MolassesProducer) GetRecentSnapshot(trx *quorumpb.Trx) error {
-	return nil
+	molaproducer_log.Debugf("<%s> GetRecentSnapshot called", producer.groupId)
+
+	var reqSnapshotItem quorumpb.ReqSnapshot
+	decryptData, err := aesDecodeTrxData(producer.grpItem, trx, producer.nodename)
+	if err != nil {
+		return err
+	}
+
+	if err := proto.Unmarshal(decryptData, &reqSnapshotItem); err != nil {
+		return err
+	}
+
+	//check if requester is blocked or removed from group
+	if allowed, err := isSyncAllowed(producer.grpItem, trx.SenderPubkey, producer.nodename); !allowed {
+		molaproducer_log.Debugf("<%s> user <%s> is not allowed to sync", producer.groupId, trx.SenderPubkey)
+		return err
+	}
+
+	//send the latest snapshot taken, take one now if no snapshot taken yet
+	snapshot, err := nodectx.GetDbMgr().GetSnapshot(producer.groupId, producer.nodename)
+	if err != nil {
+		return err
+	}
+
+	if snapshot == nil {
+		snapshot, err = CreateSnapshot(producer.grpItem, producer.nodename)
+		if err != nil {
+			return err
+		}
+	}
+	snapshot.RequesterPubkey = reqSnapshotItem.UserId
+
+	channelId := SYNC_CHANNEL_PREFIX + producer.grpItem.GroupId + "_" + reqSnapshotItem.UserId
+	trxMgr, _ := producer.getRespConn(trx, channelId)
+
+	molaproducer_log.Debugf("<%s> send SNAPSHOT <%s>, height <%d>", producer.groupId, snapshot.SnapshotId, snapshot.Height)
+	return trxMgr.SendSnapshot(snapshot, trx.KeyEpoch)
+}
+
+func (producer *MolassesProducer) takeSnapshot() {
+	snapshot, err := CreateSnapshot(producer.grpItem, producer.nodename)
+	if err != nil {
+		molaproducer_log.Warningf("<%s> take snapshot failed <%s>", producer.groupId, err.Error())
+		return
+	}
+
+	if err := nodectx.GetDbMgr().SaveSnapshot(snapshot, producer.nodename); err != nil {
+		molaproducer_log.Warningf("<%s> save snapshot failed <%s>", producer.groupId, err.Error())
+	}
 }
 
 //addBlock for producer
@@ -482,6 +799,15 @@ func (producer *MolassesProducer) AddBlock(block *quorumpb.Block) error {
 		return err
 	}
 
+	//verify all trxs in those blocks before apply them
+	for _, blk := range blocks {
+		if err := producer.cIface.VerifyBlockTrxs(blk); err != nil {
+			molaproducer_log.Warningf("<%s> block <%s> contains invalid trx <%s>, remove it from cache", producer.groupId, blk.BlockId, err.Error())
+			nodectx.GetDbMgr().RmBlock(blk.BlockId, true, producer.nodename)
+			return err
+		}
+	}
+
 	//get all trxs in those new blocks
 	var trxs []*quorumpb.Trx
 	trxs, err = GetAllTrxs(blocks)
@@ -489,8 +815,15 @@ func (producer *MolassesProducer) AddBlock(block *quorumpb.Block) error {
 		return err
 	}
 
+	//apply trxs and move blocks to chain in one transaction, so a crash will not leave the chain half applied
+	dbMgr, err := nodectx.GetDbMgr().Begin()
+	if err != nil {
+		return err
+	}
+	defer dbMgr.Discard()
+
 	//apply those trxs
-	err = producer.applyTrxs(trxs)
+	err = producer.applyTrxs(dbMgr, trxs)
 	if err != nil {
 		return err
 	}
@@ -498,43 +831,70 @@ func (producer *MolassesProducer) AddBlock(block *quorumpb.Block) error {
 	//move blocks from cache to normal
 	for _, block := range blocks {
 		molaproducer_log.Debugf("<%s> move block <%s> from cache to chain", producer.groupId, block.BlockId)
-		err := nodectx.GetDbMgr().AddBlock(block, false, producer.nodename)
+		err := dbMgr.AddBlock(block, false, producer.nodename)
 		if err != nil {
 			return err
 		}
 
-		err = nodectx.GetDbMgr().RmBlock(block.BlockId, true, producer.nodename)
+		err = dbMgr.RmBlock(block.BlockId, true, producer.nodename)
 		if err != nil {
 			return err
 		}
 	}
 
+	err = finalizeCommittedBlocks(dbMgr, blocks, producer.cIface.GetChainCtx().ProducerPool, producer.nodename)
+	if err != nil {
+		return err
+	}
+
 	for _, block := range blocks {
-		err := nodectx.GetDbMgr().AddProducedBlockCount(producer.groupId, block.ProducerPubKey, producer.nodename)
+		err := dbMgr.AddProducedBlockCount(producer.groupId, block.ProducerPubKey, producer.nodename)
 		if err != nil {
 			return err
 		}
 	}
 
 	molaproducer_log.Debugf("<%s> chain height before recal: <%d>", producer.groupId, producer.grpItem.HighestHeight)
-	topBlock, err := nodectx.GetDbMgr().GetBlock(producer.grpItem.HighestBlockId, false, producer.nodename)
+	topBlock, err := dbMgr.GetBlock(producer.grpItem.HighestBlockId, false, producer.nodename)
 	if err != nil {
 		return err
 	}
-	newHeight, newHighestBlockId, err := RecalChainHeight(blocks, producer.grpItem.HighestHeight, topBlock, producer.nodename)
+	newHeight, newHighestBlockId, err := RecalChainHeight(dbMgr, blocks, producer.grpItem.HighestHeight, topBlock, producer.nodename)
 	if err != nil {
 		return err
 	}
 	molaproducer_log.Debugf("<%s> new height <%d>, new highest blockId %v", producer.groupId, newHeight, newHighestBlockId)
 
-	return producer.cIface.UpdChainInfo(newHeight, newHighestBlockId)
+	err = dbMgr.SetHighestBlock(producer.groupId, newHighestBlockId, producer.nodename)
+	if err != nil {
+		return err
+	}
+
+	err = dbMgr.Commit()
+	if err != nil {
+		return err
+	}
+
+	oldHeight := producer.grpItem.HighestHeight
+	err = producer.cIface.UpdChainInfo(newHeight, newHighestBlockId)
+	if err != nil {
+		return err
+	}
+
+	//take a snapshot when chain grows past the snapshot interval
+	if newHeight/SNAPSHOT_BLOCK_INTERVAL > oldHeight/SNAPSHOT_BLOCK_INTERVAL {
+		producer.takeSnapshot()
+	}
+
+	return nil
 }
 
-func (producer *MolassesProducer) applyTrxs(trxs []*quorumpb.Trx) error {
+func (producer *MolassesProducer) applyTrxs(dbMgr *storage.DbMgr, trxs []*quorumpb.Trx) error {
 	molaproducer_log.Debugf("<%s> applyTrxs called", producer.groupId)
 	for _, trx := range trxs {
+		trx := trx //trx is published after commit
 		//check if trx already applied
-		isExist, err := nodectx.GetDbMgr().IsTrxExist(trx.TrxId, producer.nodename)
+		isExist, err := dbMgr.IsTrxExist(trx.TrxId, producer.nodename)
 		if err != nil {
 			molaproducer_log.Debugf("<%s> %s", producer.groupId, err.Error())
 			continue
@@ -542,11 +902,14 @@ func (producer *MolassesProducer) applyTrxs(trxs []*quorumpb.Trx) error {
 
 		if isExist {
 			molaproducer_log.Debugf("<%s> trx <%s> existed, update trx", producer.groupId, trx.TrxId)
-			nodectx.GetDbMgr().AddTrx(trx)
+			if err := dbMgr.AddTrx(trx, producer.nodename); err != nil {
+				return err
+			}
 			continue
 		}
 
 		originalData := trx.Data
+		decrypted := true
 
 		if trx.Type == quorumpb.TrxType_POST && producer.grpItem.EncryptType == quorumpb.GroupEncryptType_PRIVATE {
 			//for post, private group, encrypted by pgp for all announced group user
@@ -560,43 +923,107 @@ func (producer *MolassesProducer) applyTrxs(trxs []*quorumpb.Trx) error {
 			if err == nil {
 				//set trx.Data to decrypted []byte
 				trx.Data = decryptData
+			} else {
+				decrypted = false
 			}
 		} else {
 			//decode trx data
-			ciperKey, err := hex.DecodeString(producer.grpItem.CipherKey)
+			decryptData, err := decodeAppliedTrxData(dbMgr, producer.grpItem, trx, producer.nodename)
 			if err != nil {
-				return err
-			}
-
-			decryptData, err := localcrypto.AesDecode(trx.Data, ciperKey)
-			if err != nil {
-				return err
+				if trx.KeyEpoch == producer.grpItem.KeyEpoch {
+					return err
+				}
+				//cipher key of the key epoch is not wrapped to us (removed from group or joined later), save trx only
+				molaproducer_log.Warningf("<%s> trx <%s> of key epoch <%d> can not be decrypted, save trx only", producer.groupId, trx.TrxId, trx.KeyEpoch)
+				if err := dbMgr.AddTrx(trx, producer.nodename); err != nil {
+					return err
+				}
+				continue
 			}
 
 			//set trx.Data to decrypted []byte
 			trx.Data = decryptData
 		}
 
+		//trx sent without the required role is saved only
+		if err := checkAppliedTrxPermission(dbMgr, trx, producer.grpItem, producer.nodename); err != nil {
+			molaproducer_log.Warningf("<%s> trx <%s> not applied, %s", producer.groupId, trx.TrxId, err.Error())
+			trx.Data = originalData
+			if err := dbMgr.AddTrx(trx, producer.nodename); err != nil {
+				return err
+			}
+			continue
+		}
+
 		molaproducer_log.Debugf("<%s> apply trx <%s>", producer.groupId, trx.TrxId)
 		//apply trx content
 		switch trx.Type {
 		case quorumpb.TrxType_POST:
 			molaproducer_log.Debugf("<%s> apply POST trx", producer.groupId)
-			nodectx.GetDbMgr().AddPost(trx, producer.nodename)
+			if !decrypted {
+				//the POST may be a new post or an activity of a post, it can not be applied without decryption
+				molaproducer_log.Debugf("<%s> POST trx <%s> can not be decrypted, save trx only", producer.groupId, trx.TrxId)
+				break
+			}
+			activity, post, err := producer.applyPost(dbMgr, trx)
+			if isApplyWriteError(err) {
+				return err
+			}
+			if err != nil {
+				break
+			}
+			if post != nil {
+				dbMgr.OnCommit(func() { publishPostUpdated(trx, post) })
+			} else if activity == nil {
+				dbMgr.OnCommit(func() { publishPostAdded(trx) })
+			}
 		case quorumpb.TrxType_AUTH:
 			molaproducer_log.Debugf("<%s> apply AUTH trx", producer.groupId)
-			nodectx.GetDbMgr().UpdateBlkListItem(trx, producer.nodename)
+			if err := dbMgr.UpdateBlkListItem(trx, producer.nodename); err != nil {
+				return err
+			}
 		case quorumpb.TrxType_PRODUCER:
 			molaproducer_log.Debugf("<%s> apply PRODUCER trx", producer.groupId)
-			nodectx.GetDbMgr().UpdateProducer(trx, producer.nodename)
-			producer.cIface.UpdProducerList()
-			producer.cIface.CreateConsensus()
+			if err := dbMgr.UpdateProducer(trx, producer.nodename); err != nil {
+				return err
+			}
+			//producers are loaded from db
+			dbMgr.OnCommit(func() {
+				producer.cIface.UpdProducerList()
+				producer.cIface.CreateConsensus()
+			})
 		case quorumpb.TrxType_ANNOUNCE:
 			molaproducer_log.Debugf("<%s> apply ANNOUNCE trx", producer.groupId)
-			nodectx.GetDbMgr().UpdateAnnounce(trx, producer.nodename)
+			if err := applyAnnounceTrx(dbMgr, trx, producer.grpItem, producer.cIface, producer.nodename); err != nil {
+				molaproducer_log.Warningf("<%s> ANNOUNCE trx <%s> not applied, %s", producer.groupId, trx.TrxId, err.Error())
+			}
 		case quorumpb.TrxType_SCHEMA:
 			molaproducer_log.Debugf("<%s> apply SCHEMA trx", producer.groupId)
-			nodectx.GetDbMgr().UpdateSchema(trx, producer.nodename)
+			if err := dbMgr.UpdateSchema(trx, producer.nodename); err != nil {
+				return err
+			}
+		case quorumpb.TrxType_STAKE:
+			molaproducer_log.Debugf("<%s> apply STAKE trx", producer.groupId)
+			if err := applyStakeTrx(dbMgr, trx, producer.grpItem, producer.nodename); err != nil {
+				molaproducer_log.Warningf("<%s> STAKE trx <%s> not applied, %s", producer.groupId, trx.TrxId, err.Error())
+			}
+		case quorumpb.TrxType_ANNOUNCE_RESULT:
+			molaproducer_log.Debugf("<%s> apply ANNOUNCE_RESULT trx", producer.groupId)
+			if err := applyAnnounceResultTrx(dbMgr, trx, producer.grpItem, producer.cIface, producer.nodename); err != nil {
+				molaproducer_log.Warningf("<%s> ANNOUNCE_RESULT trx <%s> not applied, %s", producer.groupId, trx.TrxId, err.Error())
+			}
+		case quorumpb.TrxType_ROLE:
+			molaproducer_log.Debugf("<%s> apply ROLE trx", producer.groupId)
+			if err := applyRoleTrx(dbMgr, trx, producer.grpItem, producer.nodename); err != nil {
+				molaproducer_log.Warningf("<%s> ROLE trx <%s> not applied, %s", producer.groupId, trx.TrxId, err.Error())
+			}
+		case quorumpb.TrxType_GROUP_KEY:
+			molaproducer_log.Debugf("<%s> apply GROUP_KEY trx", producer.groupId)
+			if err := applyGroupKeyTrx(dbMgr, trx, producer.grpItem, producer.cIface, producer.nodename); isApplyWriteError(err) {
+				return err
+			} else if err != nil {
+				molaproducer_log.Warningf("<%s> GROUP_KEY trx <%s> not applied, %s", producer.groupId, trx.TrxId, err.Error())
+			}
 		default:
 			molaproducer_log.Warningf("<%s> unsupported msgType <%s>", producer.groupId, trx.Type)
 		}
@@ -605,8 +1032,30 @@ func (producer *MolassesProducer) applyTrxs(trxs []*quorumpb.Trx) error {
 		trx.Data = originalData
 
 		//save trx to db
-		nodectx.GetDbMgr().AddTrx(trx, producer.nodename)
+		if err := dbMgr.AddTrx(trx, producer.nodename); err != nil {
+			return err
+		}
+		dbMgr.OnCommit(func() { publishTrxApplied(trx) })
 	}
 
 	return nil
 }
+
+func (producer *MolassesProducer) decryptTrxData(trx *quorumpb.Trx) ([]byte, error) {
+	return DecryptTrxData(producer.grpItem, trx, producer.nodename)
+}
+
+//POST packaged in block which mismatch group schema, or Update/Delete/reaction of a post not permitted is
+//dropped (trx is still saved)
+func (producer *MolassesProducer) applyPost(dbMgr *storage.DbMgr, trx *quorumpb.Trx) (*quorumpb.Activity, *quorumpb.PostItem, error) {
+	activity, post, err := applyPostTrx(dbMgr, trx, producer.grpItem, producer.nodename)
+	if isApplyWriteError(err) {
+		return nil, nil, err
+	}
+	if err != nil {
+		molaproducer_log.Warningf("<%s> POST trx <%s> dropped, %s", producer.groupId, trx.TrxId, err.Error())
+		producer.cIface.RejectTrx(trx, err)
+		return nil, nil, err
+	}
+	return activity, post, nil
+}
@@ -0,0 +1,90 @@
+package chain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rumsystem/quorum/internal/pkg/nodectx"
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+)
+
+//newTestProducer creates a producer of a group with 4 producers, 3 votes are the quorum
+func newTestProducer(t *testing.T) (*MolassesProducer, *testChainIface) {
+	grpItem := newTestGroup(t)
+	cIface := newTestChainIface(grpItem)
+	for _, pubkey := range []string{"p1", "p2", "p3"} {
+		cIface.chain.ProducerPool[pubkey] = &quorumpb.ProducerItem{ProducerPubkey: pubkey}
+	}
+	producer := &MolassesProducer{}
+	producer.Init(grpItem, "", cIface)
+	return producer, cIface
+}
+
+func addTestVotes(producer *MolassesProducer, voteType quorumpb.VoteType, block *quorumpb.Block) {
+	for _, voter := range []string{"p1", "p2", "p3"} {
+		producer.addVote(&quorumpb.BlockVote{Type: voteType, GroupId: block.GroupId, BlockId: block.BlockId, PrevBlockId: block.PrevBlockId, VoterPubkey: voter})
+	}
+}
+
+func isTestCommitted(producer *MolassesProducer, prevBlockId string) bool {
+	producer.votemu.Lock()
+	defer producer.votemu.Unlock()
+	return producer.committed[prevBlockId]
+}
+
+func TestPrevoteQuorumBeforeBlock(t *testing.T) {
+	producer, cIface := newTestProducer(t)
+	block := &quorumpb.Block{BlockId: "blk", PrevBlockId: "parent", GroupId: producer.groupId}
+
+	addTestVotes(producer, quorumpb.VoteType_PREVOTE, block)
+	if isTestCommitted(producer, block.PrevBlockId) {
+		t.Fatalf("block committed before it is received")
+	}
+
+	producer.AddBlockToPool(block)
+	if !isTestCommitted(producer, block.PrevBlockId) {
+		t.Fatalf("block not committed after it is received")
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for cIface.producerPsconn.count() == 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if cIface.producerPsconn.count() != 1 {
+		t.Errorf("commit vote not sent, %d published", cIface.producerPsconn.count())
+	}
+}
+
+func TestCommitQuorumBeforeBlock(t *testing.T) {
+	producer, _ := newTestProducer(t)
+	block := &quorumpb.Block{BlockId: "blk", PrevBlockId: "parent", GroupId: producer.groupId}
+
+	addTestVotes(producer, quorumpb.VoteType_COMMIT, block)
+	producer.AddBlockToPool(block)
+
+	select {
+	case blkId := <-producer.commitCh:
+		if blkId != block.BlockId {
+			t.Errorf("got committed block %s, want %s", blkId, block.BlockId)
+		}
+	case <-time.After(time.Second):
+		t.Errorf("block not finalized after it is received")
+	}
+}
+
+func TestReturnTrxsToPool(t *testing.T) {
+	producer, _ := newTestProducer(t)
+	committed := &quorumpb.Trx{TrxId: "committed", GroupId: producer.groupId}
+	pending := &quorumpb.Trx{TrxId: "pending", GroupId: producer.groupId}
+	if err := nodectx.GetDbMgr().AddTrx(committed, ""); err != nil {
+		t.Fatalf("add trx err: %s", err)
+	}
+
+	producer.AddBlockToPool(&quorumpb.Block{BlockId: "mine", PrevBlockId: "parent", ProducerPubKey: producer.grpItem.UserSignPubkey, Trxs: []*quorumpb.Trx{committed, pending}})
+	producer.AddBlockToPool(&quorumpb.Block{BlockId: "other", PrevBlockId: "parent", ProducerPubKey: "p1", Trxs: []*quorumpb.Trx{{TrxId: "other"}}})
+	producer.returnTrxsToPool()
+
+	if len(producer.trxPool) != 1 || producer.trxPool["pending"] == nil {
+		t.Errorf("got trx pool %v, want the pending trx only", producer.trxPool)
+	}
+}
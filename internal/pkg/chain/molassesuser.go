@@ -1,13 +1,13 @@
 package chain
 
 import (
-	"encoding/hex"
 	"errors"
 
 	logging "github.com/ipfs/go-log/v2"
 	localcrypto "github.com/rumsystem/quorum/internal/pkg/crypto"
 	"github.com/rumsystem/quorum/internal/pkg/nodectx"
 	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+	"github.com/rumsystem/quorum/internal/pkg/storage"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -49,6 +49,26 @@ func (user *MolassesUser) UpdProducer(item *quorumpb.ProducerItem) (string, erro
 	return user.cIface.GetProducerTrxMgr().SendRegProducerTrx(item)
 }
 
+func (user *MolassesUser) UpdStake(item *quorumpb.StakeItem) (string, error) {
+	molauser_log.Debugf("<%s> UpdStake called", user.groupId)
+	return user.cIface.GetProducerTrxMgr().SendUpdStakeTrx(item)
+}
+
+func (user *MolassesUser) UpdAnnounceResult(item *quorumpb.AnnounceItem) (string, error) {
+	molauser_log.Debugf("<%s> UpdAnnounceResult called", user.groupId)
+	return user.cIface.GetProducerTrxMgr().SendAnnounceResultTrx(item)
+}
+
+func (user *MolassesUser) UpdGroupKey(item *quorumpb.GroupKeyItem) (string, error) {
+	molauser_log.Debugf("<%s> UpdGroupKey called", user.groupId)
+	return user.cIface.GetProducerTrxMgr().SendUpdGroupKeyTrx(item)
+}
+
+func (user *MolassesUser) UpdRole(item *quorumpb.RoleItem) (string, error) {
+	molauser_log.Debugf("<%s> UpdRole called", user.groupId)
+	return user.cIface.GetProducerTrxMgr().SendUpdRoleTrx(item)
+}
+
 func (user *MolassesUser) PostToGroup(content proto.Message) (string, error) {
 	molauser_log.Debugf("<%s> PostToGroup called", user.groupId)
 	if user.cIface.IsSyncerReady() {
@@ -117,6 +137,15 @@ func (user *MolassesUser) AddBlock(block *quorumpb.Block) error {
 		return err
 	}
 
+	//verify all trxs in those blocks before apply them
+	for _, blk := range blocks {
+		if err := user.cIface.VerifyBlockTrxs(blk); err != nil {
+			molauser_log.Warningf("<%s> block <%s> contains invalid trx <%s>, remove it from cache", user.groupId, blk.BlockId, err.Error())
+			nodectx.GetDbMgr().RmBlock(blk.BlockId, true, user.nodename)
+			return err
+		}
+	}
+
 	//get all trxs from those blocks
 	var trxs []*quorumpb.Trx
 	trxs, err = GetAllTrxs(blocks)
@@ -124,8 +153,15 @@ func (user *MolassesUser) AddBlock(block *quorumpb.Block) error {
 		return err
 	}
 
+	//apply trxs and move blocks to chain in one transaction, so a crash will not leave the chain half applied
+	dbMgr, err := nodectx.GetDbMgr().Begin()
+	if err != nil {
+		return err
+	}
+	defer dbMgr.Discard()
+
 	//apply those trxs
-	err = user.applyTrxs(trxs, user.nodename)
+	err = user.applyTrxs(dbMgr, trxs, user.nodename)
 	if err != nil {
 		return err
 	}
@@ -133,20 +169,25 @@ func (user *MolassesUser) AddBlock(block *quorumpb.Block) error {
 	//move gathered blocks from cache to chain
 	for _, block := range blocks {
 		molauser_log.Debugf("<%s> move block <%s> from cache to chain", user.groupId, block.BlockId)
-		err := nodectx.GetDbMgr().AddBlock(block, false, user.nodename)
+		err := dbMgr.AddBlock(block, false, user.nodename)
 		if err != nil {
 			return err
 		}
 
-		err = nodectx.GetDbMgr().RmBlock(block.BlockId, true, user.nodename)
+		err = dbMgr.RmBlock(block.BlockId, true, user.nodename)
 		if err != nil {
 			return err
 		}
 	}
 
+	err = finalizeCommittedBlocks(dbMgr, blocks, user.cIface.GetChainCtx().ProducerPool, user.nodename)
+	if err != nil {
+		return err
+	}
+
 	//update block produced count
 	for _, block := range blocks {
-		err := nodectx.GetDbMgr().AddProducedBlockCount(user.groupId, block.ProducerPubKey, user.nodename)
+		err := dbMgr.AddProducedBlockCount(user.groupId, block.ProducerPubKey, user.nodename)
 		if err != nil {
 			return err
 		}
@@ -154,21 +195,31 @@ func (user *MolassesUser) AddBlock(block *quorumpb.Block) error {
 
 	//calculate new height
 	molauser_log.Debugf("<%s> height before recal <%d>", user.groupId, user.grpItem.HighestHeight)
-	topBlock, err := nodectx.GetDbMgr().GetBlock(user.grpItem.HighestBlockId, false, user.nodename)
+	topBlock, err := dbMgr.GetBlock(user.grpItem.HighestBlockId, false, user.nodename)
 	if err != nil {
 		return err
 	}
-	newHeight, newHighestBlockId, err := RecalChainHeight(blocks, user.grpItem.HighestHeight, topBlock, user.nodename)
+	newHeight, newHighestBlockId, err := RecalChainHeight(dbMgr, blocks, user.grpItem.HighestHeight, topBlock, user.nodename)
 	if err != nil {
 		return err
 	}
 	molauser_log.Debugf("<%s> new height <%d>, new highest blockId %v", user.groupId, newHeight, newHighestBlockId)
 
+	err = dbMgr.SetHighestBlock(user.groupId, newHighestBlockId, user.nodename)
+	if err != nil {
+		return err
+	}
+
+	err = dbMgr.Commit()
+	if err != nil {
+		return err
+	}
+
 	//if the new block is not highest block after recalculate, we need to "trim" the chain
 	if newHeight < user.grpItem.HighestHeight {
 
 		//from parent of the new blocks, get all blocks not belong to the longest path
-		resendBlocks, err := GetTrimedBlocks(blocks, user.nodename)
+		resendBlocks, err := GetTrimedBlocks(blocks, newHighestBlockId, user.nodename)
 		if err != nil {
 			return err
 		}
@@ -197,11 +248,12 @@ func (user *MolassesUser) resendTrx(trxs []*quorumpb.Trx) error {
 	return nil
 }
 
-func (user *MolassesUser) applyTrxs(trxs []*quorumpb.Trx, nodename string) error {
+func (user *MolassesUser) applyTrxs(dbMgr *storage.DbMgr, trxs []*quorumpb.Trx, nodename string) error {
 	molauser_log.Debugf("<%s> applyTrxs called", user.groupId)
 	for _, trx := range trxs {
+		trx := trx //trx is published after commit
 		//check if trx already applied
-		isExist, err := nodectx.GetDbMgr().IsTrxExist(trx.TrxId, nodename)
+		isExist, err := dbMgr.IsTrxExist(trx.TrxId, nodename)
 		if err != nil {
 			molauser_log.Debugf("<%s> %s", user.groupId, err.Error())
 			continue
@@ -209,59 +261,127 @@ func (user *MolassesUser) applyTrxs(trxs []*quorumpb.Trx, nodename string) error
 
 		if isExist {
 			molauser_log.Debugf("<%s> trx <%s> existed, update trx only", user.groupId, trx.TrxId)
-			nodectx.GetDbMgr().AddTrx(trx)
+			if err := dbMgr.AddTrx(trx, nodename); err != nil {
+				return err
+			}
 			continue
 		}
 
 		originalData := trx.Data
+		decrypted := true
 
 		//new trx, apply it
 		if trx.Type == quorumpb.TrxType_POST && user.grpItem.EncryptType == quorumpb.GroupEncryptType_PRIVATE {
 			//for post, private group, encrypted by pgp for all announced group user
+			//POST not encrypted for us (removed from group or approved later) is saved only
 			ks := localcrypto.GetKeystore()
 			decryptData, err := ks.Decrypt(user.grpItem.UserEncryptPubkey, trx.Data)
-			if err != nil {
-				return err
+			if err == nil {
+				//set trx.Data to decrypted []byte
+				trx.Data = decryptData
+			} else {
+				decrypted = false
 			}
-
-			//set trx.Data to decrypted []byte
-			trx.Data = decryptData
 		} else {
 			//decode trx data
-			ciperKey, err := hex.DecodeString(user.grpItem.CipherKey)
-			if err != nil {
-				return err
-			}
-
-			decryptData, err := localcrypto.AesDecode(trx.Data, ciperKey)
+			decryptData, err := decodeAppliedTrxData(dbMgr, user.grpItem, trx, user.nodename)
 			if err != nil {
-				return err
+				if trx.KeyEpoch == user.grpItem.KeyEpoch {
+					return err
+				}
+				//cipher key of the key epoch is not wrapped to us (removed from group or joined later), save trx only
+				molauser_log.Warningf("<%s> trx <%s> of key epoch <%d> can not be decrypted, save trx only", user.groupId, trx.TrxId, trx.KeyEpoch)
+				if err := dbMgr.AddTrx(trx, nodename); err != nil {
+					return err
+				}
+				continue
 			}
 
 			//set trx.Data to decrypted []byte
 			trx.Data = decryptData
 		}
 
+		//trx sent without the required role is saved only
+		if err := checkAppliedTrxPermission(dbMgr, trx, user.grpItem, nodename); err != nil {
+			molauser_log.Warningf("<%s> trx <%s> not applied, %s", user.groupId, trx.TrxId, err.Error())
+			trx.Data = originalData
+			if err := dbMgr.AddTrx(trx, nodename); err != nil {
+				return err
+			}
+			continue
+		}
+
 		molauser_log.Debugf("<%s> try apply trx <%s>", user.groupId, trx.TrxId)
 		//apply trx content
 		switch trx.Type {
 		case quorumpb.TrxType_POST:
 			molauser_log.Debugf("<%s> apply POST trx", user.groupId)
-			nodectx.GetDbMgr().AddPost(trx, nodename)
+			if !decrypted {
+				molauser_log.Debugf("<%s> POST trx <%s> can not be decrypted, save trx only", user.groupId, trx.TrxId)
+				break
+			}
+			//POST mismatch group schema, or Update/Delete/reaction of a post not permitted is dropped (trx is still saved)
+			activity, post, err := applyPostTrx(dbMgr, trx, user.grpItem, nodename)
+			if isApplyWriteError(err) {
+				return err
+			}
+			if err != nil {
+				molauser_log.Warningf("<%s> POST trx <%s> dropped, %s", user.groupId, trx.TrxId, err.Error())
+				user.cIface.RejectTrx(trx, err)
+				break
+			}
+			if post != nil {
+				dbMgr.OnCommit(func() { publishPostUpdated(trx, post) })
+			} else if activity == nil {
+				dbMgr.OnCommit(func() { publishPostAdded(trx) })
+			}
 		case quorumpb.TrxType_AUTH:
 			molauser_log.Debugf("<%s> apply AUTH trx", user.groupId)
-			nodectx.GetDbMgr().UpdateBlkListItem(trx, nodename)
+			if err := dbMgr.UpdateBlkListItem(trx, nodename); err != nil {
+				return err
+			}
 		case quorumpb.TrxType_PRODUCER:
 			molauser_log.Debugf("<%s> apply PRODUCER trx", user.groupId)
-			nodectx.GetDbMgr().UpdateProducer(trx, nodename)
-			user.cIface.UpdProducerList()
-			user.cIface.CreateConsensus()
+			if err := dbMgr.UpdateProducer(trx, nodename); err != nil {
+				return err
+			}
+			//producers are loaded from db
+			dbMgr.OnCommit(func() {
+				user.cIface.UpdProducerList()
+				user.cIface.CreateConsensus()
+			})
 		case quorumpb.TrxType_ANNOUNCE:
 			molauser_log.Debugf("<%s> apply ANNOUNCE trx", user.groupId)
-			nodectx.GetDbMgr().UpdateAnnounce(trx, nodename)
+			if err := applyAnnounceTrx(dbMgr, trx, user.grpItem, user.cIface, nodename); err != nil {
+				molauser_log.Warningf("<%s> ANNOUNCE trx <%s> not applied, %s", user.groupId, trx.TrxId, err.Error())
+			}
 		case quorumpb.TrxType_SCHEMA:
 			molauser_log.Debugf("<%s> apply SCHEMA trx", user.groupId)
-			nodectx.GetDbMgr().UpdateSchema(trx, nodename)
+			if err := dbMgr.UpdateSchema(trx, nodename); err != nil {
+				return err
+			}
+		case quorumpb.TrxType_STAKE:
+			molauser_log.Debugf("<%s> apply STAKE trx", user.groupId)
+			if err := applyStakeTrx(dbMgr, trx, user.grpItem, nodename); err != nil {
+				molauser_log.Warningf("<%s> STAKE trx <%s> not applied, %s", user.groupId, trx.TrxId, err.Error())
+			}
+		case quorumpb.TrxType_ANNOUNCE_RESULT:
+			molauser_log.Debugf("<%s> apply ANNOUNCE_RESULT trx", user.groupId)
+			if err := applyAnnounceResultTrx(dbMgr, trx, user.grpItem, user.cIface, nodename); err != nil {
+				molauser_log.Warningf("<%s> ANNOUNCE_RESULT trx <%s> not applied, %s", user.groupId, trx.TrxId, err.Error())
+			}
+		case quorumpb.TrxType_ROLE:
+			molauser_log.Debugf("<%s> apply ROLE trx", user.groupId)
+			if err := applyRoleTrx(dbMgr, trx, user.grpItem, nodename); err != nil {
+				molauser_log.Warningf("<%s> ROLE trx <%s> not applied, %s", user.groupId, trx.TrxId, err.Error())
+			}
+		case quorumpb.TrxType_GROUP_KEY:
+			molauser_log.Debugf("<%s> apply GROUP_KEY trx", user.groupId)
+			if err := applyGroupKeyTrx(dbMgr, trx, user.grpItem, user.cIface, nodename); isApplyWriteError(err) {
+				return err
+			} else if err != nil {
+				molauser_log.Warningf("<%s> GROUP_KEY trx <%s> not applied, %s", user.groupId, trx.TrxId, err.Error())
+			}
 		default:
 			molauser_log.Warningf("<%s> unsupported msgType <%s>", user.groupId, trx.Type)
 		}
@@ -270,7 +390,10 @@ func (user *MolassesUser) applyTrxs(trxs []*quorumpb.Trx, nodename string) error
 		trx.Data = originalData
 
 		//save trx to db
-		nodectx.GetDbMgr().AddTrx(trx, nodename)
+		if err := dbMgr.AddTrx(trx, nodename); err != nil {
+			return err
+		}
+		dbMgr.OnCommit(func() { publishTrxApplied(trx) })
 	}
 
 	return nil
@@ -7,20 +7,49 @@ import (
 	localCrypto "github.com/rumsystem/quorum/internal/pkg/crypto"
 	"github.com/rumsystem/quorum/internal/pkg/nodectx"
 	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+	"github.com/rumsystem/quorum/internal/pkg/storage"
 )
 
 var molautil_log = logging.Logger("util")
 
 //find the highest block from the block tree
-func RecalChainHeight(blocks []*quorumpb.Block, currentHeight int64, currentHighestBlock *quorumpb.Block, nodename string) (int64, string, error) {
+func RecalChainHeight(dbMgr *storage.DbMgr, blocks []*quorumpb.Block, currentHeight int64, currentHighestBlock *quorumpb.Block, nodename string) (int64, string, error) {
 	molautil_log.Debug("RecalChainHeight called")
 
 	newHighestHeight := currentHeight
 	newHighestBlockId := currentHighestBlock.BlockId
 	newHighestBlock := currentHighestBlock
 
+	//chain never reorganizes past a finalized block, restart from the finalized block if current highest block is on another branch
+	onFinalizedPath, err := isOnFinalizedPath(dbMgr, currentHighestBlock, nodename)
+	if err != nil {
+		return -1, "INVALID_BLOCK_ID", err
+	}
+	if !onFinalizedPath {
+		finalizedBlockId, finalizedHeight, err := dbMgr.GetFinalizedBlock(currentHighestBlock.GroupId, nodename)
+		if err != nil {
+			return -1, "INVALID_BLOCK_ID", err
+		}
+		finalizedBlock, err := dbMgr.GetBlock(finalizedBlockId, false, nodename)
+		if err != nil {
+			return -1, "INVALID_BLOCK_ID", err
+		}
+		newHighestHeight = finalizedHeight
+		newHighestBlockId = finalizedBlockId
+		newHighestBlock = finalizedBlock
+	}
+
 	for _, block := range blocks {
-		blockHeight, err := nodectx.GetDbMgr().GetBlockHeight(block.BlockId, nodename)
+		onFinalizedPath, err := isOnFinalizedPath(dbMgr, block, nodename)
+		if err != nil {
+			return -1, "INVALID_BLOCK_ID", err
+		}
+		if !onFinalizedPath {
+			molautil_log.Debugf("<%s> block <%s> is not on finalized path, skip it", block.GroupId, block.BlockId)
+			continue
+		}
+
+		blockHeight, err := dbMgr.GetBlockHeight(block.BlockId, nodename)
 		if err != nil {
 			return -1, "INVALID_BLOCK_ID", err
 		}
@@ -41,27 +70,80 @@ func RecalChainHeight(blocks []*quorumpb.Block, currentHeight int64, currentHigh
 	return newHighestHeight, newHighestBlockId, nil
 }
 
-//from root of the new block tree, get all blocks trimed when not belong to longest path
-func GetTrimedBlocks(blocks []*quorumpb.Block, nodename string) ([]string, error) {
+//check if the block is the highest finalized block or its descendant
+func isOnFinalizedPath(dbMgr *storage.DbMgr, block *quorumpb.Block, nodename string) (bool, error) {
+	finalizedBlockId, finalizedHeight, err := dbMgr.GetFinalizedBlock(block.GroupId, nodename)
+	if err != nil {
+		return false, err
+	}
+
+	//no block finalized yet
+	if finalizedBlockId == "" {
+		return true, nil
+	}
+
+	height, err := dbMgr.GetBlockHeight(block.BlockId, nodename)
+	if err != nil {
+		return false, err
+	}
+
+	if height < finalizedHeight {
+		return false, nil
+	}
+
+	//walk back to the height of finalized block
+	ancestor := block
+	for ; height > finalizedHeight; height-- {
+		ancestor, err = dbMgr.GetBlock(ancestor.PrevBlockId, false, nodename)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return ancestor.BlockId == finalizedBlockId, nil
+}
+
+//from root of the new block tree, get all blocks trimed when not belong to longest path, which ends at the highest
+//block. Finalized blocks are never trimmed
+func GetTrimedBlocks(blocks []*quorumpb.Block, highestBlockId string, nodename string) ([]string, error) {
 	molautil_log.Debug("GetTrimedBlocks called")
 	var cache map[string]bool
-	var longestPath []string
 	var result []string
 
 	cache = make(map[string]bool)
 
-	err := dfs(blocks, cache, longestPath, nodename)
+	tree, err := dfs(blocks, cache, nil, nodename)
+	if err != nil {
+		return nil, err
+	}
+
+	//blocks of the tree on the longest path are the ancestors of the highest block
+	longestPath := make(map[string]bool)
+	for blockId := highestBlockId; cache[blockId] && !longestPath[blockId]; {
+		longestPath[blockId] = true
+		block, err := nodectx.GetDbMgr().GetBlock(blockId, false, nodename)
+		if err != nil {
+			return nil, err
+		}
+		blockId = block.PrevBlockId
+	}
 
-	for _, blockId := range longestPath {
-		if _, ok := cache[blockId]; !ok {
-			result = append(result, blockId)
+	for _, blockId := range tree {
+		if longestPath[blockId] {
+			continue
 		}
+		finalized, err := nodectx.GetDbMgr().IsBlockFinalized(blockId, nodename)
+		if err == nil && finalized {
+			continue
+		}
+		result = append(result, blockId)
 	}
 
-	return result, err
+	return result, nil
 }
 
-func dfs(blocks []*quorumpb.Block, cache map[string]bool, result []string, nodename string) error {
+//dfs appends the blocks and all their descendants not in cache to result
+func dfs(blocks []*quorumpb.Block, cache map[string]bool, result []string, nodename string) ([]string, error) {
 	molautil_log.Debug("dfs called")
 	for _, block := range blocks {
 		if _, ok := cache[block.BlockId]; !ok {
@@ -69,12 +151,40 @@ func dfs(blocks []*quorumpb.Block, cache map[string]bool, result []string, noden
 			result = append(result, block.BlockId)
 			subBlocks, err := nodectx.GetDbMgr().GetSubBlock(block.BlockId, nodename)
 			if err != nil {
-				return err
+				return nil, err
+			}
+			if result, err = dfs(subBlocks, cache, result, nodename); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return result, nil
+}
+
+//get descendants of the block which are deeper than offset levels and not deeper than offset + count levels
+func GetDescendantBlocks(blockId string, offset int64, count int64, nodename string) ([]*quorumpb.Block, error) {
+	molautil_log.Debug("GetDescendantBlocks called")
+	var result []*quorumpb.Block
+	level := []string{blockId}
+
+	for depth := int64(1); depth <= offset+count && len(level) != 0; depth++ {
+		var nextLevel []string
+		for _, id := range level {
+			subBlocks, err := nodectx.GetDbMgr().GetSubBlock(id, nodename)
+			if err != nil {
+				return nil, err
+			}
+			for _, block := range subBlocks {
+				nextLevel = append(nextLevel, block.BlockId)
+				if depth > offset {
+					result = append(result, block)
+				}
 			}
-			err = dfs(subBlocks, cache, result, nodename)
 		}
+		level = nextLevel
 	}
-	return nil
+
+	return result, nil
 }
 
 //get all trx belongs to me from the block list
@@ -0,0 +1,36 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/rumsystem/quorum/internal/pkg/nodectx"
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+)
+
+func TestGetTrimedBlocks(t *testing.T) {
+	grpItem := newTestGroup(t)
+	dbMgr := nodectx.GetDbMgr()
+	if err := dbMgr.AddGensisBlock(&quorumpb.Block{BlockId: "genesis", GroupId: grpItem.GroupId}, ""); err != nil {
+		t.Fatalf("add genesis block err: %s", err)
+	}
+
+	//a <- b is the longest path, c and d are forks on genesis, c is finalized
+	blocks := map[string]*quorumpb.Block{}
+	for _, ids := range [][2]string{{"a", "genesis"}, {"b", "a"}, {"c", "genesis"}, {"d", "genesis"}} {
+		blocks[ids[0]] = &quorumpb.Block{BlockId: ids[0], PrevBlockId: ids[1], GroupId: grpItem.GroupId}
+		if err := dbMgr.AddBlock(blocks[ids[0]], false, ""); err != nil {
+			t.Fatalf("add block %s err: %s", ids[0], err)
+		}
+	}
+	if err := dbMgr.FinalizeBlock("c", ""); err != nil {
+		t.Fatalf("finalize block err: %s", err)
+	}
+
+	trimed, err := GetTrimedBlocks([]*quorumpb.Block{blocks["a"], blocks["c"], blocks["d"]}, "b", "")
+	if err != nil {
+		t.Fatalf("get trimed blocks err: %s", err)
+	}
+	if len(trimed) != 1 || trimed[0] != "d" {
+		t.Errorf("got trimed blocks %v, want [d]", trimed)
+	}
+}
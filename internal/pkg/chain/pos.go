@@ -0,0 +1,225 @@
+package chain
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	logging "github.com/ipfs/go-log/v2"
+	"github.com/rumsystem/quorum/internal/pkg/nodectx"
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+	"github.com/rumsystem/quorum/internal/pkg/storage"
+	"google.golang.org/protobuf/proto"
+)
+
+var pos_log = logging.Logger("pos")
+
+type Pos struct {
+	name     string
+	producer Producer
+	user     User
+}
+
+func NewPos(p Producer, u User) *Pos {
+	return &Pos{name: "Pos", producer: p, user: u}
+}
+
+func (p *Pos) Name() string {
+	return p.name
+}
+
+func (p *Pos) Producer() Producer {
+	return p.producer
+}
+
+func (p *Pos) User() User {
+	return p.user
+}
+
+//stake registry of a group, built from STAKE trxs applied on chain
+type stakeRegistry struct {
+	stakes  map[string]int64 //producer pubkey -> active stake
+	slashed map[string]bool  //producer pubkey -> slashed
+}
+
+func loadStakeRegistry(groupId string, nodename string) (*stakeRegistry, error) {
+	items, err := nodectx.GetDbMgr().GetStakes(groupId, nodename)
+	if err != nil {
+		return nil, err
+	}
+
+	registry := &stakeRegistry{stakes: make(map[string]int64), slashed: make(map[string]bool)}
+	for _, item := range items {
+		if item.Slashed {
+			registry.slashed[item.ProducerPubkey] = true
+			continue
+		}
+		if item.Stake > 0 {
+			registry.stakes[item.ProducerPubkey] = item.Stake
+		}
+	}
+	return registry, nil
+}
+
+//before any stake registered, pos group works as a molasses group
+func (registry *stakeRegistry) isActive() bool {
+	return len(registry.stakes) != 0
+}
+
+func (registry *stakeRegistry) isSlashed(pubkey string) bool {
+	return registry.slashed[pubkey]
+}
+
+func (registry *stakeRegistry) getStake(pubkey string) int64 {
+	return registry.stakes[pubkey]
+}
+
+//checkPosBlock checks a block before it is added to chain, block from slashed or unstaked producer is rejected.
+//If the producer already has another block with the same parent on chain and both blocks are committed, the
+//producer equivocated, the block is rejected and the block on chain is returned as the evidence to slash the producer
+func checkPosBlock(groupId string, block *quorumpb.Block, nodename string) (*quorumpb.Block, error) {
+	registry, err := loadStakeRegistry(groupId, nodename)
+	if err != nil {
+		return nil, err
+	}
+
+	if registry.isSlashed(block.ProducerPubKey) {
+		pos_log.Warningf("<%s> block <%s> produced by slashed producer <%s>", groupId, block.BlockId, block.ProducerPubKey)
+		return nil, errors.New("PRODUCER_SLASHED")
+	}
+
+	if registry.isActive() && registry.getStake(block.ProducerPubKey) == 0 {
+		pos_log.Warningf("<%s> block <%s> produced by producer <%s> without stake", groupId, block.BlockId, block.ProducerPubKey)
+		return nil, errors.New("PRODUCER_NOT_STAKED")
+	}
+
+	//parent may not exist yet, equivocation will be checked when the block is synced again
+	siblings, err := nodectx.GetDbMgr().GetSubBlock(block.PrevBlockId, nodename)
+	if err != nil {
+		return nil, nil
+	}
+
+	for _, sibling := range siblings {
+		if sibling.ProducerPubKey != block.ProducerPubKey || sibling.BlockId == block.BlockId {
+			continue
+		}
+		//producer produces a new block on the same parent after a round dropped by vote timeout, only 2 blocks
+		//committed on the same parent prove the equivocation
+		producers, err := loadProducerPool(nodectx.GetDbMgr(), groupId, nodename)
+		if err != nil {
+			return nil, err
+		}
+		if IsBlockCommitted(block, producers) && IsBlockCommitted(sibling, producers) {
+			pos_log.Warningf("<%s> producer <%s> equivocated on block <%s>", groupId, block.ProducerPubKey, block.PrevBlockId)
+			return sibling, errors.New("PRODUCER_EQUIVOCATED")
+		}
+	}
+
+	return nil, nil
+}
+
+//loadProducerPool returns the producers of a group by pubkey, the commit votes of blocks are checked against it
+func loadProducerPool(dbMgr *storage.DbMgr, groupId string, nodename string) (map[string]*quorumpb.ProducerItem, error) {
+	items, err := dbMgr.GetProducers(groupId, nodename)
+	if err != nil {
+		return nil, err
+	}
+	producers := make(map[string]*quorumpb.ProducerItem)
+	for _, item := range items {
+		producers[item.ProducerPubkey] = item
+	}
+	return producers, nil
+}
+
+//GetStakeHash returns the hash signed by group owner to add or remove the stake of a producer
+func GetStakeHash(item *quorumpb.StakeItem) []byte {
+	var buffer bytes.Buffer
+	buffer.Write([]byte(item.GroupId))
+	buffer.Write([]byte(item.ProducerPubkey))
+	buffer.Write([]byte(fmt.Sprint(item.Stake)))
+	buffer.Write([]byte(item.GroupOwnerPubkey))
+	buffer.Write([]byte(item.Action.String()))
+	return Hash(buffer.Bytes())
+}
+
+//newSlashItem creates the STAKE item to slash an equivocating producer, the item carries the conflicting blocks
+//so every node verifies the equivocation by itself when the trx is applied
+func newSlashItem(groupId string, blocks ...*quorumpb.Block) *quorumpb.StakeItem {
+	return &quorumpb.StakeItem{
+		GroupId:        groupId,
+		ProducerPubkey: blocks[0].ProducerPubKey,
+		Slashed:        true,
+		Evidence:       blocks,
+		TimeStamp:      time.Now().UnixNano(),
+		Memo:           "equivocation",
+	}
+}
+
+//verifySlashEvidence checks the evidence of a slash item, it should be 2 different blocks on the same parent
+//signed by the slashed producer and committed by producers. A block of a round dropped by vote timeout is not
+//committed, so the new block produced on the same parent in the next round is not an evidence
+func verifySlashEvidence(item *quorumpb.StakeItem, producers map[string]*quorumpb.ProducerItem) error {
+	if len(item.Evidence) != 2 {
+		return errors.New("SLASH_EVIDENCE_INVALID")
+	}
+
+	first, second := item.Evidence[0], item.Evidence[1]
+	if first.PrevBlockId != second.PrevBlockId || first.BlockId == second.BlockId {
+		return errors.New("SLASH_EVIDENCE_INVALID")
+	}
+
+	for _, block := range item.Evidence {
+		if block.GroupId != item.GroupId || block.ProducerPubKey != item.ProducerPubkey {
+			return errors.New("SLASH_EVIDENCE_INVALID")
+		}
+		if valid, err := isBlockHashValid(block); err != nil || !valid {
+			return errors.New("SLASH_EVIDENCE_INVALID")
+		}
+		if valid, err := verifyBySignPubkey(block.ProducerPubKey, block.Hash, block.Signature); err != nil || !valid {
+			return errors.New("SLASH_EVIDENCE_INVALID")
+		}
+		if !IsBlockCommitted(block, producers) {
+			return errors.New("SLASH_EVIDENCE_NOT_COMMITTED")
+		}
+	}
+	return nil
+}
+
+//applyStakeTrx applies a STAKE trx. A slash item can be sent by anyone with the evidence of equivocation,
+//other items must be sent and signed by group owner, a slashed stake can not be added back
+func applyStakeTrx(dbMgr *storage.DbMgr, trx *quorumpb.Trx, grpItem *quorumpb.GroupItem, nodename string) error {
+	item := &quorumpb.StakeItem{}
+	if err := proto.Unmarshal(trx.Data, item); err != nil {
+		return err
+	}
+	if item.GroupId != grpItem.GroupId {
+		return errors.New("STAKE item mismatch")
+	}
+
+	if item.Slashed {
+		producers, err := loadProducerPool(dbMgr, grpItem.GroupId, nodename)
+		if err != nil {
+			return err
+		}
+		if err := verifySlashEvidence(item, producers); err != nil {
+			return err
+		}
+		pos_log.Warningf("<%s> producer <%s> equivocated, slash it", item.GroupId, item.ProducerPubkey)
+		return dbMgr.SlashProducer(item, nodename)
+	}
+
+	if trx.SenderPubkey != grpItem.OwnerPubKey || item.GroupOwnerPubkey != grpItem.OwnerPubKey {
+		return errors.New("STAKE trx not sent by group owner")
+	}
+	ownerSign, err := hex.DecodeString(item.GroupOwnerSign)
+	if err != nil {
+		return err
+	}
+	if valid, err := verifyBySignPubkey(item.GroupOwnerPubkey, GetStakeHash(item), ownerSign); err != nil || !valid {
+		return errors.New("STAKE owner sign invalid")
+	}
+
+	return dbMgr.UpdateStake(trx, nodename)
+}
@@ -0,0 +1,179 @@
+package chain
+
+import (
+	"encoding/hex"
+	"testing"
+
+	p2pcrypto "github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/rumsystem/quorum/internal/pkg/nodectx"
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+func newTestStakeTrx(t *testing.T, grpItem *quorumpb.GroupItem, sender string, item *quorumpb.StakeItem) *quorumpb.Trx {
+	data, err := proto.Marshal(item)
+	if err != nil {
+		t.Fatalf("marshal stake item err: %s", err)
+	}
+	return &quorumpb.Trx{TrxId: "stk", Type: quorumpb.TrxType_STAKE, GroupId: grpItem.GroupId, SenderPubkey: sender, Data: data}
+}
+
+func newTestOwnerStakeItem(t *testing.T, grpItem *quorumpb.GroupItem, producerPubkey string, stake int64, action quorumpb.ActionType) *quorumpb.StakeItem {
+	item := &quorumpb.StakeItem{GroupId: grpItem.GroupId, ProducerPubkey: producerPubkey, Stake: stake, GroupOwnerPubkey: grpItem.OwnerPubKey, Action: action}
+	signature, err := nodectx.GetNodeCtx().Keystore.SignByKeyName(grpItem.GroupId, GetStakeHash(item))
+	if err != nil {
+		t.Fatalf("sign stake item err: %s", err)
+	}
+	item.GroupOwnerSign = hex.EncodeToString(signature)
+	return item
+}
+
+//newTestEquivocation returns 2 blocks produced by group owner on the same parent and committed by the owner,
+//the only producer of the group
+func newTestEquivocation(t *testing.T, grpItem *quorumpb.GroupItem) (*quorumpb.Block, *quorumpb.Block) {
+	if err := nodectx.GetDbMgr().AddProducer(&quorumpb.ProducerItem{GroupId: grpItem.GroupId, ProducerPubkey: grpItem.OwnerPubKey}, ""); err != nil {
+		t.Fatalf("add producer err: %s", err)
+	}
+	pubkey, err := p2pcrypto.ConfigDecodeKey(grpItem.OwnerPubKey)
+	if err != nil {
+		t.Fatalf("decode owner pubkey err: %s", err)
+	}
+	parent := &quorumpb.Block{BlockId: "parent", GroupId: grpItem.GroupId}
+	var blocks []*quorumpb.Block
+	for i := 0; i < 2; i++ {
+		block, err := CreateBlock(parent, nil, pubkey)
+		if err != nil {
+			t.Fatalf("create block err: %s", err)
+		}
+		vote, err := CreateBlockVote(quorumpb.VoteType_COMMIT, block.GroupId, block.BlockId, block.PrevBlockId, grpItem.OwnerPubKey)
+		if err != nil {
+			t.Fatalf("create commit vote err: %s", err)
+		}
+		block.Commits = []*quorumpb.BlockVote{vote}
+		blocks = append(blocks, block)
+	}
+	return blocks[0], blocks[1]
+}
+
+func getTestStake(t *testing.T, grpItem *quorumpb.GroupItem, producerPubkey string) *quorumpb.StakeItem {
+	items, err := nodectx.GetDbMgr().GetStakes(grpItem.GroupId, "")
+	if err != nil {
+		t.Fatalf("get stakes err: %s", err)
+	}
+	for _, item := range items {
+		if item.ProducerPubkey == producerPubkey {
+			return item
+		}
+	}
+	return nil
+}
+
+func TestApplyStakeTrxOwnerSign(t *testing.T) {
+	grpItem := newTestGroup(t)
+	dbMgr := nodectx.GetDbMgr()
+	producer, _ := newTestKeys(t, "producer")
+
+	item := newTestOwnerStakeItem(t, grpItem, producer, 10, quorumpb.ActionType_ADD)
+	if err := applyStakeTrx(dbMgr, newTestStakeTrx(t, grpItem, producer, item), grpItem, ""); err == nil {
+		t.Errorf("STAKE trx not sent by owner is applied")
+	}
+
+	forged := proto.Clone(item).(*quorumpb.StakeItem)
+	forged.Stake = 100
+	if err := applyStakeTrx(dbMgr, newTestStakeTrx(t, grpItem, grpItem.OwnerPubKey, forged), grpItem, ""); err == nil {
+		t.Errorf("STAKE trx with invalid owner sign is applied")
+	}
+	if stake := getTestStake(t, grpItem, producer); stake != nil {
+		t.Fatalf("stake is saved by invalid trxs: %v", stake)
+	}
+
+	if err := applyStakeTrx(dbMgr, newTestStakeTrx(t, grpItem, grpItem.OwnerPubKey, item), grpItem, ""); err != nil {
+		t.Fatalf("apply STAKE trx err: %s", err)
+	}
+	if stake := getTestStake(t, grpItem, producer); stake == nil || stake.Stake != 10 {
+		t.Errorf("stake is not saved, got %v", stake)
+	}
+}
+
+func TestApplySlashTrx(t *testing.T) {
+	grpItem := newTestGroup(t)
+	dbMgr := nodectx.GetDbMgr()
+	producer := grpItem.OwnerPubKey
+	reporter, _ := newTestKeys(t, "reporter")
+
+	add := newTestOwnerStakeItem(t, grpItem, producer, 10, quorumpb.ActionType_ADD)
+	if err := applyStakeTrx(dbMgr, newTestStakeTrx(t, grpItem, grpItem.OwnerPubKey, add), grpItem, ""); err != nil {
+		t.Fatalf("apply STAKE trx err: %s", err)
+	}
+
+	first, second := newTestEquivocation(t, grpItem)
+	invalid := []*quorumpb.StakeItem{
+		newSlashItem(grpItem.GroupId, first),
+		newSlashItem(grpItem.GroupId, first, first),
+	}
+	tampered := proto.Clone(second).(*quorumpb.Block)
+	tampered.PrevBlockId = "other"
+	invalid = append(invalid, newSlashItem(grpItem.GroupId, first, tampered))
+	//block produced again on the same parent after a round dropped by vote timeout is not committed
+	uncommitted := proto.Clone(second).(*quorumpb.Block)
+	uncommitted.Commits = nil
+	invalid = append(invalid, newSlashItem(grpItem.GroupId, first, uncommitted))
+	for i, item := range invalid {
+		if err := applyStakeTrx(dbMgr, newTestStakeTrx(t, grpItem, reporter, item), grpItem, ""); err == nil {
+			t.Errorf("slash item %d with invalid evidence is applied", i)
+		}
+	}
+	if stake := getTestStake(t, grpItem, producer); stake == nil || stake.Slashed {
+		t.Fatalf("producer is slashed by invalid evidence")
+	}
+
+	//any node can report the equivocation with the evidence
+	if err := applyStakeTrx(dbMgr, newTestStakeTrx(t, grpItem, reporter, newSlashItem(grpItem.GroupId, first, second)), grpItem, ""); err != nil {
+		t.Fatalf("apply slash trx err: %s", err)
+	}
+	registry, err := loadStakeRegistry(grpItem.GroupId, "")
+	if err != nil {
+		t.Fatalf("load stake registry err: %s", err)
+	}
+	if !registry.isSlashed(producer) || registry.getStake(producer) != 0 {
+		t.Errorf("producer is not slashed")
+	}
+
+	//owner can not add the stake back or remove the slash record
+	for _, action := range []quorumpb.ActionType{quorumpb.ActionType_ADD, quorumpb.ActionType_REMOVE} {
+		item := newTestOwnerStakeItem(t, grpItem, producer, 20, action)
+		if err := applyStakeTrx(dbMgr, newTestStakeTrx(t, grpItem, grpItem.OwnerPubKey, item), grpItem, ""); err == nil {
+			t.Errorf("owner STAKE %s is applied to a slashed producer", action)
+		}
+	}
+	if stake := getTestStake(t, grpItem, producer); stake == nil || !stake.Slashed || len(stake.Evidence) != 2 {
+		t.Errorf("slash record is changed, got %v", stake)
+	}
+}
+
+func TestCheckPosBlockEquivocation(t *testing.T) {
+	grpItem := newTestGroup(t)
+	dbMgr := nodectx.GetDbMgr()
+	first, second := newTestEquivocation(t, grpItem)
+	if err := dbMgr.AddGensisBlock(&quorumpb.Block{BlockId: first.PrevBlockId, GroupId: grpItem.GroupId}, ""); err != nil {
+		t.Fatalf("add parent block err: %s", err)
+	}
+	if err := dbMgr.AddBlock(first, false, ""); err != nil {
+		t.Fatalf("add block err: %s", err)
+	}
+
+	//a new block on the same parent after a dropped round is not an equivocation
+	uncommitted := proto.Clone(second).(*quorumpb.Block)
+	uncommitted.Commits = nil
+	if sibling, err := checkPosBlock(grpItem.GroupId, uncommitted, ""); err != nil || sibling != nil {
+		t.Errorf("block produced again after a dropped round is rejected, %v", err)
+	}
+
+	sibling, err := checkPosBlock(grpItem.GroupId, second, "")
+	if err == nil || err.Error() != "PRODUCER_EQUIVOCATED" {
+		t.Fatalf("got err %v for 2 committed blocks on the same parent, want PRODUCER_EQUIVOCATED", err)
+	}
+	if sibling == nil || sibling.BlockId != first.BlockId {
+		t.Errorf("got evidence %v, want block %s", sibling, first.BlockId)
+	}
+}
@@ -0,0 +1,129 @@
+package chain
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"sort"
+	"sync"
+	"time"
+
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+)
+
+const EQUIVOCATION_REPORT_INTERVAL time.Duration = 60 //60s
+
+//PosProducer produces and merges blocks the same way as MolassesProducer,
+//but the winner of each merge round is picked by stake
+type PosProducer struct {
+	MolassesProducer
+	reported   map[string]time.Time //producer pubkey -> time the equivocation reported
+	reportLock sync.Mutex
+}
+
+func (producer *PosProducer) Init(item *quorumpb.GroupItem, nodename string, iface ChainMolassesIface) {
+	producer.MolassesProducer.Init(item, nodename, iface)
+	producer.reported = make(map[string]time.Time)
+	producer.selectCandidate = producer.selectCandidateByStake
+	pos_log.Infof("<%s> pos producer created", producer.groupId)
+}
+
+func (producer *PosProducer) AddBlock(block *quorumpb.Block) error {
+	if sibling, err := checkPosBlock(producer.groupId, block, producer.nodename); err != nil {
+		if sibling != nil {
+			producer.reportEquivocation(sibling, block)
+		}
+		return err
+	}
+	return producer.MolassesProducer.AddBlock(block)
+}
+
+//selectCandidateByStake picks the winner block by stake weighted deterministic selection,
+//seed is the current highest block, so all producers on the same height get the same result
+func (producer *PosProducer) selectCandidateByStake(blockPool map[string]*quorumpb.Block) string {
+	registry, err := loadStakeRegistry(producer.groupId, producer.nodename)
+	if err != nil {
+		pos_log.Errorf("<%s> load stake registry failed <%s>", producer.groupId, err.Error())
+		return ""
+	}
+
+	//producer produced more than one block on the same parent in a round is equivocating, its blocks are not
+	//candidates. Blocks in pool are not committed, the equivocation is reported when both blocks are committed
+	equivocated := make(map[string]bool)
+	produced := make(map[string]*quorumpb.Block)
+	for _, blk := range blockPool {
+		key := blk.ProducerPubKey + "_" + blk.PrevBlockId
+		if prev, ok := produced[key]; ok && prev.BlockId != blk.BlockId {
+			equivocated[blk.ProducerPubKey] = true
+		}
+		produced[key] = blk
+	}
+
+	candidates := make(map[string]*quorumpb.Block)
+	var totalStake int64
+	for _, blk := range blockPool {
+		if equivocated[blk.ProducerPubKey] || registry.isSlashed(blk.ProducerPubKey) {
+			continue
+		}
+
+		if registry.isActive() {
+			stake := registry.getStake(blk.ProducerPubKey)
+			if stake == 0 {
+				continue
+			}
+			totalStake += stake
+		}
+		candidates[blk.BlockId] = blk
+	}
+
+	if !registry.isActive() {
+		pos_log.Debugf("<%s> no stake registered, select candidate by hash", producer.groupId)
+		return selectCandidateByHash(candidates)
+	}
+
+	if totalStake == 0 {
+		return ""
+	}
+
+	var blocks []*quorumpb.Block
+	for _, blk := range candidates {
+		blocks = append(blocks, blk)
+	}
+	sort.Slice(blocks, func(i, j int) bool {
+		if blocks[i].ProducerPubKey == blocks[j].ProducerPubKey {
+			return blocks[i].BlockId < blocks[j].BlockId
+		}
+		return blocks[i].ProducerPubKey < blocks[j].ProducerPubKey
+	})
+
+	seed := sha256.Sum256([]byte(producer.grpItem.HighestBlockId))
+	target := int64(binary.BigEndian.Uint64(seed[:8]) % uint64(totalStake))
+
+	var accumulated int64
+	for _, blk := range blocks {
+		accumulated += registry.getStake(blk.ProducerPubKey)
+		if target < accumulated {
+			pos_log.Debugf("<%s> producer <%s> selected with stake <%d>/<%d>", producer.groupId, blk.ProducerPubKey, registry.getStake(blk.ProducerPubKey), totalStake)
+			return blk.BlockId
+		}
+	}
+
+	return ""
+}
+
+//reportEquivocation sends a STAKE trx with the conflicting blocks to slash the producer, the producer is slashed
+//by all nodes when the trx is packaged in a block. Report is resent if the producer is still not slashed after a while
+func (producer *PosProducer) reportEquivocation(blocks ...*quorumpb.Block) {
+	pubkey := blocks[0].ProducerPubKey
+	producer.reportLock.Lock()
+	if reported, ok := producer.reported[pubkey]; ok && time.Since(reported) < EQUIVOCATION_REPORT_INTERVAL*time.Second {
+		producer.reportLock.Unlock()
+		return
+	}
+	producer.reported[pubkey] = time.Now()
+	producer.reportLock.Unlock()
+
+	pos_log.Warningf("<%s> producer <%s> equivocated, report it", producer.groupId, pubkey)
+	if _, err := producer.cIface.GetProducerTrxMgr().SendUpdStakeTrx(newSlashItem(producer.groupId, blocks...)); err != nil {
+		pos_log.Errorf("<%s> report equivocation of producer <%s> failed <%s>", producer.groupId, pubkey, err.Error())
+	}
+}
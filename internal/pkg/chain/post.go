@@ -0,0 +1,186 @@
+package chain
+
+import (
+	"errors"
+
+	logging "github.com/ipfs/go-log/v2"
+	"github.com/rumsystem/quorum/internal/pkg/nodectx"
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+	"github.com/rumsystem/quorum/internal/pkg/storage"
+)
+
+var post_log = logging.Logger("post")
+
+//activity types to edit or delete a post, or react to a post, the Object.Id of activity is the trx id of the post
+const POST_UPDATE string = "Update"
+const POST_DELETE string = "Delete"
+const POST_LIKE string = "Like"
+const POST_DISLIKE string = "Dislike"
+const POST_EMOJI_REACT string = "EmojiReact" //the Content of activity is the emoji
+const POST_UNDO string = "Undo"              //undo the reaction of sender
+
+const MAX_REACTION_LENGTH int = 32 //max bytes of an emoji reaction
+
+//GetPostActivity returns the Update, Delete or reaction activity carried by the decrypted POST data, nil for a
+//new post
+func GetPostActivity(trxId string, data []byte) *quorumpb.Activity {
+	ctnobj, _, err := quorumpb.BytesToMessage(trxId, data)
+	if err != nil {
+		return nil
+	}
+	activity, ok := ctnobj.(*quorumpb.Activity)
+	if !ok || (activity.Type != POST_UPDATE && activity.Type != POST_DELETE && !IsReaction(activity)) {
+		return nil
+	}
+	return activity
+}
+
+//IsReaction returns if the activity is a reaction to a post, or undo of the reaction
+func IsReaction(activity *quorumpb.Activity) bool {
+	switch activity.Type {
+	case POST_LIKE, POST_DISLIKE, POST_EMOJI_REACT, POST_UNDO:
+		return true
+	}
+	return false
+}
+
+//GetReaction returns the reaction of the activity, the emoji for EmojiReact, and empty for Undo
+func GetReaction(activity *quorumpb.Activity) string {
+	switch activity.Type {
+	case POST_EMOJI_REACT:
+		return activity.Content
+	case POST_UNDO:
+		return ""
+	}
+	return activity.Type
+}
+
+//checkPostActivity returns the post to be updated, deleted or reacted by the activity. Only the publisher can
+//update the post, the post can be deleted by the publisher or the group owner
+func checkPostActivity(dbMgr *storage.DbMgr, activity *quorumpb.Activity, trx *quorumpb.Trx, grpItem *quorumpb.GroupItem, nodename string) (*quorumpb.PostItem, error) {
+	if activity.Object == nil || activity.Object.Id == "" {
+		return nil, errors.New("POST_ID_REQUIRED")
+	}
+	post, err := dbMgr.GetPost(grpItem.GroupId, activity.Object.Id, nodename)
+	if err != nil {
+		return nil, err
+	}
+	if post.Deleted {
+		return nil, errors.New("POST_DELETED")
+	}
+	if IsReaction(activity) {
+		if activity.Type == POST_EMOJI_REACT && (activity.Content == "" || len(activity.Content) > MAX_REACTION_LENGTH) {
+			return nil, errors.New("INVALID_REACTION")
+		}
+		return post, nil
+	}
+	//post can be updated by its publisher only, and deleted by moderators of the group as well
+	if trx.SenderPubkey != post.PublisherPubkey && !(activity.Type == POST_DELETE && getUserRole(dbMgr, grpItem, trx.SenderPubkey, nodename) >= quorumpb.GroupRole_MODERATOR) {
+		return nil, errors.New("POST_PERMISSION_DENIED")
+	}
+	//activities are applied in the order of blocks, the older one packaged later is ignored
+	if trx.TimeStamp <= post.UpdatedTimeStamp {
+		return nil, errors.New("POST_UPDATE_OUTDATED")
+	}
+	return post, nil
+}
+
+//checkPostTrx checks the decrypted data of a POST trx, the content of a new post and the object of an Update
+//activity should match the group schema. It returns the activity and the post to be updated or deleted
+func checkPostTrx(dbMgr *storage.DbMgr, trx *quorumpb.Trx, data []byte, grpItem *quorumpb.GroupItem, nodename string) (*quorumpb.Activity, *quorumpb.PostItem, error) {
+	activity := GetPostActivity(trx.TrxId, data)
+	if activity == nil {
+		return nil, nil, checkPostSchema(dbMgr, grpItem.GroupId, trx.TrxId, data, nodename)
+	}
+
+	post, err := checkPostActivity(dbMgr, activity, trx, grpItem, nodename)
+	if err != nil {
+		return nil, nil, err
+	}
+	if activity.Type == POST_UPDATE {
+		content, err := quorumpb.ContentToBytes(activity.Object)
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := checkPostSchema(dbMgr, grpItem.GroupId, trx.TrxId, content, nodename); err != nil {
+			return nil, nil, err
+		}
+	}
+	return activity, post, nil
+}
+
+//applyPostTrx saves a new post, and the profile if the post is a Person, or updates or tombstones the post pointed
+//by the Update or Delete activity (the profile of a deleted Person is removed), the content of an edited post is
+//replaced by the object of the activity.
+//Reactions are aggregated by appdata, the post is not changed. It returns the activity and the post updated or
+//deleted, nil for a new post
+func applyPostTrx(dbMgr *storage.DbMgr, trx *quorumpb.Trx, grpItem *quorumpb.GroupItem, nodename string) (*quorumpb.Activity, *quorumpb.PostItem, error) {
+	activity, post, err := checkPostTrx(dbMgr, trx, trx.Data, grpItem, nodename)
+	if err != nil {
+		return nil, nil, err
+	}
+	if activity == nil {
+		if err := dbMgr.AddPost(trx, nodename); err != nil {
+			return nil, nil, &applyWriteError{err}
+		}
+		if person := getProfilePerson(trx.TrxId, trx.Data); person != nil {
+			if err := applyProfile(dbMgr, trx, person, nodename); err != nil {
+				return nil, nil, &applyWriteError{err}
+			}
+		}
+		return nil, nil, nil
+	}
+	if IsReaction(activity) {
+		return activity, nil, nil
+	}
+
+	switch activity.Type {
+	case POST_UPDATE:
+		content, err := quorumpb.ContentToBytes(activity.Object)
+		if err != nil {
+			return nil, nil, err
+		}
+		post.Content = content
+		post.Edited = true
+	case POST_DELETE:
+		if person := getProfilePerson(post.TrxId, post.Content); person != nil {
+			if err := removeProfile(dbMgr, grpItem.GroupId, post, nodename); err != nil {
+				return nil, nil, &applyWriteError{err}
+			}
+		}
+		post.Content = nil
+		post.Deleted = true
+	}
+	post.UpdatedTrxId = trx.TrxId
+	post.UpdatedTimeStamp = trx.TimeStamp
+	post_log.Debugf("<%s> post <%s> %s by trx <%s>", grpItem.GroupId, post.TrxId, activity.Type, trx.TrxId)
+	if err := dbMgr.UpdPost(grpItem.GroupId, post, nodename); err != nil {
+		return nil, nil, &applyWriteError{err}
+	}
+	return activity, post, nil
+}
+
+//applyWriteError is an error of reading or writing db when a trx is applied, the trx may be valid but the block
+//can not be applied
+type applyWriteError struct {
+	err error
+}
+
+func (e *applyWriteError) Error() string {
+	return e.err.Error()
+}
+
+func isApplyWriteError(err error) bool {
+	var writeErr *applyWriteError
+	return errors.As(err, &writeErr)
+}
+
+//GetPostContent returns the latest content of the post and if it is edited or deleted, the content of an
+//edited post is the object of the last Update activity, and a deleted post has no content
+func GetPostContent(grpItem *quorumpb.GroupItem, trx *quorumpb.Trx, nodename string) ([]byte, bool, bool, error) {
+	if post, err := nodectx.GetDbMgr().GetPost(grpItem.GroupId, trx.TrxId, nodename); err == nil && (post.Edited || post.Deleted) {
+		return post.Content, post.Edited, post.Deleted, nil
+	}
+	data, err := DecryptTrxData(grpItem, trx, nodename)
+	return data, false, false, err
+}
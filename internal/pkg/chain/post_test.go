@@ -0,0 +1,162 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/rumsystem/quorum/internal/pkg/nodectx"
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+func newTestPostTrx(t *testing.T, groupId string, trxId string, sender string, timestamp int64, content proto.Message) *quorumpb.Trx {
+	data, err := quorumpb.ContentToBytes(content)
+	if err != nil {
+		t.Fatalf("marshal content err: %s", err)
+	}
+	return &quorumpb.Trx{TrxId: trxId, Type: quorumpb.TrxType_POST, GroupId: groupId, SenderPubkey: sender, TimeStamp: timestamp, Data: data}
+}
+
+func newTestActivity(activityType string, postId string) *quorumpb.Activity {
+	return &quorumpb.Activity{Type: activityType, Object: &quorumpb.Object{Id: postId, Type: "Note", Content: activityType}}
+}
+
+//applyTestPost applies the POST trx and saves it as a trx packaged in block
+func applyTestPost(t *testing.T, grpItem *quorumpb.GroupItem, trx *quorumpb.Trx) error {
+	dbMgr := nodectx.GetDbMgr()
+	_, _, err := applyPostTrx(dbMgr, trx, grpItem, "")
+	if isApplyWriteError(err) {
+		t.Fatalf("apply POST trx err: %s", err)
+	}
+	if err := dbMgr.AddTrx(trx, ""); err != nil {
+		t.Fatalf("add trx err: %s", err)
+	}
+	return err
+}
+
+func TestApplyPostActivityPermission(t *testing.T) {
+	grpItem := newTestGroup(t)
+	owner := grpItem.OwnerPubKey
+	groupId := grpItem.GroupId
+	note := &quorumpb.Object{Type: "Note", Content: "hello"}
+
+	for _, trx := range []*quorumpb.Trx{
+		newTestPostTrx(t, groupId, "ownerpost", owner, 1, note),
+		newTestPostTrx(t, groupId, "alicepost", "alice", 2, note),
+		newTestPostTrx(t, "othergroup", "otherpost", "alice", 3, note),
+	} {
+		if err := applyTestPost(t, grpItem, trx); err != nil {
+			t.Fatalf("apply post %s err: %s", trx.TrxId, err)
+		}
+	}
+
+	cases := []struct {
+		name string
+		trx  *quorumpb.Trx
+		err  string
+	}{
+		{"update by other user", newTestPostTrx(t, groupId, "a1", "alice", 10, newTestActivity(POST_UPDATE, "ownerpost")), "POST_PERMISSION_DENIED"},
+		{"delete by other user", newTestPostTrx(t, groupId, "a2", "alice", 11, newTestActivity(POST_DELETE, "ownerpost")), "POST_PERMISSION_DENIED"},
+		{"update by publisher", newTestPostTrx(t, groupId, "a3", "alice", 12, newTestActivity(POST_UPDATE, "alicepost")), ""},
+		{"outdated update", newTestPostTrx(t, groupId, "a4", "alice", 11, newTestActivity(POST_UPDATE, "alicepost")), "POST_UPDATE_OUTDATED"},
+		{"update by owner", newTestPostTrx(t, groupId, "a5", owner, 13, newTestActivity(POST_UPDATE, "alicepost")), "POST_PERMISSION_DENIED"},
+		{"reaction", newTestPostTrx(t, groupId, "a6", owner, 14, newTestActivity(POST_LIKE, "alicepost")), ""},
+		{"delete by owner", newTestPostTrx(t, groupId, "a7", owner, 15, newTestActivity(POST_DELETE, "alicepost")), ""},
+		{"reaction to deleted post", newTestPostTrx(t, groupId, "a8", "alice", 16, newTestActivity(POST_LIKE, "alicepost")), "POST_DELETED"},
+		{"reaction to post of other group", newTestPostTrx(t, groupId, "a9", "alice", 17, newTestActivity(POST_LIKE, "otherpost")), "POST_NOT_FOUND"},
+		{"reaction to unknown post", newTestPostTrx(t, groupId, "a10", "alice", 18, newTestActivity(POST_LIKE, "unknown")), "POST_NOT_FOUND"},
+	}
+	for _, c := range cases {
+		err := applyTestPost(t, grpItem, c.trx)
+		if c.err == "" && err != nil {
+			t.Errorf("%s: rejected, %s", c.name, err)
+		} else if c.err != "" && (err == nil || err.Error() != c.err) {
+			t.Errorf("%s: got %v, want %s", c.name, err, c.err)
+		}
+	}
+
+	post, err := nodectx.GetDbMgr().GetPost(groupId, "ownerpost", "")
+	if err != nil || post.Edited || post.Deleted {
+		t.Errorf("post of owner is changed by other user, %v", post)
+	}
+	//the activities are not saved as new posts
+	for _, c := range cases {
+		if _, err := nodectx.GetDbMgr().GetPost(groupId, c.trx.TrxId, ""); err == nil {
+			t.Errorf("%s: activity is saved as a post", c.name)
+		}
+	}
+}
+
+func TestModeratorDeletePost(t *testing.T) {
+	grpItem := newTestGroup(t)
+	groupId := grpItem.GroupId
+	note := &quorumpb.Object{Type: "Note", Content: "hello"}
+	grantTestRole(t, grpItem, "mod", quorumpb.GroupRole_MODERATOR)
+	grantTestRole(t, grpItem, "admin", quorumpb.GroupRole_ADMIN)
+
+	for _, trxId := range []string{"post1", "post2"} {
+		if err := applyTestPost(t, grpItem, newTestPostTrx(t, groupId, trxId, "alice", 1, note)); err != nil {
+			t.Fatalf("apply post %s err: %s", trxId, err)
+		}
+	}
+
+	cases := []struct {
+		name string
+		trx  *quorumpb.Trx
+		err  string
+	}{
+		{"delete by writer", newTestPostTrx(t, groupId, "a1", "bob", 10, newTestActivity(POST_DELETE, "post1")), "POST_PERMISSION_DENIED"},
+		{"update by moderator", newTestPostTrx(t, groupId, "a2", "mod", 11, newTestActivity(POST_UPDATE, "post1")), "POST_PERMISSION_DENIED"},
+		{"delete by moderator", newTestPostTrx(t, groupId, "a3", "mod", 12, newTestActivity(POST_DELETE, "post1")), ""},
+		{"delete by admin", newTestPostTrx(t, groupId, "a4", "admin", 13, newTestActivity(POST_DELETE, "post2")), ""},
+	}
+	for _, c := range cases {
+		err := applyTestPost(t, grpItem, c.trx)
+		if c.err == "" && err != nil {
+			t.Errorf("%s: rejected, %s", c.name, err)
+		} else if c.err != "" && (err == nil || err.Error() != c.err) {
+			t.Errorf("%s: got %v, want %s", c.name, err, c.err)
+		}
+	}
+
+	for _, trxId := range []string{"post1", "post2"} {
+		if post, err := nodectx.GetDbMgr().GetPost(groupId, trxId, ""); err != nil || !post.Deleted {
+			t.Errorf("post %s is not deleted, %v", trxId, err)
+		}
+	}
+}
+
+func TestProducerApplyPostNotDecrypted(t *testing.T) {
+	producer, _ := newTestProducer(t)
+	dbMgr := nodectx.GetDbMgr()
+
+	//POST of private group is encrypted for announced users, the producer is not one of them
+	trx := &quorumpb.Trx{TrxId: "encrypted", Type: quorumpb.TrxType_POST, GroupId: producer.groupId, SenderPubkey: producer.grpItem.OwnerPubKey, TimeStamp: 1, Data: []byte("encrypted")}
+	if err := producer.applyTrxs(dbMgr, []*quorumpb.Trx{trx}); err != nil {
+		t.Fatalf("apply trxs err: %s", err)
+	}
+	if exist, _ := dbMgr.IsTrxExist(trx.TrxId, ""); !exist {
+		t.Errorf("trx is not saved")
+	}
+	if post, err := dbMgr.GetPost(producer.groupId, trx.TrxId, ""); err == nil {
+		t.Errorf("POST can not be decrypted is saved as a post, %v", post)
+	}
+}
+
+func TestUserApplyPostNotDecrypted(t *testing.T) {
+	grpItem := newTestGroup(t)
+	user := &MolassesUser{}
+	user.Init(grpItem, "", newTestChainIface(grpItem))
+	dbMgr := nodectx.GetDbMgr()
+
+	//POST of private group not encrypted for us (removed from group or approved later) does not stop the block
+	trx := &quorumpb.Trx{TrxId: "encrypted", Type: quorumpb.TrxType_POST, GroupId: grpItem.GroupId, SenderPubkey: grpItem.OwnerPubKey, TimeStamp: 1, Data: []byte("encrypted")}
+	if err := user.applyTrxs(dbMgr, []*quorumpb.Trx{trx}, ""); err != nil {
+		t.Fatalf("apply trxs err: %s", err)
+	}
+	if exist, _ := dbMgr.IsTrxExist(trx.TrxId, ""); !exist {
+		t.Errorf("trx is not saved")
+	}
+	if post, err := dbMgr.GetPost(grpItem.GroupId, trx.TrxId, ""); err == nil {
+		t.Errorf("POST can not be decrypted is saved as a post, %v", post)
+	}
+}
@@ -0,0 +1,24 @@
+package chain
+
+import (
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+)
+
+//PosUser applies blocks the same way as MolassesUser, blocks from slashed,
+//unstaked or equivocating producers are rejected
+type PosUser struct {
+	MolassesUser
+}
+
+func (user *PosUser) Init(item *quorumpb.GroupItem, nodename string, iface ChainMolassesIface) {
+	user.MolassesUser.Init(item, nodename, iface)
+	pos_log.Infof("<%s> pos user created", user.groupId)
+}
+
+func (user *PosUser) AddBlock(block *quorumpb.Block) error {
+	//equivocation is reported by producers, user only rejects the block
+	if _, err := checkPosBlock(user.groupId, block, user.nodename); err != nil {
+		return err
+	}
+	return user.MolassesUser.AddBlock(block)
+}
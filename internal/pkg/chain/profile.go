@@ -0,0 +1,42 @@
+package chain
+
+import (
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+	"github.com/rumsystem/quorum/internal/pkg/storage"
+)
+
+//getProfilePerson returns the Person of the decrypted POST data, nil if it is not a profile
+func getProfilePerson(trxId string, data []byte) *quorumpb.Person {
+	ctnobj, _, err := quorumpb.BytesToMessage(trxId, data)
+	if err != nil {
+		return nil
+	}
+	person, ok := ctnobj.(*quorumpb.Person)
+	if !ok {
+		return nil
+	}
+	return person
+}
+
+//applyProfile adds the profile posted by the trx to the history of the sender, and rebuilds the current profile of
+//the sender from the history. Profiles are merged by the order of trx timestamp, so an earlier profile packaged
+//later (or synced later by history sync) does not override the later one
+func applyProfile(dbMgr *storage.DbMgr, trx *quorumpb.Trx, person *quorumpb.Person, nodename string) error {
+	item := &quorumpb.ProfileItem{GroupId: trx.GroupId, Pubkey: trx.SenderPubkey, Person: person, TrxId: trx.TrxId, TimeStamp: trx.TimeStamp}
+	if err := dbMgr.AddProfileHistory(item, nodename); err != nil {
+		return err
+	}
+	post_log.Debugf("<%s> profile of <%s> updated by trx <%s>", trx.GroupId, trx.SenderPubkey, trx.TrxId)
+	return dbMgr.RebuildProfile(trx.GroupId, trx.SenderPubkey, nodename)
+}
+
+//removeProfile removes the profile of a deleted Person post from the history of the publisher, and rebuilds the
+//current profile without it
+func removeProfile(dbMgr *storage.DbMgr, groupId string, post *quorumpb.PostItem, nodename string) error {
+	item := &quorumpb.ProfileItem{GroupId: groupId, Pubkey: post.PublisherPubkey, TrxId: post.TrxId, TimeStamp: post.TimeStamp}
+	if err := dbMgr.RmProfileHistory(item, nodename); err != nil {
+		return err
+	}
+	post_log.Debugf("<%s> profile of <%s> posted by trx <%s> removed", groupId, post.PublisherPubkey, post.TrxId)
+	return dbMgr.RebuildProfile(groupId, post.PublisherPubkey, nodename)
+}
@@ -0,0 +1,118 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/rumsystem/quorum/internal/pkg/nodectx"
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+)
+
+func getTestProfile(t *testing.T, groupId string, pubkey string) *quorumpb.ProfileItem {
+	item, err := nodectx.GetDbMgr().GetProfile(groupId, pubkey, "")
+	if err != nil {
+		t.Fatalf("get profile err: %s", err)
+	}
+	return item
+}
+
+func TestApplyProfileOrder(t *testing.T) {
+	grpItem := newTestGroup(t)
+	groupId := grpItem.GroupId
+	wallet := []*quorumpb.Payment{{Id: "wallet", Type: "mixin", Name: "alice"}}
+
+	steps := []struct {
+		name     string
+		trx      *quorumpb.Trx
+		wantName string
+		wantTrx  string
+	}{
+		{"first", newTestPostTrx(t, groupId, "p1", "alice", 10, &quorumpb.Person{Name: "alice"}), "alice", "p1"},
+		//empty fields of a later profile keep the current ones
+		{"wallet only", newTestPostTrx(t, groupId, "p2", "alice", 20, &quorumpb.Person{Wallet: wallet}), "alice", "p2"},
+		//an earlier profile packaged later goes to the history only
+		{"earlier", newTestPostTrx(t, groupId, "p0", "alice", 5, &quorumpb.Person{Name: "old"}), "alice", "p2"},
+		//profiles of the same timestamp are ordered by trx id
+		{"same time", newTestPostTrx(t, groupId, "p3b", "alice", 30, &quorumpb.Person{Name: "b"}), "b", "p3b"},
+		{"same time smaller trx id", newTestPostTrx(t, groupId, "p3a", "alice", 30, &quorumpb.Person{Name: "a"}), "b", "p3b"},
+	}
+	for _, step := range steps {
+		if err := applyTestPost(t, grpItem, step.trx); err != nil {
+			t.Fatalf("%s: apply profile err: %s", step.name, err)
+		}
+		item := getTestProfile(t, groupId, "alice")
+		if item == nil || item.Person.Name != step.wantName || item.TrxId != step.wantTrx {
+			t.Fatalf("%s: got profile %v, want name %s of trx %s", step.name, item, step.wantName, step.wantTrx)
+		}
+		if step.trx.TrxId != "p1" && len(item.Person.Wallet) != 1 {
+			t.Errorf("%s: wallet is not kept, got %v", step.name, item.Person)
+		}
+	}
+
+	history, err := nodectx.GetDbMgr().GetProfileHistory(groupId, "alice", "")
+	if err != nil {
+		t.Fatalf("get profile history err: %s", err)
+	}
+	if len(history) != len(steps) || history[0].TrxId != "p0" || history[len(history)-1].TrxId != "p3b" {
+		t.Errorf("got %d profiles in history, want %d ordered by timestamp", len(history), len(steps))
+	}
+}
+
+func TestDeleteProfilePost(t *testing.T) {
+	grpItem := newTestGroup(t)
+	groupId := grpItem.GroupId
+	for _, trx := range []*quorumpb.Trx{
+		newTestPostTrx(t, groupId, "p1", "alice", 10, &quorumpb.Person{Name: "first"}),
+		newTestPostTrx(t, groupId, "p2", "alice", 20, &quorumpb.Person{Name: "second"}),
+	} {
+		if err := applyTestPost(t, grpItem, trx); err != nil {
+			t.Fatalf("apply profile err: %s", err)
+		}
+	}
+
+	if err := applyTestPost(t, grpItem, newTestPostTrx(t, groupId, "d2", "alice", 30, newTestActivity(POST_DELETE, "p2"))); err != nil {
+		t.Fatalf("delete profile post err: %s", err)
+	}
+	if item := getTestProfile(t, groupId, "alice"); item == nil || item.Person.Name != "first" {
+		t.Errorf("got profile %v after the latest profile deleted, want the first one", item)
+	}
+
+	if err := applyTestPost(t, grpItem, newTestPostTrx(t, groupId, "d1", "alice", 40, newTestActivity(POST_DELETE, "p1"))); err != nil {
+		t.Fatalf("delete profile post err: %s", err)
+	}
+	if item := getTestProfile(t, groupId, "alice"); item != nil {
+		t.Errorf("got profile %v after all profiles deleted", item)
+	}
+}
+
+func TestProducerApplyProfile(t *testing.T) {
+	producer, cIface := newTestProducer(t)
+	grpItem := producer.grpItem
+	owner := grpItem.OwnerPubKey
+	dbMgr := nodectx.GetDbMgr()
+
+	//POST of private group is encrypted for announced users, the producer not announced can not decrypt it
+	encrypted := &quorumpb.Trx{TrxId: "encrypted", Type: quorumpb.TrxType_POST, GroupId: grpItem.GroupId, SenderPubkey: owner, TimeStamp: 10, Data: []byte("encrypted")}
+	if err := producer.applyTrxs(dbMgr, []*quorumpb.Trx{encrypted}); err != nil {
+		t.Fatalf("apply trxs err: %s", err)
+	}
+	if item := getTestProfile(t, grpItem.GroupId, owner); item != nil {
+		t.Errorf("got profile %v from the POST can not be decrypted", item)
+	}
+
+	//POST of public group is encrypted by the group cipher key
+	grpItem.EncryptType = quorumpb.GroupEncryptType_PUBLIC
+	content, err := quorumpb.ContentToBytes(&quorumpb.Person{Name: "owner"})
+	if err != nil {
+		t.Fatalf("marshal content err: %s", err)
+	}
+	trx, err := cIface.producerTrxMgr.CreateTrx(quorumpb.TrxType_POST, content)
+	if err != nil {
+		t.Fatalf("create trx err: %s", err)
+	}
+	if err := producer.applyTrxs(dbMgr, []*quorumpb.Trx{trx}); err != nil {
+		t.Fatalf("apply trxs err: %s", err)
+	}
+	if item := getTestProfile(t, grpItem.GroupId, owner); item == nil || item.Person.Name != "owner" || item.TrxId != trx.TrxId {
+		t.Errorf("got profile %v, want the profile of trx %s", item, trx.TrxId)
+	}
+}
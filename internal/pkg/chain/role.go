@@ -0,0 +1,199 @@
+package chain
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+
+	"github.com/rumsystem/quorum/internal/pkg/nodectx"
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+	"github.com/rumsystem/quorum/internal/pkg/storage"
+	"google.golang.org/protobuf/proto"
+)
+
+//reject reason
+const TRX_PERMISSION_DENIED = "TRX_PERMISSION_DENIED"
+
+//DEFAULT_ROLE is the role of user without a grant
+const DEFAULT_ROLE quorumpb.GroupRole = quorumpb.GroupRole_WRITER
+
+//GetRoleItemHash returns the hash signed by the granter of a role item
+func GetRoleItemHash(item *quorumpb.RoleItem) []byte {
+	var buffer bytes.Buffer
+	buffer.Write([]byte(item.GroupId))
+	buffer.Write([]byte(item.UserPubkey))
+	buffer.Write([]byte(item.Role.String()))
+	buffer.Write([]byte(item.GranterPubkey))
+	buffer.Write([]byte(item.Action.String()))
+	buffer.Write([]byte(item.Memo))
+	return Hash(buffer.Bytes())
+}
+
+//GetProducerItemHash returns the hash signed by the signer of a producer item, the signer is hashed only if it is
+//not group owner, so items signed by group owner keep the same hash
+func GetProducerItemHash(item *quorumpb.ProducerItem) []byte {
+	var buffer bytes.Buffer
+	buffer.Write([]byte(item.GroupId))
+	buffer.Write([]byte(item.ProducerPubkey))
+	buffer.Write([]byte(item.GroupOwnerPubkey))
+	if item.SignerPubkey != "" && item.SignerPubkey != item.GroupOwnerPubkey {
+		buffer.Write([]byte(item.SignerPubkey))
+	}
+	return Hash(buffer.Bytes())
+}
+
+//GetSchemaItemHash returns the hash signed by the signer of a schema item, the same as GetProducerItemHash
+func GetSchemaItemHash(item *quorumpb.SchemaItem) []byte {
+	var buffer bytes.Buffer
+	buffer.Write([]byte(item.GroupId))
+	buffer.Write([]byte(item.Type))
+	buffer.Write([]byte(item.Rule))
+	buffer.Write([]byte(item.GroupOwnerPubkey))
+	if item.SignerPubkey != "" && item.SignerPubkey != item.GroupOwnerPubkey {
+		buffer.Write([]byte(item.SignerPubkey))
+	}
+	return Hash(buffer.Bytes())
+}
+
+//getItemSigner returns the pubkey signed an item, empty signer is group owner
+func getItemSigner(ownerPubkey string, signerPubkey string) string {
+	if signerPubkey == "" {
+		return ownerPubkey
+	}
+	return signerPubkey
+}
+
+//GetUserRole returns the role of user in group, group owner is always OWNER
+func GetUserRole(grpItem *quorumpb.GroupItem, userPubkey string, nodename string) quorumpb.GroupRole {
+	return getUserRole(nodectx.GetDbMgr(), grpItem, userPubkey, nodename)
+}
+
+func getUserRole(dbMgr *storage.DbMgr, grpItem *quorumpb.GroupItem, userPubkey string, nodename string) quorumpb.GroupRole {
+	if userPubkey == grpItem.OwnerPubKey {
+		return quorumpb.GroupRole_OWNER
+	}
+
+	item, err := dbMgr.GetRole(grpItem.GroupId, userPubkey, nodename)
+	if err != nil || item == nil {
+		return DEFAULT_ROLE
+	}
+	return item.Role
+}
+
+//TrxRequiredRole returns the lowest role which is allowed to send the trx type
+func TrxRequiredRole(trxType quorumpb.TrxType) quorumpb.GroupRole {
+	switch trxType {
+	case quorumpb.TrxType_POST:
+		return quorumpb.GroupRole_WRITER
+	case quorumpb.TrxType_AUTH,
+		quorumpb.TrxType_ROLE:
+		return quorumpb.GroupRole_MODERATOR
+	case quorumpb.TrxType_SCHEMA,
+		quorumpb.TrxType_PRODUCER:
+		return quorumpb.GroupRole_ADMIN
+	case quorumpb.TrxType_STAKE,
+		quorumpb.TrxType_GROUP_KEY,
+		quorumpb.TrxType_ANNOUNCE_RESULT:
+		return quorumpb.GroupRole_OWNER
+	default:
+		return quorumpb.GroupRole_READER
+	}
+}
+
+//CheckTrxPermission returns error if the sender has no role to send the trx type
+func CheckTrxPermission(grpItem *quorumpb.GroupItem, senderPubkey string, trxType quorumpb.TrxType, nodename string) error {
+	return checkTrxPermission(nodectx.GetDbMgr(), grpItem, senderPubkey, trxType, nodename)
+}
+
+func checkTrxPermission(dbMgr *storage.DbMgr, grpItem *quorumpb.GroupItem, senderPubkey string, trxType quorumpb.TrxType, nodename string) error {
+	if getUserRole(dbMgr, grpItem, senderPubkey, nodename) < TrxRequiredRole(trxType) {
+		return errors.New(TRX_PERMISSION_DENIED)
+	}
+	return nil
+}
+
+//checkAppliedTrxPermission checks a (decrypted) trx packaged in block, besides the role required by trx type,
+//a user can only deny or grant users with a lower role
+func checkAppliedTrxPermission(dbMgr *storage.DbMgr, trx *quorumpb.Trx, grpItem *quorumpb.GroupItem, nodename string) error {
+	if err := checkTrxPermission(dbMgr, grpItem, trx.SenderPubkey, trx.Type, nodename); err != nil {
+		return err
+	}
+
+	switch trx.Type {
+	case quorumpb.TrxType_AUTH:
+		item := &quorumpb.DenyUserItem{}
+		if err := proto.Unmarshal(trx.Data, item); err != nil {
+			return err
+		}
+		if getUserRole(dbMgr, grpItem, item.PeerId, nodename) >= getUserRole(dbMgr, grpItem, trx.SenderPubkey, nodename) {
+			return errors.New(TRX_PERMISSION_DENIED)
+		}
+	case quorumpb.TrxType_PRODUCER:
+		item := &quorumpb.ProducerItem{}
+		if err := proto.Unmarshal(trx.Data, item); err != nil {
+			return err
+		}
+		return checkItemSigner(trx, grpItem, item.GroupOwnerPubkey, item.SignerPubkey, item.GroupOwnerSign, GetProducerItemHash(item))
+	case quorumpb.TrxType_SCHEMA:
+		item := &quorumpb.SchemaItem{}
+		if err := proto.Unmarshal(trx.Data, item); err != nil {
+			return err
+		}
+		return checkItemSigner(trx, grpItem, item.GroupOwnerPubkey, item.SignerPubkey, item.GroupOwnerSign, GetSchemaItemHash(item))
+	}
+	return nil
+}
+
+//checkItemSigner checks an item signed by group owner or admin, the item keeps group owner as its owner, and the
+//signer should be the trx sender, whose role is already checked by the trx type
+func checkItemSigner(trx *quorumpb.Trx, grpItem *quorumpb.GroupItem, ownerPubkey string, signerPubkey string, sign string, hash []byte) error {
+	if ownerPubkey != grpItem.OwnerPubKey {
+		return errors.New("item owner mismatch")
+	}
+	signer := getItemSigner(ownerPubkey, signerPubkey)
+	if signer != trx.SenderPubkey {
+		return errors.New("item signer mismatch")
+	}
+
+	signature, err := hex.DecodeString(sign)
+	if err != nil {
+		return err
+	}
+	if valid, err := verifyBySignPubkey(signer, hash, signature); err != nil || !valid {
+		return errors.New("item sign invalid")
+	}
+	return nil
+}
+
+//applyRoleTrx saves the role item signed by the granter, the granter should have a higher role than
+//both the current role and the new role of user
+func applyRoleTrx(dbMgr *storage.DbMgr, trx *quorumpb.Trx, grpItem *quorumpb.GroupItem, nodename string) error {
+	item := &quorumpb.RoleItem{}
+	if err := proto.Unmarshal(trx.Data, item); err != nil {
+		return err
+	}
+	if item.GroupId != grpItem.GroupId || item.GranterPubkey != trx.SenderPubkey {
+		return errors.New("ROLE item mismatch")
+	}
+	if item.Role == quorumpb.GroupRole_OWNER || item.UserPubkey == grpItem.OwnerPubKey {
+		return errors.New("ROLE of group owner can not be changed")
+	}
+
+	granterSign, err := hex.DecodeString(item.GranterSign)
+	if err != nil {
+		return err
+	}
+	if valid, err := verifyBySignPubkey(item.GranterPubkey, GetRoleItemHash(item), granterSign); err != nil || !valid {
+		return errors.New("ROLE granter sign invalid")
+	}
+
+	granterRole := getUserRole(dbMgr, grpItem, item.GranterPubkey, nodename)
+	if granterRole <= getUserRole(dbMgr, grpItem, item.UserPubkey, nodename) {
+		return errors.New(TRX_PERMISSION_DENIED)
+	}
+	if item.Action == quorumpb.ActionType_ADD && granterRole <= item.Role {
+		return errors.New(TRX_PERMISSION_DENIED)
+	}
+
+	return dbMgr.UpdateRole(trx, nodename)
+}
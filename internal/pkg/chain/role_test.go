@@ -0,0 +1,90 @@
+package chain
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/rumsystem/quorum/internal/pkg/nodectx"
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+func newTestItemTrx(t *testing.T, grpItem *quorumpb.GroupItem, trxType quorumpb.TrxType, sender string, item proto.Message) *quorumpb.Trx {
+	data, err := proto.Marshal(item)
+	if err != nil {
+		t.Fatalf("marshal item err: %s", err)
+	}
+	return &quorumpb.Trx{TrxId: "item", Type: trxType, GroupId: grpItem.GroupId, SenderPubkey: sender, Data: data}
+}
+
+func grantTestRole(t *testing.T, grpItem *quorumpb.GroupItem, userPubkey string, role quorumpb.GroupRole) {
+	item := &quorumpb.RoleItem{GroupId: grpItem.GroupId, UserPubkey: userPubkey, Role: role, GranterPubkey: grpItem.OwnerPubKey, Action: quorumpb.ActionType_ADD}
+	if err := nodectx.GetDbMgr().UpdateRole(newTestItemTrx(t, grpItem, quorumpb.TrxType_ROLE, grpItem.OwnerPubKey, item), ""); err != nil {
+		t.Fatalf("update role err: %s", err)
+	}
+}
+
+//newTestProducerItem returns a producer item signed by keyname
+func newTestProducerItem(t *testing.T, grpItem *quorumpb.GroupItem, keyname string, ownerPubkey string, signerPubkey string) *quorumpb.ProducerItem {
+	item := &quorumpb.ProducerItem{GroupId: grpItem.GroupId, ProducerPubkey: "producer", GroupOwnerPubkey: ownerPubkey, SignerPubkey: signerPubkey}
+	signature, err := nodectx.GetNodeCtx().Keystore.SignByKeyName(keyname, GetProducerItemHash(item))
+	if err != nil {
+		t.Fatalf("sign producer item err: %s", err)
+	}
+	item.GroupOwnerSign = hex.EncodeToString(signature)
+	return item
+}
+
+func TestCheckProducerItemSigner(t *testing.T) {
+	grpItem := newTestGroup(t)
+	dbMgr := nodectx.GetDbMgr()
+	owner := grpItem.OwnerPubKey
+	admin, _ := newTestKeys(t, "admin")
+	writer, _ := newTestKeys(t, "writer")
+	grantTestRole(t, grpItem, admin, quorumpb.GroupRole_ADMIN)
+
+	cases := []struct {
+		name    string
+		sender  string
+		item    *quorumpb.ProducerItem
+		allowed bool
+	}{
+		{"signed by owner", owner, newTestProducerItem(t, grpItem, grpItem.GroupId, owner, ""), true},
+		{"signed by admin", admin, newTestProducerItem(t, grpItem, "admin", owner, admin), true},
+		{"admin as owner", admin, newTestProducerItem(t, grpItem, "admin", admin, ""), false},
+		{"admin signer sent by owner", owner, newTestProducerItem(t, grpItem, "admin", owner, admin), false},
+		{"admin signer signed by owner", admin, newTestProducerItem(t, grpItem, grpItem.GroupId, owner, admin), false},
+		{"signed by writer", writer, newTestProducerItem(t, grpItem, "writer", owner, writer), false},
+	}
+	for _, c := range cases {
+		err := checkAppliedTrxPermission(dbMgr, newTestItemTrx(t, grpItem, quorumpb.TrxType_PRODUCER, c.sender, c.item), grpItem, "")
+		if c.allowed && err != nil {
+			t.Errorf("%s: rejected, %s", c.name, err)
+		} else if !c.allowed && err == nil {
+			t.Errorf("%s: allowed", c.name)
+		}
+	}
+}
+
+func TestCheckSchemaItemSigner(t *testing.T) {
+	grpItem := newTestGroup(t)
+	dbMgr := nodectx.GetDbMgr()
+	admin, _ := newTestKeys(t, "admin")
+	grantTestRole(t, grpItem, admin, quorumpb.GroupRole_ADMIN)
+
+	item := &quorumpb.SchemaItem{GroupId: grpItem.GroupId, Type: "Note", Rule: "{}", GroupOwnerPubkey: grpItem.OwnerPubKey, SignerPubkey: admin}
+	signature, err := nodectx.GetNodeCtx().Keystore.SignByKeyName("admin", GetSchemaItemHash(item))
+	if err != nil {
+		t.Fatalf("sign schema item err: %s", err)
+	}
+	item.GroupOwnerSign = hex.EncodeToString(signature)
+	if err := checkAppliedTrxPermission(dbMgr, newTestItemTrx(t, grpItem, quorumpb.TrxType_SCHEMA, admin, item), grpItem, ""); err != nil {
+		t.Errorf("schema signed by admin is rejected, %s", err)
+	}
+
+	//the signature of admin can not be used as group owner
+	item.SignerPubkey = ""
+	if err := checkAppliedTrxPermission(dbMgr, newTestItemTrx(t, grpItem, quorumpb.TrxType_SCHEMA, admin, item), grpItem, ""); err == nil {
+		t.Errorf("schema signed by admin as group owner is allowed")
+	}
+}
@@ -0,0 +1,70 @@
+package chain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	logging "github.com/ipfs/go-log/v2"
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+	"github.com/rumsystem/quorum/internal/pkg/storage"
+	"github.com/xeipuuv/gojsonschema"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var schema_log = logging.Logger("schema")
+
+const TRX_SCHEMA_MISMATCH = "TRX_SCHEMA_MISMATCH"
+
+//CheckSchemaRule makes sure a schema rule is a valid JSON schema before it is published to the group
+func CheckSchemaRule(rule string) error {
+	if _, err := gojsonschema.NewSchema(gojsonschema.NewStringLoader(rule)); err != nil {
+		return fmt.Errorf("invalid schema rule: %s", err.Error())
+	}
+	return nil
+}
+
+//checkPostSchema checks the decrypted content of a POST trx against the schema rule published by group owner,
+//schema is keyed by the type url of the content (for example "quorum.pb.Object"), content without rule is accepted
+//without being parsed
+func checkPostSchema(dbMgr *storage.DbMgr, groupId string, trxId string, data []byte, nodename string) error {
+	typeurl := quorumpb.BytesToTypeUrl(data)
+	schema, err := dbMgr.GetSchemaByGroup(groupId, typeurl, nodename)
+	if err != nil {
+		return &applyWriteError{err}
+	}
+	if schema == nil {
+		//no rule for this type
+		return nil
+	}
+
+	ctnobj, _, err := quorumpb.BytesToMessage(trxId, data)
+	if err != nil {
+		schema_log.Debugf("<%s> %s", groupId, err.Error())
+		return errors.New(TRX_SCHEMA_MISMATCH)
+	}
+
+	ruleLoader := gojsonschema.NewStringLoader(schema.Rule)
+	jsonBytes, err := protojson.Marshal(ctnobj)
+	if err != nil {
+		schema_log.Debugf("<%s> trx <%s> marshal to json failed: %s", groupId, trxId, err.Error())
+		return errors.New(TRX_SCHEMA_MISMATCH)
+	}
+
+	result, err := gojsonschema.Validate(ruleLoader, gojsonschema.NewBytesLoader(jsonBytes))
+	if err != nil {
+		schema_log.Debugf("<%s> type <%s> validate failed: %s", groupId, typeurl, err.Error())
+		return errors.New(TRX_SCHEMA_MISMATCH)
+	}
+
+	if !result.Valid() {
+		var reasons []string
+		for _, desc := range result.Errors() {
+			reasons = append(reasons, desc.String())
+		}
+		schema_log.Debugf("<%s> trx <%s> type <%s> mismatch schema: %s", groupId, trxId, typeurl, strings.Join(reasons, "; "))
+		return errors.New(TRX_SCHEMA_MISMATCH)
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/rumsystem/quorum/internal/pkg/nodectx"
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+func newTestPostContent(t *testing.T, obj *quorumpb.Object, legacy bool) []byte {
+	var data []byte
+	var err error
+	if legacy {
+		data, err = proto.Marshal(obj)
+	} else {
+		data, err = quorumpb.ContentToBytes(obj)
+	}
+	if err != nil {
+		t.Fatalf("marshal content err: %s", err)
+	}
+	return data
+}
+
+func TestCheckPostSchema(t *testing.T) {
+	grpItem := newTestGroup(t)
+	dbMgr := nodectx.GetDbMgr()
+	note := &quorumpb.Object{Type: "Note", Content: "hello"}
+	empty := &quorumpb.Object{Type: "Note"}
+	invalid := []byte("\xff\xff")
+
+	//content is not parsed if group has no rule
+	if err := checkPostSchema(dbMgr, grpItem.GroupId, "trx", invalid, ""); err != nil {
+		t.Errorf("content without rule is rejected, %s", err)
+	}
+
+	item := &quorumpb.SchemaItem{GroupId: grpItem.GroupId, Type: "quorum.pb.Object", Rule: `{"type": "object", "required": ["content"]}`, Action: quorumpb.ActionType_ADD}
+	if err := dbMgr.UpdateSchema(newTestItemTrx(t, grpItem, quorumpb.TrxType_SCHEMA, grpItem.OwnerPubKey, item), ""); err != nil {
+		t.Fatalf("update schema err: %s", err)
+	}
+
+	cases := []struct {
+		name    string
+		data    []byte
+		allowed bool
+	}{
+		{"note", newTestPostContent(t, note, false), true},
+		{"legacy note", newTestPostContent(t, note, true), true},
+		{"note without content", newTestPostContent(t, empty, false), false},
+		{"legacy note without content", newTestPostContent(t, empty, true), false},
+		{"invalid content", invalid, false},
+	}
+	for _, c := range cases {
+		err := checkPostSchema(dbMgr, grpItem.GroupId, "trx", c.data, "")
+		if c.allowed && err != nil {
+			t.Errorf("%s: rejected, %s", c.name, err)
+		} else if !c.allowed && (err == nil || err.Error() != TRX_SCHEMA_MISMATCH) {
+			t.Errorf("%s: got %v, want %s", c.name, err, TRX_SCHEMA_MISMATCH)
+		}
+	}
+}
@@ -0,0 +1,239 @@
+package chain
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	guuid "github.com/google/uuid"
+	logging "github.com/ipfs/go-log/v2"
+	p2pcrypto "github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/rumsystem/quorum/internal/pkg/nodectx"
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+var snapshot_log = logging.Logger("snapshot")
+
+const SNAPSHOT_BLOCK_INTERVAL int64 = 100 //producer takes a snapshot every 100 blocks
+
+//CreateSnapshot takes a snapshot of the group state on the current highest block and signs it with the group sign key,
+//posts are not in the snapshot, they are synced by the blocks before the snapshot block
+func CreateSnapshot(grpItem *quorumpb.GroupItem, nodename string) (*quorumpb.Snapshot, error) {
+	dbMgr := nodectx.GetDbMgr()
+	groupId := grpItem.GroupId
+
+	block, err := dbMgr.GetBlock(grpItem.HighestBlockId, false, nodename)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &quorumpb.Snapshot{}
+	snapshot.GroupId = groupId
+	snapshot.SnapshotId = guuid.New().String()
+	snapshot.BlockId = grpItem.HighestBlockId
+	snapshot.Height = grpItem.HighestHeight
+	snapshot.Block = block
+
+	if snapshot.Producers, err = dbMgr.GetProducers(groupId, nodename); err != nil {
+		return nil, err
+	}
+
+	users, err := dbMgr.GetAnnouncedUsersByGroup(groupId, nodename)
+	if err != nil {
+		return nil, err
+	}
+	producers, err := dbMgr.GetAnnounceProducersByGroup(groupId, nodename)
+	if err != nil {
+		return nil, err
+	}
+	snapshot.Announces = append(users, producers...)
+
+	//block list is not indexed by group
+	blkList, err := dbMgr.GetBlkedUsers(nodename)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range blkList {
+		if item.GroupId == groupId {
+			snapshot.DenyList = append(snapshot.DenyList, item)
+		}
+	}
+
+	if snapshot.Schemas, err = dbMgr.GetAllSchemasByGroup(groupId, nodename); err != nil {
+		return nil, err
+	}
+	if snapshot.Stakes, err = dbMgr.GetStakes(groupId, nodename); err != nil {
+		return nil, err
+	}
+	if snapshot.GroupKeys, err = dbMgr.GetGroupKeys(groupId, nodename); err != nil {
+		return nil, err
+	}
+	if snapshot.Roles, err = dbMgr.GetRoles(groupId, nodename); err != nil {
+		return nil, err
+	}
+
+	//keep items in the same order on all producers, so the same state gets the same hash
+	sort.Slice(snapshot.Producers, func(i, j int) bool {
+		return snapshot.Producers[i].ProducerPubkey < snapshot.Producers[j].ProducerPubkey
+	})
+	sort.Slice(snapshot.Announces, func(i, j int) bool {
+		if snapshot.Announces[i].Type == snapshot.Announces[j].Type {
+			return snapshot.Announces[i].SignPubkey < snapshot.Announces[j].SignPubkey
+		}
+		return snapshot.Announces[i].Type < snapshot.Announces[j].Type
+	})
+	sort.Slice(snapshot.DenyList, func(i, j int) bool {
+		return snapshot.DenyList[i].PeerId < snapshot.DenyList[j].PeerId
+	})
+	sort.Slice(snapshot.Schemas, func(i, j int) bool {
+		return snapshot.Schemas[i].Type < snapshot.Schemas[j].Type
+	})
+	sort.Slice(snapshot.Stakes, func(i, j int) bool {
+		return snapshot.Stakes[i].ProducerPubkey < snapshot.Stakes[j].ProducerPubkey
+	})
+	sort.Slice(snapshot.GroupKeys, func(i, j int) bool {
+		return snapshot.GroupKeys[i].Epoch < snapshot.GroupKeys[j].Epoch
+	})
+	sort.Slice(snapshot.Roles, func(i, j int) bool {
+		return snapshot.Roles[i].UserPubkey < snapshot.Roles[j].UserPubkey
+	})
+
+	snapshot.ProducerPubkey = grpItem.UserSignPubkey
+	snapshot.TimeStamp = time.Now().UnixNano()
+
+	hash, err := getSnapshotHash(snapshot)
+	if err != nil {
+		return nil, err
+	}
+	snapshot.Hash = hash
+
+	signature, err := nodectx.GetNodeCtx().Keystore.SignByKeyName(groupId, getSnapshotSignHash(snapshot))
+	if err != nil {
+		return nil, err
+	}
+	snapshot.Signature = signature
+
+	snapshot_log.Debugf("<%s> snapshot <%s> created on block <%s>, height <%d>", groupId, snapshot.SnapshotId, snapshot.BlockId, snapshot.Height)
+	return snapshot, nil
+}
+
+//hash of the group state, fields set by the producer who takes the snapshot are not included
+func getSnapshotHash(snapshot *quorumpb.Snapshot) ([]byte, error) {
+	state := proto.Clone(snapshot).(*quorumpb.Snapshot)
+	state.SnapshotId = ""
+	state.RequesterPubkey = ""
+	state.ProducerPubkey = ""
+	state.TimeStamp = 0
+	state.Hash = nil
+	state.Signature = nil
+
+	//produced block count is local statistic
+	for _, item := range state.Producers {
+		item.BlockProduced = 0
+	}
+
+	bytes, err := proto.MarshalOptions{Deterministic: true}.Marshal(state)
+	if err != nil {
+		return nil, err
+	}
+	return Hash(bytes), nil
+}
+
+func getSnapshotSignHash(snapshot *quorumpb.Snapshot) []byte {
+	var buffer bytes.Buffer
+	buffer.Write([]byte(snapshot.GroupId))
+	buffer.Write([]byte(snapshot.SnapshotId))
+	buffer.Write(snapshot.Hash)
+	buffer.Write([]byte(snapshot.ProducerPubkey))
+	buffer.Write([]byte(fmt.Sprint(snapshot.TimeStamp)))
+	return Hash(buffer.Bytes())
+}
+
+//IsSnapshotValid checks the snapshot is signed by the group owner or a producer this node has on record, producers
+//in the snapshot are not trusted before it is verified
+func IsSnapshotValid(snapshot *quorumpb.Snapshot, ownerPubkey string, producers []*quorumpb.ProducerItem) (bool, error) {
+	if snapshot.Block == nil || snapshot.Block.BlockId != snapshot.BlockId || snapshot.Block.GroupId != snapshot.GroupId {
+		return false, errors.New("Snapshot block mismatch")
+	}
+
+	hash, err := getSnapshotHash(snapshot)
+	if err != nil {
+		return false, err
+	}
+	if !bytes.Equal(hash, snapshot.Hash) {
+		return false, errors.New("Hash for snapshot is invalid")
+	}
+
+	//group owner is trusted by the seed, other producers must be registered by the owner or an admin
+	if snapshot.ProducerPubkey != ownerPubkey {
+		var signer *quorumpb.ProducerItem
+		for _, item := range producers {
+			if item.ProducerPubkey == snapshot.ProducerPubkey {
+				signer = item
+				break
+			}
+		}
+		if signer == nil {
+			return false, errors.New("Snapshot producer not registered")
+		}
+		if signer.GroupOwnerPubkey != ownerPubkey {
+			return false, errors.New("Snapshot producer owner mismatch")
+		}
+		//producer registered by admin is signed by admin, the role of admin is checked when the item is applied
+		ownerSign, err := hex.DecodeString(signer.GroupOwnerSign)
+		if err != nil {
+			return false, err
+		}
+		if valid, err := verifyBySignPubkey(getItemSigner(ownerPubkey, signer.SignerPubkey), GetProducerItemHash(signer), ownerSign); err != nil || !valid {
+			return false, errors.New("Snapshot producer owner sign invalid")
+		}
+	}
+
+	if valid, err := verifyBySignPubkey(snapshot.Block.ProducerPubKey, snapshot.Block.Hash, snapshot.Block.Signature); err != nil || !valid {
+		return false, errors.New("Snapshot block sign invalid")
+	}
+
+	return verifyBySignPubkey(snapshot.ProducerPubkey, getSnapshotSignHash(snapshot), snapshot.Signature)
+}
+
+func verifyBySignPubkey(signPubkey string, data []byte, signature []byte) (bool, error) {
+	serializedpub, err := p2pcrypto.ConfigDecodeKey(signPubkey)
+	if err != nil {
+		return false, err
+	}
+
+	pubkey, err := p2pcrypto.UnmarshalPublicKey(serializedpub)
+	if err != nil {
+		return false, err
+	}
+
+	return pubkey.Verify(data, signature)
+}
+
+//selectSnapshot picks the state signed by most producers, higher snapshot wins when tie
+func selectSnapshot(snapshots map[string]*quorumpb.Snapshot) *quorumpb.Snapshot {
+	signers := make(map[string]int)
+	states := make(map[string]*quorumpb.Snapshot)
+	for _, snapshot := range snapshots {
+		key := hex.EncodeToString(snapshot.Hash)
+		signers[key]++
+		states[key] = snapshot
+	}
+
+	var selected *quorumpb.Snapshot
+	var selectedKey string
+	for key, snapshot := range states {
+		if selected == nil ||
+			signers[key] > signers[selectedKey] ||
+			(signers[key] == signers[selectedKey] && snapshot.Height > selected.Height) ||
+			(signers[key] == signers[selectedKey] && snapshot.Height == selected.Height && key > selectedKey) {
+			selected = snapshot
+			selectedKey = key
+		}
+	}
+	return selected
+}
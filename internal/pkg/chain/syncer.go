@@ -3,9 +3,12 @@ package chain
 import (
 	"errors"
 	"fmt"
+	"sort"
+	"sync"
 	"time"
 
 	logging "github.com/ipfs/go-log/v2"
+	"github.com/rumsystem/quorum/internal/pkg/eventbus"
 	"github.com/rumsystem/quorum/internal/pkg/nodectx"
 	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
 )
@@ -15,12 +18,17 @@ var syncer_log = logging.Logger("syncer")
 var WAIT_BLOCK_TIME_S = 10 //wait time period
 var RETRY_LIMIT = 30       //retry times
 
+const BLOCK_RANGE_SIZE int64 = 50  //levels of blocks asked in one range
+const BLOCK_RANGE_BATCH = 10       //blocks sent in one range response
+const MAX_PENDING_RANGES int64 = 4 //ranges asked at the same time
+
 //syncer status
 const (
 	SYNCING_FORWARD  = 0
 	SYNCING_BACKWARD = 1
 	SYNC_FAILED      = 2
 	IDLE             = 3
+	SYNCING_SNAPSHOT = 4
 )
 
 type Syncer struct {
@@ -32,22 +40,44 @@ type Syncer struct {
 	retryCount       int8
 	statusBeforeFail int8
 	responses        map[string]*quorumpb.ReqBlockResp
+	snapshots        map[string]*quorumpb.Snapshot
+	snapshotmu       sync.Mutex
+	rangeAnchor      *quorumpb.Block
+	ranges           map[int64]string //pending block ranges, offset -> provider
+	rangeTipReached  bool
+	rangeProvider    int
+	rangeNext        int64           //offset of the next round of ranges from the same anchor
+	historyBlock     *quorumpb.Block //ancestors of the block are synced before syncing forward, nil if history is complete
+	historymu        sync.Mutex
+	syncedBlocks     int64
+	rangemu          sync.Mutex
 	cIface           ChainMolassesIface
 	groupId          string
 }
 
+//setStatus publishes SYNC_STATUS when the status shown to clients is changed
+func (syncer *Syncer) setStatus(status int8) {
+	changed := GetSyncerStatusName(syncer.Status) != GetSyncerStatusName(status)
+	syncer.Status = status
+	if changed {
+		eventbus.Publish(&eventbus.Event{Type: eventbus.SYNC_STATUS, GroupId: syncer.groupId, Status: GetSyncerStatusName(status)})
+	}
+}
+
 func (syncer *Syncer) Init(grp *Group, iface ChainMolassesIface) {
 	syncer_log.Debug("Init called")
 	syncer.Status = IDLE
 	syncer.group = grp
 	syncer.retryCount = 0
 	syncer.responses = make(map[string]*quorumpb.ReqBlockResp)
+	syncer.snapshots = make(map[string]*quorumpb.Snapshot)
 	syncer.groupId = grp.Item.GroupId
 	syncer.cIface = iface
 	syncer_log.Infof("<%s> syncer initialed", syncer.groupId)
 }
 
-// sync block "forward"
+// sync block "forward", blocks before the snapshot block applied are synced in background after it, so the group
+// is ready when the blocks after the snapshot block are synced
 func (syncer *Syncer) SyncForward(block *quorumpb.Block) error {
 	syncer_log.Debugf("<%s> SyncForward called", syncer.group.Item.GroupId)
 
@@ -55,24 +85,78 @@ func (syncer *Syncer) SyncForward(block *quorumpb.Block) error {
 	if syncer.group.Item.OwnerPubKey == syncer.group.Item.UserSignPubkey {
 		if len(syncer.group.ChainCtx.ProducerPool) == 1 {
 			syncer_log.Debugf("<%s> group owner, no registed producer, no need to sync", syncer.groupId)
+			syncer.startHistorySync()
 			return nil
 		} else {
 			syncer_log.Debugf("<%s> owner, has registed producer, start sync missing block", syncer.groupId)
 		}
 	} else if _, ok := syncer.group.ChainCtx.ProducerPool[syncer.group.Item.UserSignPubkey]; ok {
 		syncer_log.Debugf("<%s> producer, no need to sync forward (sync backward when new block produced and found missing block(s)", syncer.groupId)
+		syncer.startHistorySync()
 		return nil
-	} else if syncer.Status == SYNCING_FORWARD || syncer.Status == SYNCING_BACKWARD {
+	} else if syncer.Status == SYNCING_FORWARD || syncer.Status == SYNCING_BACKWARD || syncer.Status == SYNCING_SNAPSHOT {
 		return errors.New("already in SYNCING")
 	}
 
+	//history is synced again when forward sync is done
+	syncer.stopHistorySync()
+	syncer.setStatus(SYNCING_FORWARD)
+	syncer.syncedBlocks = 0
 	syncer_log.Debugf("<%s> try sync forward from block <%s>", syncer.groupId, block.BlockId)
-	syncer.Status = SYNCING_FORWARD
-	syncer.askNextBlock(block)
-	syncer.waitBlock(block)
+	syncer.askBlockRanges(block, 0)
+	syncer.waitBlockRange()
 	return nil
 }
 
+//startHistorySync syncs the blocks before the history block from the genesis block in background, the status of
+//syncer is not changed, so the group is ready while the history is synced
+func (syncer *Syncer) startHistorySync() {
+	syncer.rangemu.Lock()
+	running := syncer.historyBlock != nil
+	syncer.rangemu.Unlock()
+	if running || syncer.Status != IDLE {
+		return
+	}
+
+	history, err := syncer.getHistoryBlock()
+	if err != nil {
+		syncer_log.Warningf("<%s> get history block failed <%s>", syncer.groupId, err.Error())
+		return
+	}
+	if history == nil {
+		return
+	}
+
+	syncer_log.Debugf("<%s> blocks before <%s> not synced, sync history in background", syncer.groupId, history.BlockId)
+	syncer.rangemu.Lock()
+	syncer.historyBlock = history
+	syncer.retryCount = 0
+	syncer.rangemu.Unlock()
+	syncer.syncedBlocks = 0
+	syncer.askBlockRanges(syncer.group.Item.GenesisBlock, 0)
+	syncer.waitBlockRange()
+}
+
+//stopHistorySync stops syncing history in background, the blocks cached are kept for the next history sync
+func (syncer *Syncer) stopHistorySync() {
+	syncer.rangemu.Lock()
+	running := syncer.historyBlock != nil
+	syncer.historyBlock = nil
+	syncer.rangemu.Unlock()
+	if running {
+		syncer_log.Debugf("<%s> history sync stopped", syncer.groupId)
+		syncer.stopWaitBlock()
+	}
+}
+
+func (syncer *Syncer) getHistoryBlock() (*quorumpb.Block, error) {
+	blockId, err := nodectx.GetDbMgr().GetHistoryBlock(syncer.groupId, syncer.nodeName)
+	if err != nil || blockId == "" {
+		return nil, err
+	}
+	return nodectx.GetDbMgr().GetBlock(blockId, false, syncer.nodeName)
+}
+
 //Sync block "backward"
 func (syncer *Syncer) SyncBackward(block *quorumpb.Block) error {
 	syncer_log.Debugf("<%s> SyncBackward called", syncer.group.Item.GroupId)
@@ -84,20 +168,63 @@ func (syncer *Syncer) SyncBackward(block *quorumpb.Block) error {
 		return nil
 	}
 
-	if syncer.Status == SYNCING_FORWARD || syncer.Status == SYNCING_BACKWARD {
+	if syncer.Status == SYNCING_FORWARD || syncer.Status == SYNCING_BACKWARD || syncer.Status == SYNCING_SNAPSHOT {
 		return errors.New("already in SYNCING")
 	}
 
-	syncer.Status = SYNCING_BACKWARD
+	syncer.stopHistorySync()
+	syncer.setStatus(SYNCING_BACKWARD)
 	syncer.askPreviousBlock(block)
 	syncer.waitBlock(block)
 	return nil
 }
 
+//SyncSnapshot asks producers for the latest group snapshot, apply it and sync forward from the snapshot block,
+//sync forward from the given block if no valid snapshot received
+func (syncer *Syncer) SyncSnapshot(block *quorumpb.Block) error {
+	syncer_log.Debugf("<%s> SyncSnapshot called", syncer.groupId)
+
+	if syncer.Status == SYNCING_FORWARD || syncer.Status == SYNCING_BACKWARD || syncer.Status == SYNCING_SNAPSHOT {
+		return errors.New("already in SYNCING")
+	}
+
+	syncer.setStatus(SYNCING_SNAPSHOT)
+	syncer.askSnapshot()
+	syncer.waitSnapshot(block)
+	return nil
+}
+
+func (syncer *Syncer) AddSnapshot(snapshot *quorumpb.Snapshot) error {
+	syncer_log.Debugf("<%s> AddSnapshot called", syncer.groupId)
+	if syncer.Status != SYNCING_SNAPSHOT {
+		syncer_log.Warningf("<%s> Not in syncing snapshot, ignore snapshot", syncer.groupId)
+		return nil
+	}
+
+	producers, err := nodectx.GetDbMgr().GetProducers(syncer.groupId, syncer.nodeName)
+	if err != nil {
+		return err
+	}
+	valid, err := IsSnapshotValid(snapshot, syncer.group.Item.OwnerPubKey, producers)
+	if !valid {
+		if err != nil {
+			syncer_log.Warningf("<%s> invalid snapshot from producer <%s>, <%s>", syncer.groupId, snapshot.ProducerPubkey, err.Error())
+		}
+		return err
+	}
+
+	syncer_log.Debugf("<%s> snapshot incoming, provider <%s>, height <%d>", syncer.groupId, snapshot.ProducerPubkey, snapshot.Height)
+	syncer.snapshotmu.Lock()
+	syncer.snapshots[snapshot.ProducerPubkey] = snapshot
+	syncer.snapshotmu.Unlock()
+	return nil
+}
+
 func (syncer *Syncer) StopSync() error {
 	syncer_log.Debugf("<%s> StopSync called", syncer.groupId)
+	syncer.stopHistorySync()
 	syncer.stopWaitBlock()
-	syncer.Status = IDLE
+	syncer.setStatus(IDLE)
 	syncer_log.Debugf("<%s> sync stopped", syncer.groupId)
 	return nil
 }
@@ -105,10 +232,7 @@ func (syncer *Syncer) StopSync() error {
 func (syncer *Syncer) ContinueSync(block *quorumpb.Block) error {
 	syncer_log.Debugf("<%s> ContinueSync called", syncer.groupId)
 	syncer.stopWaitBlock()
-	if syncer.Status == SYNCING_FORWARD {
-		syncer.askNextBlock(block)
-		syncer.waitBlock(block)
-	} else if syncer.Status == SYNCING_BACKWARD {
+	if syncer.Status == SYNCING_BACKWARD {
 		syncer.askPreviousBlock(block)
 		syncer.waitBlock(block)
 	} else if syncer.Status == SYNC_FAILED {
@@ -123,8 +247,9 @@ func (syncer *Syncer) ContinueSync(block *quorumpb.Block) error {
 
 func (syncer *Syncer) AddBlockSynced(resp *quorumpb.ReqBlockResp, block *quorumpb.Block) error {
 	syncer_log.Debugf("<%s> AddBlockSynced called", syncer.groupId)
-	if !(syncer.Status == SYNCING_FORWARD || syncer.Status == SYNCING_BACKWARD) {
-		syncer_log.Warningf("<%s> Not in syncing, ignore block", syncer.groupId)
+	//forward sync asks block ranges, single block is only asked when sync backward
+	if syncer.Status != SYNCING_BACKWARD {
+		syncer_log.Warningf("<%s> Not in syncing backward, ignore block", syncer.groupId)
 		return nil
 	}
 
@@ -139,53 +264,268 @@ func (syncer *Syncer) AddBlockSynced(resp *quorumpb.ReqBlockResp, block *quorump
 
 	_, producer := syncer.group.ChainCtx.ProducerPool[syncer.group.Item.UserSignPubkey]
 
-	if syncer.Status == SYNCING_FORWARD {
-		if producer {
-			syncer_log.Debugf("<%s> SYNCING_FORWARD, PRODUCER ADD BLOCK", syncer.groupId)
-			err := syncer.group.ChainCtx.Consensus.Producer().AddBlock(block)
-			if err != nil {
-				syncer_log.Infof(err.Error())
-			}
-		} else {
-			syncer_log.Debugf("<%s> SYNCING_FORWARD, USER ADD BLOCK", syncer.groupId)
-			err := syncer.group.ChainCtx.Consensus.User().AddBlock(block)
-			if err != nil {
-				syncer_log.Infof(err.Error())
-			}
+	var err error
+	if producer {
+		syncer_log.Debugf("<%s> SYNCING_BACKWARD, PRODUCER ADD BLOCK", syncer.groupId)
+		err = syncer.group.ChainCtx.Consensus.Producer().AddBlock(block)
+	} else {
+		syncer_log.Debugf("<%s> SYNCING_BACKWARD, USER ADD BLOCK", syncer.groupId)
+		err = syncer.group.ChainCtx.Consensus.User().AddBlock(block)
+	}
+
+	if err != nil {
+		syncer_log.Debugf(err.Error())
+		if err.Error() == "PARENT_NOT_EXIST" {
+			syncer_log.Debugf("<%s> SYNCING_BACKWARD, CONTINUE", syncer.groupId)
+			syncer.ContinueSync(block)
 		}
-		syncer_log.Debugf("<%s> SYNCING_FORWARD, CONTINUE", syncer.groupId)
-		syncer.ContinueSync(block)
-	} else { //sync backward
+	} else {
+		syncer_log.Debugf("<%s> SYNCING_BACKWARD err is nil", syncer.groupId)
+	}
+
+	return nil
+}
+
+func (syncer *Syncer) AddBlockRange(resp *quorumpb.ReqBlockRangeResp) error {
+	syncer_log.Debugf("<%s> AddBlockRange called", syncer.groupId)
+	syncer.rangemu.Lock()
+	provider, ok := syncer.ranges[resp.Offset]
+	asked := ok && provider == resp.ProviderPubkey && syncer.rangeAnchor.BlockId == resp.BlockId
+	history := syncer.historyBlock
+	syncer.rangemu.Unlock()
+	if syncer.Status != SYNCING_FORWARD && history == nil {
+		syncer_log.Warningf("<%s> Not in syncing forward or history, ignore block range", syncer.groupId)
+		return nil
+	}
+	if !asked {
+		syncer_log.Debugf("<%s> block range <%d> from provider <%s> not asked, ignore", syncer.groupId, resp.Offset, resp.ProviderPubkey)
+		return nil
+	}
+
+	//blocks come in any order, block whose parent not exist yet is cached and applied when the parent comes
+	_, producer := syncer.group.ChainCtx.ProducerPool[syncer.group.Item.UserSignPubkey]
+	for _, block := range resp.Blocks {
 		var err error
-		if producer {
-			syncer_log.Debugf("<%s> SYNCING_BACKWARD, PRODUCER ADD BLOCK", syncer.groupId)
+		if history != nil {
+			err = saveHistoryBlock(block, syncer.nodeName)
+		} else if producer {
 			err = syncer.group.ChainCtx.Consensus.Producer().AddBlock(block)
 		} else {
-			syncer_log.Debugf("<%s> SYNCING_BACKWARD, USER ADD BLOCK", syncer.groupId)
 			err = syncer.group.ChainCtx.Consensus.User().AddBlock(block)
 		}
-
 		if err != nil {
-			syncer_log.Debugf(err.Error())
-			if err.Error() == "PARENT_NOT_EXIST" {
-				syncer_log.Debugf("<%s> SYNCING_BACKWARD, CONTINUE", syncer.groupId)
-				syncer.ContinueSync(block)
+			syncer_log.Debugf("<%s> add block <%s> in range, <%s>", syncer.groupId, block.BlockId, err.Error())
+		}
+	}
+
+	syncer.rangemu.Lock()
+	syncer.retryCount = 0
+	syncer.syncedBlocks += int64(len(resp.Blocks))
+	if resp.Result == quorumpb.ReqBlkResult_BLOCK_NOT_FOUND {
+		syncer.rangeTipReached = true
+	}
+	if resp.Last {
+		delete(syncer.ranges, resp.Offset)
+	}
+	pending := len(syncer.ranges)
+	tipReached := syncer.rangeTipReached
+	anchor := syncer.rangeAnchor
+	syncer.rangemu.Unlock()
+
+	if history != nil {
+		return syncer.syncHistory(history, pending, tipReached)
+	}
+
+	syncer_log.Infof("<%s> sync progress, <%d> blocks synced, height <%d>, <%d> ranges pending", syncer.groupId, syncer.syncedBlocks, syncer.group.Item.HighestHeight, pending)
+
+	if pending != 0 {
+		syncer.resetWaitTimer()
+		return nil
+	}
+
+	//all ranges of this round received, ask next round from the new top block
+	topBlock, err := nodectx.GetDbMgr().GetBlock(syncer.group.Item.HighestBlockId, false, syncer.nodeName)
+	if err != nil {
+		return err
+	}
+
+	if tipReached || topBlock.BlockId == anchor.BlockId {
+		syncer_log.Infof("<%s> sync forward done, <%d> blocks synced, height <%d>", syncer.groupId, syncer.syncedBlocks, syncer.group.Item.HighestHeight)
+		syncer.stopWaitBlock()
+		syncer.setStatus(IDLE)
+		syncer.startHistorySync()
+		return nil
+	}
+
+	syncer.askBlockRanges(topBlock, 0)
+	syncer.resetWaitTimer()
+	return nil
+}
+
+//syncHistory connects the history block when all its ancestors are cached. History is given up when the provider
+//has no more blocks, and synced again after next forward sync
+func (syncer *Syncer) syncHistory(history *quorumpb.Block, pending int, tipReached bool) error {
+	syncer.historymu.Lock()
+	defer syncer.historymu.Unlock()
+
+	syncer.rangemu.Lock()
+	current := syncer.historyBlock
+	syncer.rangemu.Unlock()
+	if current != history {
+		//connected by another response
+		return nil
+	}
+
+	path, connected, err := getHistoryPath(history, syncer.nodeName)
+	if err != nil {
+		syncer_log.Warningf("<%s> walk history from block <%s> failed <%s>", syncer.groupId, history.BlockId, err.Error())
+	}
+	if connected {
+		if err := connectHistory(syncer.group.Item, path, syncer.nodeName); err != nil {
+			syncer_log.Warningf("<%s> connect history failed <%s>", syncer.groupId, err.Error())
+		}
+	} else if err == nil && (pending != 0 || !tipReached) {
+		syncer_log.Infof("<%s> sync history progress, <%d> blocks synced, <%d> ranges pending", syncer.groupId, syncer.syncedBlocks, pending)
+		if pending == 0 {
+			syncer.rangemu.Lock()
+			next := syncer.rangeNext
+			syncer.rangemu.Unlock()
+			syncer.askBlockRanges(syncer.group.Item.GenesisBlock, next)
+		}
+		syncer.resetWaitTimer()
+		return nil
+	} else {
+		syncer_log.Warningf("<%s> history before block <%s> not found, sync it next time", syncer.groupId, history.BlockId)
+	}
+
+	//blocks after the history block are synced by forward sync already
+	syncer.stopHistorySync()
+	return nil
+}
+
+func (syncer *Syncer) askSnapshot() {
+	syncer_log.Debugf("<%s> askSnapshot called", syncer.groupId)
+
+	//reset received snapshots
+	syncer.snapshotmu.Lock()
+	syncer.snapshots = make(map[string]*quorumpb.Snapshot)
+	syncer.snapshotmu.Unlock()
+	//send ask snapshot msg out
+	syncer.cIface.GetSyncTrxMgr("").SendReqSnapshot()
+}
+
+//wait snapshots coming, apply the one signed by most producers when time up
+func (syncer *Syncer) waitSnapshot(block *quorumpb.Block) {
+	syncer_log.Debugf("<%s> waitSnapshot called", syncer.groupId)
+	timer := time.NewTimer(time.Duration(WAIT_BLOCK_TIME_S) * time.Second)
+	syncer.rangemu.Lock()
+	syncer.AskNextTimer = timer
+	syncer.rangemu.Unlock()
+	syncer.AskNextTimerDone = make(chan bool, 1)
+	go func() {
+		select {
+		case <-syncer.AskNextTimerDone:
+			syncer_log.Debugf("<%s> wait snapshot stopped by signal", syncer.groupId)
+			return
+		case <-timer.C:
+			syncer.snapshotmu.Lock()
+			snapshot := selectSnapshot(syncer.snapshots)
+			syncer.snapshotmu.Unlock()
+
+			syncFrom := block
+			if snapshot == nil {
+				syncer_log.Debugf("<%s> no snapshot received, sync forward from block <%s>", syncer.groupId, block.BlockId)
+			} else if err := syncer.applySnapshot(snapshot); err != nil {
+				syncer_log.Warningf("<%s> apply snapshot <%s> failed <%s>, sync forward from block <%s>", syncer.groupId, snapshot.SnapshotId, err.Error(), block.BlockId)
+			} else {
+				syncFrom = snapshot.Block
 			}
-		} else {
-			syncer_log.Debugf("<%s> SYNCING_BACKWARD err is nil", syncer.groupId)
+
+			syncer.rangemu.Lock()
+			syncer.AskNextTimer = nil
+			syncer.rangemu.Unlock()
+			syncer.setStatus(IDLE)
+			syncer.SyncForward(syncFrom)
 		}
+	}()
+}
+
+func (syncer *Syncer) applySnapshot(snapshot *quorumpb.Snapshot) error {
+	if snapshot.Height <= syncer.group.Item.HighestHeight {
+		return errors.New("Snapshot is lower than chain")
+	}
+
+	syncer_log.Infof("<%s> apply snapshot <%s>, block <%s>, height <%d>", syncer.groupId, snapshot.SnapshotId, snapshot.BlockId, snapshot.Height)
+	if err := nodectx.GetDbMgr().ApplySnapshot(snapshot, syncer.nodeName); err != nil {
+		return err
+	}
+
+	if err := nodectx.GetDbMgr().AddSnapshotBlock(snapshot.Block, snapshot.Height, syncer.nodeName); err != nil {
+		return err
+	}
+
+	//posts are not in the snapshot, blocks before the snapshot block are synced in background after syncing forward. Profiles are
+	//rebuilt from the posts already saved, the history sync adds the profiles of earlier posts
+	if err := nodectx.GetDbMgr().SetHistoryBlock(syncer.groupId, snapshot.BlockId, syncer.nodeName); err != nil {
+		return err
+	}
+	if err := nodectx.GetDbMgr().RebuildGroupProfiles(syncer.groupId, syncer.nodeName); err != nil {
+		return err
 	}
 
+	if err := syncer.cIface.UpdChainInfo(snapshot.Height, snapshot.BlockId); err != nil {
+		return err
+	}
+
+	//group keys are sorted by epoch, move to the latest key epoch wrapped to us
+	for _, item := range snapshot.GroupKeys {
+		if err := syncer.cIface.UpdGroupKey(item); err != nil {
+			syncer_log.Warningf("<%s> apply group key of epoch <%d> failed, %s", syncer.groupId, item.Epoch, err.Error())
+		}
+	}
+
+	syncer.cIface.UpdProducerList()
 	return nil
 }
 
-func (syncer *Syncer) askNextBlock(block *quorumpb.Block) {
-	syncer_log.Debugf("<%s> askNextBlock called", syncer.groupId)
+//ask a round of block ranges from the offset to the block, ranges are asked from producers in turn
+func (syncer *Syncer) askBlockRanges(block *quorumpb.Block, offset int64) {
+	syncer_log.Debugf("<%s> askBlockRanges called", syncer.groupId)
+	syncer.rangemu.Lock()
+	defer syncer.rangemu.Unlock()
+
+	syncer.rangeAnchor = block
+	syncer.ranges = make(map[int64]string)
+	syncer.rangeTipReached = false
+	for i := int64(0); i < MAX_PENDING_RANGES; i++ {
+		syncer.askBlockRange(offset + i*BLOCK_RANGE_SIZE)
+	}
+	syncer.rangeNext = offset + MAX_PENDING_RANGES*BLOCK_RANGE_SIZE
+}
 
-	//reset received response
-	syncer.responses = make(map[string]*quorumpb.ReqBlockResp)
-	//send ask block forward msg out
-	syncer.cIface.GetProducerTrxMgr().SendReqBlockForward(block)
+//caller should hold rangemu
+func (syncer *Syncer) askBlockRange(offset int64) {
+	var providers []string
+	for pubkey := range syncer.group.ChainCtx.ProducerPool {
+		if pubkey != syncer.group.Item.UserSignPubkey {
+			providers = append(providers, pubkey)
+		}
+	}
+
+	if len(providers) == 0 {
+		syncer_log.Warningf("<%s> no producer to ask block range", syncer.groupId)
+		return
+	}
+
+	sort.Strings(providers)
+	provider := providers[syncer.rangeProvider%len(providers)]
+	syncer.rangeProvider++
+	syncer.ranges[offset] = provider
+
+	syncer_log.Debugf("<%s> ask block range <%d> from producer <%s>", syncer.groupId, offset, provider)
+	if err := syncer.cIface.GetSyncTrxMgr(provider).SendReqBlockRange(syncer.rangeAnchor, provider, offset, BLOCK_RANGE_SIZE); err != nil {
+		syncer_log.Warningf("<%s> ask block range failed <%s>", syncer.groupId, err.Error())
+	}
 }
 
 func (syncer *Syncer) askPreviousBlock(block *quorumpb.Block) {
@@ -194,21 +534,24 @@ func (syncer *Syncer) askPreviousBlock(block *quorumpb.Block) {
 	//reset received response
 	syncer.responses = make(map[string]*quorumpb.ReqBlockResp)
 	//send ask block backward msg out
-	syncer.cIface.GetProducerTrxMgr().SendReqBlockBackward(block)
+	syncer.cIface.GetSyncTrxMgr("").SendReqBlockBackward(block)
 }
 
 //wait block coming
 func (syncer *Syncer) waitBlock(block *quorumpb.Block) {
 	syncer_log.Debugf("<%s> waitBlock called", syncer.groupId)
-	syncer.AskNextTimer = (time.NewTimer)(time.Duration(WAIT_BLOCK_TIME_S) * time.Second)
-	syncer.AskNextTimerDone = make(chan bool)
+	timer := time.NewTimer(time.Duration(WAIT_BLOCK_TIME_S) * time.Second)
+	syncer.rangemu.Lock()
+	syncer.AskNextTimer = timer
+	syncer.rangemu.Unlock()
+	syncer.AskNextTimerDone = make(chan bool, 1)
 	go func() {
 		for {
 			select {
 			case <-syncer.AskNextTimerDone:
 				syncer_log.Debugf("<%s> wait stopped by signal", syncer.groupId)
 				return
-			case <-syncer.AskNextTimer.C:
+			case <-timer.C:
 				syncer_log.Debugf("<%s> wait done", syncer.groupId)
 				if len(syncer.responses) == 0 {
 					syncer.retryCount++
@@ -217,30 +560,88 @@ func (syncer *Syncer) waitBlock(block *quorumpb.Block) {
 						syncer_log.Debugf("<%s> reach retry limit <%d>, SYNC FAILED, check network connection", syncer.groupId, RETRY_LIMIT)
 						//save syncer status
 						syncer.statusBeforeFail = syncer.Status
-						syncer.Status = SYNC_FAILED
+						syncer.setStatus(SYNC_FAILED)
+						syncer.rangemu.Lock()
+						syncer.AskNextTimer = nil
+						syncer.rangemu.Unlock()
 						return
 					}
-					if syncer.Status == SYNCING_FORWARD {
-						syncer.askNextBlock(block)
-						syncer.waitBlock(block)
-					} else if syncer.Status == SYNCING_BACKWARD {
+					if syncer.Status == SYNCING_BACKWARD {
 						syncer.askPreviousBlock(block)
 						syncer.waitBlock(block)
 					}
 					//syncer.ShowChainStruct()
 				} else { // all BLOCK_NOT_FOUND
 					syncer_log.Debugf("<%s> received <%d> BLOCK_NOT_FOUND resp, sync done, set to IDLE", syncer.groupId, len(syncer.responses))
-					syncer.Status = IDLE
+					syncer.setStatus(IDLE)
 				}
 			}
 		}
 	}()
 }
 
+//wait block ranges coming, pending ranges are asked again from other producers when time up
+func (syncer *Syncer) waitBlockRange() {
+	syncer_log.Debugf("<%s> waitBlockRange called", syncer.groupId)
+	timer := time.NewTimer(time.Duration(WAIT_BLOCK_TIME_S) * time.Second)
+	syncer.rangemu.Lock()
+	syncer.AskNextTimer = timer
+	syncer.rangemu.Unlock()
+	syncer.AskNextTimerDone = make(chan bool, 1)
+	go func() {
+		for {
+			select {
+			case <-syncer.AskNextTimerDone:
+				syncer_log.Debugf("<%s> wait block range stopped by signal", syncer.groupId)
+				return
+			case <-timer.C:
+				syncer.rangemu.Lock()
+				syncer.retryCount++
+				syncer_log.Debugf("<%s> wait block range timeout, ask <%d> pending ranges again (retry time: <%d>)", syncer.groupId, len(syncer.ranges), syncer.retryCount)
+				if syncer.retryCount == int8(RETRY_LIMIT) {
+					if syncer.historyBlock != nil {
+						//history synced in background does not fail the group, it is synced again after next forward sync
+						syncer_log.Debugf("<%s> reach retry limit <%d>, give up history sync", syncer.groupId, RETRY_LIMIT)
+						syncer.historyBlock = nil
+					} else {
+						syncer_log.Debugf("<%s> reach retry limit <%d>, SYNC FAILED, check network connection", syncer.groupId, RETRY_LIMIT)
+						syncer.statusBeforeFail = syncer.Status
+						syncer.setStatus(SYNC_FAILED)
+					}
+					syncer.AskNextTimer = nil
+					syncer.rangemu.Unlock()
+					return
+				}
+				for offset := range syncer.ranges {
+					syncer.askBlockRange(offset)
+				}
+				syncer.rangemu.Unlock()
+				timer.Reset(time.Duration(WAIT_BLOCK_TIME_S) * time.Second)
+			}
+		}
+	}()
+}
+
+//resetWaitTimer restarts the timer of waiting block ranges, responses may come after the timer is cleared by a
+//failed sync
+func (syncer *Syncer) resetWaitTimer() {
+	syncer.rangemu.Lock()
+	defer syncer.rangemu.Unlock()
+	if syncer.AskNextTimer != nil {
+		syncer.AskNextTimer.Reset(time.Duration(WAIT_BLOCK_TIME_S) * time.Second)
+	}
+}
+
 func (syncer *Syncer) stopWaitBlock() {
 	syncer_log.Debugf("<%s> stopWaitBlock called", syncer.groupId)
-	if syncer.AskNextTimer != nil {
-		syncer.AskNextTimer.Stop()
+	//the timer is cleared so the wait goroutine is signaled once, the signal is buffered and never blocks when the
+	//goroutine is already gone
+	syncer.rangemu.Lock()
+	timer := syncer.AskNextTimer
+	syncer.AskNextTimer = nil
+	syncer.rangemu.Unlock()
+	if timer != nil {
+		timer.Stop()
 		syncer.AskNextTimerDone <- true
 	}
 }
@@ -0,0 +1,120 @@
+package chain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rumsystem/quorum/internal/pkg/nodectx"
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+)
+
+//newTestSyncGroup creates a group owned by this node with another producer to sync blocks from
+func newTestSyncGroup(t *testing.T) (*Group, *testChainIface) {
+	grpItem := newTestGroup(t)
+	top := &quorumpb.Block{BlockId: "top", GroupId: grpItem.GroupId}
+	if err := nodectx.GetDbMgr().AddGensisBlock(top, ""); err != nil {
+		t.Fatalf("add block err: %s", err)
+	}
+	grpItem.GenesisBlock = top
+	grpItem.HighestBlockId = top.BlockId
+
+	cIface := newTestChainIface(grpItem)
+	cIface.chain.ProducerPool["p1"] = &quorumpb.ProducerItem{ProducerPubkey: "p1"}
+	group := &Group{Item: grpItem, ChainCtx: cIface.chain}
+	cIface.chain.group = group
+	cIface.chain.Syncer = &Syncer{}
+	cIface.chain.Syncer.Init(group, cIface)
+	return group, cIface
+}
+
+//runTestSyncStep fails the test if the step does not return in time
+func runTestSyncStep(t *testing.T, name string, step func() error) {
+	done := make(chan error, 1)
+	go func() { done <- step() }()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("%s err: %s", name, err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("%s hangs", name)
+	}
+}
+
+//answerTestRanges answers all pending block ranges with BLOCK_NOT_FOUND
+func answerTestRanges(t *testing.T, syncer *Syncer) {
+	syncer.rangemu.Lock()
+	var resps []*quorumpb.ReqBlockRangeResp
+	for offset, provider := range syncer.ranges {
+		resps = append(resps, &quorumpb.ReqBlockRangeResp{GroupId: syncer.groupId, BlockId: syncer.rangeAnchor.BlockId, ProviderPubkey: provider, Offset: offset, Result: quorumpb.ReqBlkResult_BLOCK_NOT_FOUND, Last: true})
+	}
+	syncer.rangemu.Unlock()
+	for _, resp := range resps {
+		runTestSyncStep(t, "add block range", func() error { return syncer.AddBlockRange(resp) })
+	}
+}
+
+func TestStopSyncAfterSyncDone(t *testing.T) {
+	group, cIface := newTestSyncGroup(t)
+	syncer := cIface.chain.Syncer
+
+	runTestSyncStep(t, "start sync", group.StartSync)
+	if syncer.Status != SYNCING_FORWARD {
+		t.Fatalf("got status %d, want SYNCING_FORWARD", syncer.Status)
+	}
+
+	//the provider has no block after the top block, sync is done when all ranges are answered
+	answerTestRanges(t, syncer)
+	if syncer.Status != IDLE {
+		t.Fatalf("got status %d after sync done, want IDLE", syncer.Status)
+	}
+
+	runTestSyncStep(t, "stop sync", cIface.chain.StopSync)
+	runTestSyncStep(t, "start sync again", group.StartSync)
+	if syncer.Status != SYNCING_FORWARD {
+		t.Errorf("got status %d after sync started again, want SYNCING_FORWARD", syncer.Status)
+	}
+	runTestSyncStep(t, "stop sync again", cIface.chain.StopSync)
+	if syncer.Status != IDLE {
+		t.Errorf("got status %d after sync stopped, want IDLE", syncer.Status)
+	}
+}
+
+func TestHistorySyncInBackground(t *testing.T) {
+	group, cIface := newTestSyncGroup(t)
+	syncer := cIface.chain.Syncer
+	//the group is synced by a snapshot on the top block
+	if err := nodectx.GetDbMgr().SetHistoryBlock(group.Item.GroupId, group.Item.HighestBlockId, ""); err != nil {
+		t.Fatalf("set history block err: %s", err)
+	}
+
+	runTestSyncStep(t, "start sync", group.StartSync)
+	syncer.rangemu.Lock()
+	history := syncer.historyBlock
+	syncer.rangemu.Unlock()
+	if syncer.Status != SYNCING_FORWARD || history != nil {
+		t.Fatalf("got status %d, history %v, want SYNCING_FORWARD from the top block", syncer.Status, history)
+	}
+
+	answerTestRanges(t, syncer)
+	syncer.rangemu.Lock()
+	history = syncer.historyBlock
+	anchor := syncer.rangeAnchor
+	syncer.rangemu.Unlock()
+	if cIface.chain.IsSyncerReady() {
+		t.Fatalf("group is busy while history is synced, status %d", syncer.Status)
+	}
+	if history == nil || anchor.BlockId != group.Item.GenesisBlock.BlockId {
+		t.Fatalf("history is not synced from the genesis block after forward sync")
+	}
+
+	//the provider has no history, history sync is given up without failing the group
+	answerTestRanges(t, syncer)
+	syncer.rangemu.Lock()
+	history = syncer.historyBlock
+	syncer.rangemu.Unlock()
+	if history != nil || syncer.Status != IDLE {
+		t.Errorf("got status %d, history %v after history sync is given up, want IDLE", syncer.Status, history)
+	}
+	runTestSyncStep(t, "stop sync", cIface.chain.StopSync)
+}
@@ -0,0 +1,201 @@
+package chain
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	guuid "github.com/google/uuid"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/rumsystem/quorum/internal/pkg/nodectx"
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+	pubsubconn "github.com/rumsystem/quorum/internal/pkg/pubsubconn"
+	"google.golang.org/protobuf/proto"
+)
+
+//reqStreamConn sends sync request to producers over direct streams,
+//request is published to the producer channel if no response received from streams
+type reqStreamConn struct {
+	chain          *Chain
+	providerPubkey string
+	peers          []peer.ID
+	fallback       *TrxMgr
+}
+
+func (conn *reqStreamConn) JoinChannel(cId string, chain pubsubconn.Chain) error {
+	return nil
+}
+
+func (conn *reqStreamConn) LeaveChannel(cId string) {
+}
+
+func (conn *reqStreamConn) Publish(data []byte) error {
+	go conn.request(data)
+	return nil
+}
+
+func (conn *reqStreamConn) request(data []byte) {
+	nodeCtx := nodectx.GetNodeCtx()
+	syncService := nodeCtx.Node.SyncService
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	received := 0
+	for _, p := range conn.peers {
+		wg.Add(1)
+		go func(p peer.ID) {
+			defer wg.Done()
+			err := syncService.Request(nodeCtx.Ctx, p, data, func(resp []byte) error {
+				var pkg quorumpb.Package
+				if err := proto.Unmarshal(resp, &pkg); err != nil {
+					return err
+				}
+				if pkg.Type != quorumpb.PackageType_TRX {
+					return errors.New("unsupported sync response")
+				}
+
+				trx := &quorumpb.Trx{}
+				if err := proto.Unmarshal(pkg.Data, trx); err != nil {
+					return err
+				}
+
+				//remember the peer of producer, following requests to it are sent to this peer only
+				if _, ok := conn.chain.ProducerPool[trx.SenderPubkey]; ok {
+					syncService.AddProviderPeer(trx.SenderPubkey, p)
+				}
+
+				mu.Lock()
+				received++
+				mu.Unlock()
+				return conn.chain.HandleTrx(trx)
+			})
+			if err != nil {
+				chain_log.Debugf("<%s> sync request to peer <%s> failed <%s>", conn.chain.groupId, p, err.Error())
+			}
+		}(p)
+	}
+	wg.Wait()
+
+	if received == 0 {
+		chain_log.Debugf("<%s> no response from sync streams, fall back to producer channel", conn.chain.groupId)
+		if conn.providerPubkey != "" {
+			syncService.RemoveProviderPeer(conn.providerPubkey)
+		}
+		if err := conn.publishFallback(data); err != nil {
+			chain_log.Warningf("<%s> publish sync request failed <%s>", conn.chain.groupId, err.Error())
+		}
+	}
+}
+
+//publishFallback publishes the request to producer channel as a new trx, the trx sent over streams may be
+//admitted by producers already, and the same one would be rejected as replayed
+func (conn *reqStreamConn) publishFallback(data []byte) error {
+	var pkg quorumpb.Package
+	if err := proto.Unmarshal(data, &pkg); err != nil {
+		return err
+	}
+	trx := &quorumpb.Trx{}
+	if err := proto.Unmarshal(pkg.Data, trx); err != nil {
+		return err
+	}
+
+	trx.TrxId = guuid.New().String()
+	trx.TimeStamp = time.Now().UnixNano()
+	return conn.fallback.ResendTrx(trx)
+}
+
+//respStreamConn writes trxs published by producer back to the sync stream the request came from
+type respStreamConn struct {
+	respond func(resp []byte) error
+}
+
+func (conn *respStreamConn) JoinChannel(cId string, chain pubsubconn.Chain) error {
+	return nil
+}
+
+func (conn *respStreamConn) LeaveChannel(cId string) {
+}
+
+func (conn *respStreamConn) Publish(data []byte) error {
+	return conn.respond(data)
+}
+
+//GetSyncTrxMgr returns trxMgr to send sync request, request is sent to the provider over a direct stream,
+//or to all peers in producer channel if the peer of provider is unknown, and falls back to producer channel
+func (chain *Chain) GetSyncTrxMgr(providerPubkey string) *TrxMgr {
+	producerTrxMgr := chain.GetProducerTrxMgr()
+
+	node := nodectx.GetNodeCtx().Node
+	if node == nil || node.SyncService == nil {
+		return producerTrxMgr
+	}
+
+	var peers []peer.ID
+	if p, ok := node.SyncService.GetProviderPeer(providerPubkey); ok {
+		peers = append(peers, p)
+	} else {
+		peers = node.Pubsub.ListPeers(chain.producerChannelId)
+	}
+
+	if len(peers) == 0 {
+		chain_log.Debugf("<%s> no peer for sync stream, use producer channel", chain.groupId)
+		return producerTrxMgr
+	}
+
+	conn := &reqStreamConn{chain: chain, providerPubkey: providerPubkey, peers: peers, fallback: producerTrxMgr}
+	syncTrxMgr := &TrxMgr{}
+	syncTrxMgr.Init(chain.group.Item, conn)
+	return syncTrxMgr
+}
+
+//GetStreamTrxMgr returns trxMgr to respond the sync request if it came from a sync stream
+func (chain *Chain) GetStreamTrxMgr(trxId string) (*TrxMgr, bool) {
+	chain.streammu.Lock()
+	defer chain.streammu.Unlock()
+	trxMgr, ok := chain.streamTrxMgrs[trxId]
+	return trxMgr, ok
+}
+
+//HandleSyncRequest handles sync request from a sync stream, responses are written back to the stream
+func (chain *Chain) HandleSyncRequest(trx *quorumpb.Trx, respond func(resp []byte) error) error {
+	if trx.Version != nodectx.GetNodeCtx().Version {
+		chain_log.Errorf("HandleSyncRequest called, Trx Version mismatch %s", trx.TrxId)
+		return errors.New("Trx Version mismatch")
+	}
+
+	//only producers handle sync requests, the nonce is not taken by other nodes so the request can still be
+	//published to producer channel
+	if chain.Consensus == nil || chain.Consensus.Producer() == nil {
+		return nil
+	}
+
+	if err := chain.admission.Admit(trx); err != nil {
+		return err
+	}
+
+	streamTrxMgr := &TrxMgr{}
+	streamTrxMgr.Init(chain.group.Item, &respStreamConn{respond: respond})
+
+	chain.streammu.Lock()
+	chain.streamTrxMgrs[trx.TrxId] = streamTrxMgr
+	chain.streammu.Unlock()
+
+	defer func() {
+		chain.streammu.Lock()
+		delete(chain.streamTrxMgrs, trx.TrxId)
+		chain.streammu.Unlock()
+	}()
+
+	switch trx.Type {
+	case quorumpb.TrxType_REQ_BLOCK_FORWARD:
+		return chain.handleReqBlockForward(trx)
+	case quorumpb.TrxType_REQ_BLOCK_BACKWARD:
+		return chain.handleReqBlockBackward(trx)
+	case quorumpb.TrxType_REQ_BLOCK_RANGE:
+		return chain.handleReqBlockRange(trx)
+	case quorumpb.TrxType_REQ_SNAPSHOT:
+		return chain.handleReqSnapshot(trx)
+	default:
+		return errors.New("unsupported sync request type")
+	}
+}
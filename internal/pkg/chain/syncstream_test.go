@@ -0,0 +1,76 @@
+package chain
+
+import (
+	"testing"
+
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+func newTestSyncRequest(t *testing.T, cIface *testChainIface) (*quorumpb.Trx, []byte) {
+	trx, err := cIface.producerTrxMgr.CreateTrx(quorumpb.TrxType_REQ_SNAPSHOT, []byte("req"))
+	if err != nil {
+		t.Fatalf("create trx err: %s", err)
+	}
+	pbBytes, err := proto.Marshal(trx)
+	if err != nil {
+		t.Fatalf("marshal trx err: %s", err)
+	}
+	data, err := proto.Marshal(&quorumpb.Package{Type: quorumpb.PackageType_TRX, Data: pbBytes})
+	if err != nil {
+		t.Fatalf("marshal package err: %s", err)
+	}
+	return trx, data
+}
+
+func TestSyncRequestFallback(t *testing.T) {
+	grpItem := newTestGroup(t)
+	cIface := newTestChainIface(grpItem)
+	admission := &TrxAdmission{}
+	admission.Init(grpItem.GroupId)
+
+	trx, data := newTestSyncRequest(t, cIface)
+	if err := admission.Admit(trx); err != nil {
+		t.Fatalf("admit request err: %s", err)
+	}
+
+	conn := &reqStreamConn{chain: cIface.chain, fallback: cIface.producerTrxMgr}
+	if err := conn.publishFallback(data); err != nil {
+		t.Fatalf("publish fallback err: %s", err)
+	}
+	if cIface.producerPsconn.count() != 1 {
+		t.Fatalf("got %d published, want 1", cIface.producerPsconn.count())
+	}
+
+	var pkg quorumpb.Package
+	if err := proto.Unmarshal(cIface.producerPsconn.published[0], &pkg); err != nil {
+		t.Fatalf("unmarshal package err: %s", err)
+	}
+	resent := &quorumpb.Trx{}
+	if err := proto.Unmarshal(pkg.Data, resent); err != nil {
+		t.Fatalf("unmarshal trx err: %s", err)
+	}
+	if resent.TrxId == trx.TrxId || resent.Type != trx.Type {
+		t.Errorf("got fallback trx <%s> %s, want a new %s trx", resent.TrxId, resent.Type, trx.Type)
+	}
+	if err := admission.Admit(resent); err != nil {
+		t.Errorf("fallback request is rejected, %s", err)
+	}
+}
+
+func TestHandleSyncRequestByUser(t *testing.T) {
+	grpItem := newTestGroup(t)
+	cIface := newTestChainIface(grpItem)
+	cIface.chain.admission = &TrxAdmission{}
+	cIface.chain.admission.Init(grpItem.GroupId)
+
+	trx, _ := newTestSyncRequest(t, cIface)
+	if err := cIface.chain.HandleSyncRequest(trx, func(resp []byte) error { return nil }); err != nil {
+		t.Fatalf("handle sync request err: %s", err)
+	}
+
+	//the request ignored by user can be admitted when it is published to producer channel
+	if err := cIface.chain.admission.Admit(trx); err != nil {
+		t.Errorf("request is rejected after ignored by user, %s", err)
+	}
+}
@@ -0,0 +1,152 @@
+package chain
+
+import (
+	"context"
+	"encoding/hex"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	guuid "github.com/google/uuid"
+	p2pcrypto "github.com/libp2p/go-libp2p-core/crypto"
+	localcrypto "github.com/rumsystem/quorum/internal/pkg/crypto"
+	"github.com/rumsystem/quorum/internal/pkg/nodectx"
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+	"github.com/rumsystem/quorum/internal/pkg/pubsubconn"
+	"github.com/rumsystem/quorum/internal/pkg/storage"
+)
+
+//newTestGroup creates a private group owned by this node, with a new db and keystore in the node ctx
+func newTestGroup(t *testing.T) *quorumpb.GroupItem {
+	dir := t.TempDir()
+	db := &storage.QSBadger{}
+	if err := db.Init(filepath.Join(dir, "data")); err != nil {
+		t.Fatalf("init db err: %s", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	nodectx.InitCtx(context.Background(), "", nil, &storage.DbMgr{Db: db, GroupInfoDb: db}, "pubsub", "")
+	if _, err := localcrypto.InitKeystore("default", filepath.Join(dir, "keystore")); err != nil {
+		t.Fatalf("init keystore err: %s", err)
+	}
+	nodectx.GetNodeCtx().Keystore = localcrypto.GetKeystore()
+	if err := nodectx.GetNodeCtx().Keystore.Unlock(map[string]string{}, "test"); err != nil {
+		t.Fatalf("unlock keystore err: %s", err)
+	}
+
+	groupId := guuid.New().String()
+	signPubkey, encryptPubkey := newTestKeys(t, groupId)
+	cipherKey, err := localcrypto.CreateAesKey()
+	if err != nil {
+		t.Fatalf("create aes key err: %s", err)
+	}
+
+	grpItem := &quorumpb.GroupItem{
+		GroupId:           groupId,
+		GroupName:         "test",
+		OwnerPubKey:       signPubkey,
+		UserSignPubkey:    signPubkey,
+		UserEncryptPubkey: encryptPubkey,
+		EncryptType:       quorumpb.GroupEncryptType_PRIVATE,
+		ConsenseType:      quorumpb.GroupConsenseType_POA,
+		CipherKey:         hex.EncodeToString(cipherKey),
+	}
+	if err := nodectx.GetDbMgr().AddGroup(grpItem); err != nil {
+		t.Fatalf("add group err: %s", err)
+	}
+	return grpItem
+}
+
+//newTestKeys creates the sign and encrypt keys named keyname, and returns the sign pubkey encoded as the group
+//pubkeys and the encrypt pubkey
+func newTestKeys(t *testing.T, keyname string) (string, string) {
+	ks := nodectx.GetNodeCtx().Keystore.(*localcrypto.DirKeyStore)
+	if _, err := ks.NewKeyWithDefaultPassword(keyname, localcrypto.Sign); err != nil {
+		t.Fatalf("new sign key err: %s", err)
+	}
+	encryptPubkey, err := ks.NewKeyWithDefaultPassword(keyname, localcrypto.Encrypt)
+	if err != nil {
+		t.Fatalf("new encrypt key err: %s", err)
+	}
+
+	hexkey, err := ks.GetEncodedPubkey(keyname, localcrypto.Sign)
+	if err != nil {
+		t.Fatalf("get sign pubkey err: %s", err)
+	}
+	pubkeyBytes, err := hex.DecodeString(hexkey)
+	if err != nil {
+		t.Fatalf("decode sign pubkey err: %s", err)
+	}
+	pubkey, err := p2pcrypto.UnmarshalSecp256k1PublicKey(pubkeyBytes)
+	if err != nil {
+		t.Fatalf("unmarshal sign pubkey err: %s", err)
+	}
+	marshaled, err := p2pcrypto.MarshalPublicKey(pubkey)
+	if err != nil {
+		t.Fatalf("marshal sign pubkey err: %s", err)
+	}
+	return p2pcrypto.ConfigEncodeKey(marshaled), encryptPubkey
+}
+
+//testChainIface is the chain of the test group, the methods not used by the tests are not implemented
+type testChainIface struct {
+	ChainMolassesIface
+	chain          *Chain
+	keyUpdates     int
+	producerPsconn *testPubSubConn
+	producerTrxMgr *TrxMgr
+}
+
+func newTestChainIface(grpItem *quorumpb.GroupItem) *testChainIface {
+	c := &testChainIface{chain: &Chain{group: &Group{Item: grpItem}, groupId: grpItem.GroupId}, producerPsconn: &testPubSubConn{}}
+	c.chain.ProducerPool = map[string]*quorumpb.ProducerItem{grpItem.OwnerPubKey: {ProducerPubkey: grpItem.OwnerPubKey}}
+	c.producerTrxMgr = &TrxMgr{}
+	c.producerTrxMgr.Init(grpItem, c.producerPsconn)
+	return c
+}
+
+func (c *testChainIface) GetProducerTrxMgr() *TrxMgr {
+	return c.producerTrxMgr
+}
+
+func (c *testChainIface) GetSyncTrxMgr(providerPubkey string) *TrxMgr {
+	return c.producerTrxMgr
+}
+
+func (c *testChainIface) IsSyncerReady() bool {
+	return false
+}
+
+func (c *testChainIface) GetChainCtx() *Chain {
+	return c.chain
+}
+
+func (c *testChainIface) UpdGroupKey(item *quorumpb.GroupKeyItem) error {
+	c.keyUpdates++
+	return c.chain.UpdGroupKey(item)
+}
+
+//testPubSubConn keeps the published packages instead of sending them
+type testPubSubConn struct {
+	published [][]byte
+	mu        sync.Mutex
+}
+
+func (conn *testPubSubConn) JoinChannel(cId string, chain pubsubconn.Chain) error {
+	return nil
+}
+
+func (conn *testPubSubConn) LeaveChannel(cId string) {}
+
+func (conn *testPubSubConn) Publish(data []byte) error {
+	conn.mu.Lock()
+	defer conn.mu.Unlock()
+	conn.published = append(conn.published, data)
+	return nil
+}
+
+func (conn *testPubSubConn) count() int {
+	conn.mu.Lock()
+	defer conn.mu.Unlock()
+	return len(conn.published)
+}
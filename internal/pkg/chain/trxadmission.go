@@ -0,0 +1,135 @@
+package chain
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	logging "github.com/ipfs/go-log/v2"
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+)
+
+var admission_log = logging.Logger("admission")
+
+//reject reasons
+const (
+	TRX_INVALID_SIGN = "TRX_INVALID_SIGN"
+	TRX_EXPIRED      = "TRX_EXPIRED"
+	TRX_REPLAYED     = "TRX_REPLAYED"
+)
+
+const NONCE_PRUNE_INTERVAL time.Duration = 60 //60s
+
+//TrxAdmission checks every inbound trx of a group before it is handled,
+//signature, expired time and nonce (replay) are checked
+type TrxAdmission struct {
+	groupId   string
+	nonces    map[string]int64 //sender_nonce -> trx expired time
+	rejected  map[string]int64 //reject reason -> count
+	lastPrune time.Time
+	mu        sync.Mutex
+}
+
+func (admission *TrxAdmission) Init(groupId string) {
+	admission.groupId = groupId
+	admission.nonces = make(map[string]int64)
+	admission.rejected = make(map[string]int64)
+	admission.lastPrune = time.Now()
+}
+
+//Admit checks an inbound trx, return error (with the reject reason) if the trx should be dropped
+func (admission *TrxAdmission) Admit(trx *quorumpb.Trx) error {
+	admission.mu.Lock()
+	defer admission.mu.Unlock()
+
+	now := time.Now().UnixNano()
+	admission.pruneNonces(now)
+
+	if err := verifyTrxSign(trx); err != nil {
+		return admission.reject(trx, err)
+	}
+
+	if trx.Expired < now {
+		return admission.reject(trx, errors.New(TRX_EXPIRED))
+	}
+
+	nonceKey := fmt.Sprintf("%s_%d", trx.SenderPubkey, trx.Nonce)
+	if _, ok := admission.nonces[nonceKey]; ok {
+		return admission.reject(trx, errors.New(TRX_REPLAYED))
+	}
+	admission.nonces[nonceKey] = trx.Expired
+
+	return nil
+}
+
+//VerifyBlockTrxs checks all trxs packaged in a block, a trx should be signed by its sender
+//and should be packaged before it expired
+func (admission *TrxAdmission) VerifyBlockTrxs(block *quorumpb.Block) error {
+	admission.mu.Lock()
+	defer admission.mu.Unlock()
+
+	for _, trx := range block.Trxs {
+		if err := verifyTrxSign(trx); err != nil {
+			return admission.reject(trx, err)
+		}
+
+		if trx.Expired < block.TimeStamp {
+			return admission.reject(trx, errors.New(TRX_EXPIRED))
+		}
+	}
+
+	return nil
+}
+
+//GetRejectedCount returns rejected trx count by reject reason
+func (admission *TrxAdmission) GetRejectedCount() map[string]int64 {
+	admission.mu.Lock()
+	defer admission.mu.Unlock()
+
+	result := make(map[string]int64)
+	for reason, count := range admission.rejected {
+		result[reason] = count
+	}
+	return result
+}
+
+//Reject counts and logs a trx rejected by other checks (schema etc.)
+func (admission *TrxAdmission) Reject(trx *quorumpb.Trx, err error) error {
+	admission.mu.Lock()
+	defer admission.mu.Unlock()
+	return admission.reject(trx, err)
+}
+
+func (admission *TrxAdmission) reject(trx *quorumpb.Trx, err error) error {
+	admission.rejected[err.Error()]++
+	admission_log.Warningf("<%s> reject trx <%s> type <%s> from <%s>, reason <%s>, total rejected <%d>", admission.groupId, trx.TrxId, trx.Type.String(), trx.SenderPubkey, err.Error(), admission.rejected[err.Error()])
+	return err
+}
+
+//nonce only needs to be remembered before the trx expired, an expired trx will be rejected anyway
+func (admission *TrxAdmission) pruneNonces(now int64) {
+	if time.Since(admission.lastPrune) < NONCE_PRUNE_INTERVAL*time.Second {
+		return
+	}
+
+	for key, expired := range admission.nonces {
+		if expired < now {
+			delete(admission.nonces, key)
+		}
+	}
+	admission.lastPrune = time.Now()
+}
+
+func verifyTrxSign(trx *quorumpb.Trx) error {
+	if trx.SenderPubkey == "" || len(trx.SenderSign) == 0 {
+		return errors.New(TRX_INVALID_SIGN)
+	}
+
+	verified, err := VerifyTrx(trx)
+	if err != nil || !verified {
+		return errors.New(TRX_INVALID_SIGN)
+	}
+
+	return nil
+}
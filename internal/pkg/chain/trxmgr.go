@@ -1,8 +1,8 @@
 package chain
 
 import (
+	"crypto/rand"
 	"encoding/binary"
-	"encoding/hex"
 	"errors"
 	"fmt"
 	"time"
@@ -51,6 +51,15 @@ func (trxMgr *TrxMgr) LeaveChannel(cId string) {
 }
 
 func (trxMgr *TrxMgr) CreateTrxWithoutSign(msgType quorumpb.TrxType, data []byte) (*quorumpb.Trx, []byte, error) {
+	//trxs to sync or produce blocks are encrypted by the seed key, others by the key of current key epoch
+	keyEpoch := SEED_KEY_EPOCH
+	if isEpochKeyTrx(msgType) {
+		keyEpoch = trxMgr.groupItem.KeyEpoch
+	}
+	return trxMgr.createTrxWithoutSign(msgType, data, keyEpoch)
+}
+
+func (trxMgr *TrxMgr) createTrxWithoutSign(msgType quorumpb.TrxType, data []byte, keyEpoch int64) (*quorumpb.Trx, []byte, error) {
 	var trx quorumpb.Trx
 
 	trxId := guuid.New()
@@ -60,10 +69,10 @@ func (trxMgr *TrxMgr) CreateTrxWithoutSign(msgType quorumpb.TrxType, data []byte
 	trx.SenderPubkey = trxMgr.groupItem.UserSignPubkey
 
 	var encryptdData []byte
+	var err error
 
 	if msgType == quorumpb.TrxType_POST && trxMgr.groupItem.EncryptType == quorumpb.GroupEncryptType_PRIVATE {
 		//for post, private group, encrypted by age for all announced group users
-		var err error
 		announcedUser, err := nodectx.GetDbMgr().GetAnnouncedUsersByGroup(trxMgr.groupItem.GroupId)
 
 		var pubkeys []string
@@ -79,8 +88,7 @@ func (trxMgr *TrxMgr) CreateTrxWithoutSign(msgType quorumpb.TrxType, data []byte
 			return &trx, []byte(""), err
 		}
 	} else {
-		var err error
-		ciperKey, err := hex.DecodeString(trxMgr.groupItem.CipherKey)
+		ciperKey, err := getCipherKey(trxMgr.groupItem, keyEpoch, trxMgr.nodename)
 		if err != nil {
 			return &trx, []byte(""), err
 		}
@@ -88,22 +96,23 @@ func (trxMgr *TrxMgr) CreateTrxWithoutSign(msgType quorumpb.TrxType, data []byte
 		if err != nil {
 			return &trx, []byte(""), err
 		}
+		trx.KeyEpoch = keyEpoch
 	}
 
 	trx.Data = encryptdData
 
 	trx.TimeStamp = time.Now().UnixNano()
 	trx.Version = nodectx.GetNodeCtx().Version
-	timein := time.Now().Local().Add(time.Hour*time.Duration(Hours) +
-		time.Minute*time.Duration(Mins) +
-		time.Second*time.Duration(Sec))
-	trx.Expired = timein.UnixNano()
+	trx.Expired = getExpiredTime()
+	trx.Nonce, err = getNonce()
+	if err != nil {
+		return &trx, []byte(""), err
+	}
 
-	bytes, err := proto.Marshal(&trx)
+	hashed, err := getTrxHash(&trx)
 	if err != nil {
 		return &trx, []byte(""), err
 	}
-	hashed := localcrypto.Hash(bytes)
 	return &trx, hashed, nil
 }
 
@@ -113,6 +122,24 @@ func (trxMgr *TrxMgr) CreateTrx(msgType quorumpb.TrxType, data []byte) (*quorump
 	if err != nil {
 		return trx, err
 	}
+
+	err = trxMgr.signTrx(trx, hashed)
+	return trx, err
+}
+
+//createRespTrx creates the response of a sync request by the key epoch of the request, so the requester without the
+//latest key epoch can decrypt it
+func (trxMgr *TrxMgr) createRespTrx(msgType quorumpb.TrxType, data []byte, keyEpoch int64) (*quorumpb.Trx, error) {
+	trx, hashed, err := trxMgr.createTrxWithoutSign(msgType, data, keyEpoch)
+	if err != nil {
+		return trx, err
+	}
+
+	err = trxMgr.signTrx(trx, hashed)
+	return trx, err
+}
+
+func (trxMgr *TrxMgr) signTrx(trx *quorumpb.Trx, hashed []byte) error {
 	ks := nodectx.GetNodeCtx().Keystore
 	keyname := trxMgr.groupItem.GroupId
 	if trxMgr.nodename != "" {
@@ -121,33 +148,24 @@ func (trxMgr *TrxMgr) CreateTrx(msgType quorumpb.TrxType, data []byte) (*quorump
 	signature, err := ks.SignByKeyName(keyname, hashed)
 
 	if err != nil {
-		return trx, err
+		return err
 	}
 
 	trx.SenderSign = signature
-
-	return trx, nil
+	return nil
 }
 
 func (trxMgr *TrxMgr) VerifyTrx(trx *quorumpb.Trx) (bool, error) {
-	//clone trxMsg to verify
-	clonetrxmsg := &quorumpb.Trx{
-		TrxId:        trx.TrxId,
-		Type:         trx.Type,
-		GroupId:      trx.GroupId,
-		SenderPubkey: trx.SenderPubkey,
-		Data:         trx.Data,
-		TimeStamp:    trx.TimeStamp,
-		Version:      trx.Version,
-		Expired:      trx.Expired}
+	return VerifyTrx(trx)
+}
 
-	bytes, err := proto.Marshal(clonetrxmsg)
+//verify trx signature with the sender pubkey
+func VerifyTrx(trx *quorumpb.Trx) (bool, error) {
+	hashed, err := getTrxHash(trx)
 	if err != nil {
 		return false, err
 	}
 
-	hashed := localcrypto.Hash(bytes)
-
 	//create pubkey
 	serializedpub, err := p2pcrypto.ConfigDecodeKey(trx.SenderPubkey)
 	if err != nil {
@@ -195,6 +213,21 @@ func (trxMgr *TrxMgr) SendRegProducerTrx(item *quorumpb.ProducerItem) (string, e
 	return trx.TrxId, nil
 }
 
+func (trxMgr *TrxMgr) SendUpdStakeTrx(item *quorumpb.StakeItem) (string, error) {
+	trxmgr_log.Debugf("<%s> SendUpdStakeTrx called", trxMgr.groupId)
+	encodedcontent, err := proto.Marshal(item)
+	if err != nil {
+		return "", err
+	}
+	trx, err := trxMgr.CreateTrx(quorumpb.TrxType_STAKE, encodedcontent)
+	err = trxMgr.sendTrx(trx)
+	if err != nil {
+		return "INVALID_TRX", err
+	}
+
+	return trx.TrxId, nil
+}
+
 func (trxMgr *TrxMgr) SendAnnounceTrx(item *quorumpb.AnnounceItem) (string, error) {
 	trxmgr_log.Debugf("<%s> SendAnnounceTrx called", trxMgr.groupId)
 	encodedcontent, err := proto.Marshal(item)
@@ -211,6 +244,38 @@ func (trxMgr *TrxMgr) SendAnnounceTrx(item *quorumpb.AnnounceItem) (string, erro
 	return trx.TrxId, nil
 }
 
+func (trxMgr *TrxMgr) SendAnnounceResultTrx(item *quorumpb.AnnounceItem) (string, error) {
+	trxmgr_log.Debugf("<%s> SendAnnounceResultTrx called", trxMgr.groupId)
+	encodedcontent, err := proto.Marshal(item)
+	if err != nil {
+		return "", err
+	}
+
+	trx, err := trxMgr.CreateTrx(quorumpb.TrxType_ANNOUNCE_RESULT, encodedcontent)
+	err = trxMgr.sendTrx(trx)
+	if err != nil {
+		return "INVALID_TRX", err
+	}
+
+	return trx.TrxId, nil
+}
+
+func (trxMgr *TrxMgr) SendUpdRoleTrx(item *quorumpb.RoleItem) (string, error) {
+	trxmgr_log.Debugf("<%s> SendUpdRoleTrx called", trxMgr.groupId)
+	encodedcontent, err := proto.Marshal(item)
+	if err != nil {
+		return "", err
+	}
+
+	trx, err := trxMgr.CreateTrx(quorumpb.TrxType_ROLE, encodedcontent)
+	err = trxMgr.sendTrx(trx)
+	if err != nil {
+		return "INVALID_TRX", err
+	}
+
+	return trx.TrxId, nil
+}
+
 func (trxMgr *TrxMgr) SendUpdSchemaTrx(item *quorumpb.SchemaItem) (string, error) {
 	trxmgr_log.Debugf("<%s> SendUpdSchemaTrx called", trxMgr.groupId)
 	encodedcontent, err := proto.Marshal(item)
@@ -227,7 +292,27 @@ func (trxMgr *TrxMgr) SendUpdSchemaTrx(item *quorumpb.SchemaItem) (string, error
 	return trx.TrxId, nil
 }
 
-func (trxMgr *TrxMgr) SendReqBlockResp(req *quorumpb.ReqBlock, block *quorumpb.Block, result quorumpb.ReqBlkResult) error {
+func (trxMgr *TrxMgr) SendUpdGroupKeyTrx(item *quorumpb.GroupKeyItem) (string, error) {
+	trxmgr_log.Debugf("<%s> SendUpdGroupKeyTrx called", trxMgr.groupId)
+	encodedcontent, err := proto.Marshal(item)
+	if err != nil {
+		return "", err
+	}
+
+	trx, err := trxMgr.CreateTrx(quorumpb.TrxType_GROUP_KEY, encodedcontent)
+	if err != nil {
+		return "INVALID_TRX", err
+	}
+
+	err = trxMgr.sendTrx(trx)
+	if err != nil {
+		return "INVALID_TRX", err
+	}
+
+	return trx.TrxId, nil
+}
+
+func (trxMgr *TrxMgr) SendReqBlockResp(req *quorumpb.ReqBlock, block *quorumpb.Block, result quorumpb.ReqBlkResult, keyEpoch int64) error {
 	trxmgr_log.Debugf("<%s> SendReqBlockResp called", trxMgr.groupId)
 
 	var reqBlockRespItem quorumpb.ReqBlockResp
@@ -249,7 +334,7 @@ func (trxMgr *TrxMgr) SendReqBlockResp(req *quorumpb.ReqBlock, block *quorumpb.B
 	}
 
 	//send ask next block trx out
-	trx, err := trxMgr.CreateTrx(quorumpb.TrxType_REQ_BLOCK_RESP, bItemBytes)
+	trx, err := trxMgr.createRespTrx(quorumpb.TrxType_REQ_BLOCK_RESP, bItemBytes, keyEpoch)
 	if err != nil {
 		trxmgr_log.Warningf(err.Error())
 		return err
@@ -304,6 +389,90 @@ func (trxMgr *TrxMgr) SendReqBlockBackward(block *quorumpb.Block) error {
 	return trxMgr.sendTrx(trx)
 }
 
+func (trxMgr *TrxMgr) SendReqBlockRange(block *quorumpb.Block, providerPubkey string, offset int64, count int64) error {
+	trxmgr_log.Debugf("<%s> SendReqBlockRange called", trxMgr.groupId)
+
+	var reqBlockRangeItem quorumpb.ReqBlockRange
+	reqBlockRangeItem.BlockId = block.BlockId
+	reqBlockRangeItem.GroupId = block.GroupId
+	reqBlockRangeItem.UserId = trxMgr.groupItem.UserSignPubkey
+	reqBlockRangeItem.ProviderPubkey = providerPubkey
+	reqBlockRangeItem.Offset = offset
+	reqBlockRangeItem.Count = count
+
+	bItemBytes, err := proto.Marshal(&reqBlockRangeItem)
+	if err != nil {
+		return err
+	}
+
+	trx, err := trxMgr.CreateTrx(quorumpb.TrxType_REQ_BLOCK_RANGE, bItemBytes)
+	if err != nil {
+		return err
+	}
+
+	return trxMgr.sendTrx(trx)
+}
+
+func (trxMgr *TrxMgr) SendReqBlockRangeResp(req *quorumpb.ReqBlockRange, blocks []*quorumpb.Block, result quorumpb.ReqBlkResult, last bool, keyEpoch int64) error {
+	trxmgr_log.Debugf("<%s> SendReqBlockRangeResp called", trxMgr.groupId)
+
+	var reqBlockRangeRespItem quorumpb.ReqBlockRangeResp
+	reqBlockRangeRespItem.Result = result
+	reqBlockRangeRespItem.ProviderPubkey = trxMgr.groupItem.UserSignPubkey
+	reqBlockRangeRespItem.RequesterPubkey = req.UserId
+	reqBlockRangeRespItem.GroupId = req.GroupId
+	reqBlockRangeRespItem.BlockId = req.BlockId
+	reqBlockRangeRespItem.Offset = req.Offset
+	reqBlockRangeRespItem.Blocks = blocks
+	reqBlockRangeRespItem.Last = last
+
+	bItemBytes, err := proto.Marshal(&reqBlockRangeRespItem)
+	if err != nil {
+		return err
+	}
+
+	trx, err := trxMgr.createRespTrx(quorumpb.TrxType_REQ_BLOCK_RANGE_RESP, bItemBytes, keyEpoch)
+	if err != nil {
+		trxmgr_log.Warningf(err.Error())
+		return err
+	}
+
+	return trxMgr.sendTrx(trx)
+}
+
+func (trxMgr *TrxMgr) SendReqSnapshot() error {
+	trxmgr_log.Debugf("<%s> SendReqSnapshot called", trxMgr.groupId)
+
+	var reqSnapshotItem quorumpb.ReqSnapshot
+	reqSnapshotItem.GroupId = trxMgr.groupId
+	reqSnapshotItem.UserId = trxMgr.groupItem.UserSignPubkey
+
+	bItemBytes, err := proto.Marshal(&reqSnapshotItem)
+	if err != nil {
+		return err
+	}
+
+	trx, err := trxMgr.CreateTrx(quorumpb.TrxType_REQ_SNAPSHOT, bItemBytes)
+	if err != nil {
+		return err
+	}
+
+	return trxMgr.sendTrx(trx)
+}
+
+func (trxMgr *TrxMgr) SendSnapshot(snapshot *quorumpb.Snapshot, keyEpoch int64) error {
+	trxmgr_log.Debugf("<%s> SendSnapshot called", trxMgr.groupId)
+	encodedcontent, err := proto.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+	trx, err := trxMgr.createRespTrx(quorumpb.TrxType_SNAPSHOT, encodedcontent, keyEpoch)
+	if err != nil {
+		return err
+	}
+	return trxMgr.sendTrx(trx)
+}
+
 func (trxMgr *TrxMgr) SendBlockProduced(blk *quorumpb.Block) error {
 	trxmgr_log.Debugf("<%s> SendBlockProduced called", trxMgr.groupId)
 	encodedcontent, err := proto.Marshal(blk)
@@ -317,6 +486,19 @@ func (trxMgr *TrxMgr) SendBlockProduced(blk *quorumpb.Block) error {
 	return trxMgr.sendTrx(trx)
 }
 
+func (trxMgr *TrxMgr) SendBlockVote(vote *quorumpb.BlockVote) error {
+	trxmgr_log.Debugf("<%s> SendBlockVote called", trxMgr.groupId)
+	encodedcontent, err := proto.Marshal(vote)
+	if err != nil {
+		return err
+	}
+	trx, err := trxMgr.CreateTrx(quorumpb.TrxType_BLOCK_VOTE, encodedcontent)
+	if err != nil {
+		return err
+	}
+	return trxMgr.sendTrx(trx)
+}
+
 func (trxMgr *TrxMgr) PostBytes(trxtype quorumpb.TrxType, encodedcontent []byte) (string, error) {
 	trxmgr_log.Debugf("<%s> PostBytes called", trxMgr.groupId)
 	trx, err := trxMgr.CreateTrx(trxtype, encodedcontent)
@@ -345,8 +527,28 @@ func (trxMgr *TrxMgr) PostAny(content proto.Message) (string, error) {
 	return trxMgr.PostBytes(quorumpb.TrxType_POST, encodedcontent)
 }
 
+//resend a trx created by myself, expired time and nonce are refreshed and the trx is signed again,
+//otherwise producers will reject it as expired or replayed
 func (trxMgr *TrxMgr) ResendTrx(trx *quorumpb.Trx) error {
 	trxmgr_log.Debugf("<%s> ResendTrx called", trxMgr.groupId)
+
+	var err error
+	trx.Expired = getExpiredTime()
+	trx.Nonce, err = getNonce()
+	if err != nil {
+		return err
+	}
+
+	hashed, err := getTrxHash(trx)
+	if err != nil {
+		return err
+	}
+
+	err = trxMgr.signTrx(trx, hashed)
+	if err != nil {
+		return err
+	}
+
 	return trxMgr.sendTrx(trx)
 }
 
@@ -397,3 +599,41 @@ func (trxMgr *TrxMgr) sendTrx(trx *quorumpb.Trx) error {
 
 	return trxMgr.psconn.Publish(pkgBytes)
 }
+
+//hash of trx fields covered by SenderSign (ResendCount and SenderSign itself are excluded)
+func getTrxHash(trx *quorumpb.Trx) ([]byte, error) {
+	clonetrxmsg := &quorumpb.Trx{
+		TrxId:        trx.TrxId,
+		Type:         trx.Type,
+		GroupId:      trx.GroupId,
+		SenderPubkey: trx.SenderPubkey,
+		Data:         trx.Data,
+		TimeStamp:    trx.TimeStamp,
+		Version:      trx.Version,
+		Expired:      trx.Expired,
+		Nonce:        trx.Nonce,
+		KeyEpoch:     trx.KeyEpoch}
+
+	bytes, err := proto.Marshal(clonetrxmsg)
+	if err != nil {
+		return nil, err
+	}
+
+	return localcrypto.Hash(bytes), nil
+}
+
+func getExpiredTime() int64 {
+	timein := time.Now().Local().Add(time.Hour*time.Duration(Hours) +
+		time.Minute*time.Duration(Mins) +
+		time.Second*time.Duration(Sec))
+	return timein.UnixNano()
+}
+
+//random positive nonce, used by producers to detect replayed trx
+func getNonce() (int64, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return 0, err
+	}
+	return int64(binary.BigEndian.Uint64(b) >> 1), nil
+}
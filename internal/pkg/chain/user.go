@@ -8,9 +8,13 @@ import (
 type User interface {
 	Init(item *quorumpb.GroupItem, nodename string, iface ChainMolassesIface)
 	UpdAnnounce(item *quorumpb.AnnounceItem) (string, error)
+	UpdAnnounceResult(item *quorumpb.AnnounceItem) (string, error)
 	UpdBlkList(item *quorumpb.DenyUserItem) (string, error)
 	UpdSchema(item *quorumpb.SchemaItem) (string, error)
 	UpdProducer(item *quorumpb.ProducerItem) (string, error)
+	UpdStake(item *quorumpb.StakeItem) (string, error)
+	UpdGroupKey(item *quorumpb.GroupKeyItem) (string, error)
+	UpdRole(item *quorumpb.RoleItem) (string, error)
 	PostToGroup(content proto.Message) (string, error)
 	AddBlock(block *quorumpb.Block) error
 }
@@ -14,21 +14,24 @@ type addrList []maddr.Multiaddr
 type ipList []net.IP
 
 type Config struct {
-	RendezvousString   string
-	BootstrapPeers     addrList
-	ListenAddresses    string
-	SSLCertIPAddresses ipList
-	APIListenAddresses string
-	ProtocolID         string
-	IsBootstrap        bool
-	PeerName           string
-	JsonTracer         string
-	IsDebug            bool
-	ConfigDir          string
-	DataDir            string
-	IsPing             bool
-	KeyStoreDir        string
-	KeyStoreName       string
+	RendezvousString    string
+	BootstrapPeers      addrList
+	ListenAddresses     string
+	SSLCertIPAddresses  ipList
+	APIListenAddresses  string
+	GRPCListenAddresses string
+	ProtocolID          string
+	IsBootstrap         bool
+	PeerName            string
+	JsonTracer          string
+	IsDebug             bool
+	ConfigDir           string
+	DataDir             string
+	IsPing              bool
+	KeyStoreDir         string
+	KeyStoreName        string
+	MigrateDryRun       bool
+	MigrateBackup       bool
 }
 
 func (al *addrList) String() string {
@@ -85,6 +88,7 @@ func ParseFlags() (Config, error) {
 	flag.StringVar(&config.ListenAddresses, "listen", "/ip4/127.0.0.1/tcp/4215", "Adds a multiaddress to the listen list")
 	flag.Var(&config.SSLCertIPAddresses, "ips", "IPAddresses field of x509 certificate")
 	flag.StringVar(&config.APIListenAddresses, "apilisten", ":5215", "Adds a multiaddress to the listen list")
+	flag.StringVar(&config.GRPCListenAddresses, "grpclisten", "", "gRPC api listen address, e.g. :5216, disabled if empty")
 	flag.StringVar(&config.PeerName, "peername", "peer", "peername")
 	flag.StringVar(&config.ConfigDir, "configdir", "./config/", "config and keys dir")
 	flag.StringVar(&config.DataDir, "datadir", "./data/", "config dir")
@@ -94,6 +98,8 @@ func ParseFlags() (Config, error) {
 	flag.BoolVar(&config.IsBootstrap, "bootstrap", false, "run a bootstrap node")
 	flag.BoolVar(&config.IsPing, "ping", false, "ping peer")
 	flag.BoolVar(&config.IsDebug, "debug", false, "show debug log")
+	flag.BoolVar(&config.MigrateDryRun, "migratedryrun", false, "show the pending db migrations and exit")
+	flag.BoolVar(&config.MigrateBackup, "migratebackup", true, "backup the db before migration")
 	flag.Parse()
 
 	configDir, err := filepath.Abs(config.ConfigDir)
@@ -0,0 +1,200 @@
+package eventbus
+
+import (
+	"sync"
+	"time"
+
+	logging "github.com/ipfs/go-log/v2"
+)
+
+var eventbus_log = logging.Logger("eventbus")
+
+type EventType string
+
+const (
+	BLOCK_APPLIED    EventType = "block_applied"    //a block is applied, chain info updated
+	TRX_APPLIED      EventType = "trx_applied"      //a trx packaged in block is applied
+	POST_ADDED       EventType = "post_added"       //a POST is added to group content
+	POST_UPDATED     EventType = "post_updated"     //a POST is edited or deleted, status is "edited" or "deleted"
+	PRODUCER_UPDATED EventType = "producer_updated" //group producer list changed
+	ANNOUNCE_UPDATED EventType = "announce_updated" //user or producer announced, or announced user approved/rejected
+	DENYLIST_UPDATED EventType = "denylist_updated" //group denied list changed
+	SCHEMA_UPDATED   EventType = "schema_updated"   //group schema changed
+	ROLE_UPDATED     EventType = "role_updated"     //group role granted or revoked
+	SYNC_STATUS      EventType = "sync_status"      //group syncer status changed
+	EVENTS_LOST      EventType = "events_lost"      //events after the last seen id are no longer kept, client should refresh
+)
+
+var eventTypes = []EventType{BLOCK_APPLIED, TRX_APPLIED, POST_ADDED, POST_UPDATED, PRODUCER_UPDATED, ANNOUNCE_UPDATED, DENYLIST_UPDATED, SCHEMA_UPDATED, ROLE_UPDATED, SYNC_STATUS}
+
+func IsValidEventType(eventType EventType) bool {
+	for _, item := range eventTypes {
+		if item == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+const EVENT_HISTORY_SIZE int = 1024  //events kept for subscribers to resume
+const SUBSCRIBER_CHAN_SIZE int = 256 //events buffered for a subscriber, slow subscriber is dropped
+const EVENT_ID_EPOCH_BITS = 20       //event ids start from the boot time (seconds) shifted by the bits
+
+type Event struct {
+	Id        uint64    `json:"id"`
+	Type      EventType `json:"type"`
+	GroupId   string    `json:"group_id"`
+	BlockId   string    `json:"block_id,omitempty"`
+	Height    int64     `json:"height,omitempty"`
+	TrxId     string    `json:"trx_id,omitempty"`
+	TrxType   string    `json:"trx_type,omitempty"`
+	Sender    string    `json:"sender,omitempty"`
+	Status    string    `json:"status,omitempty"`
+	TimeStamp int64     `json:"timestamp"`
+}
+
+//Filter selects events by group and type, empty list matches all
+type Filter struct {
+	GroupIds []string
+	Types    []EventType
+}
+
+func (filter *Filter) Match(event *Event) bool {
+	if event.Type == EVENTS_LOST {
+		return true
+	}
+	return matchString(filter.GroupIds, event.GroupId) && matchType(filter.Types, event.Type)
+}
+
+type Subscriber struct {
+	C      chan *Event
+	filter *Filter
+	closed bool
+}
+
+//EventBus publishes events of all groups to subscribers, recent events are kept in a ring,
+//so a reconnected subscriber can resume from the last event id it has seen
+type EventBus struct {
+	lastId      uint64
+	history     []*Event
+	subscribers map[*Subscriber]bool
+	mu          sync.Mutex
+}
+
+var bus *EventBus
+var busOnce sync.Once
+
+func GetEventBus() *EventBus {
+	busOnce.Do(func() {
+		bus = newEventBus(time.Now())
+	})
+	return bus
+}
+
+//newEventBus creates an event bus with ids starting from the boot epoch, so ids seen before a restart are older
+//than all ids after it, unless more than 2^EVENT_ID_EPOCH_BITS events per second were published before the restart
+func newEventBus(boot time.Time) *EventBus {
+	bus := &EventBus{}
+	bus.lastId = uint64(boot.Unix()) << EVENT_ID_EPOCH_BITS
+	bus.subscribers = make(map[*Subscriber]bool)
+	return bus
+}
+
+//Publish sets id and timestamp of the event and sends it to all matched subscribers
+func Publish(event *Event) {
+	GetEventBus().Publish(event)
+}
+
+func (bus *EventBus) Publish(event *Event) {
+	bus.mu.Lock()
+	defer bus.mu.Unlock()
+
+	bus.lastId++
+	event.Id = bus.lastId
+	if event.TimeStamp == 0 {
+		event.TimeStamp = time.Now().UnixNano()
+	}
+
+	bus.history = append(bus.history, event)
+	if len(bus.history) > EVENT_HISTORY_SIZE {
+		bus.history = bus.history[len(bus.history)-EVENT_HISTORY_SIZE:]
+	}
+
+	for sub := range bus.subscribers {
+		if !sub.filter.Match(event) {
+			continue
+		}
+		select {
+		case sub.C <- event:
+		default:
+			//never block the publisher (chain), drop the slow subscriber
+			eventbus_log.Warningf("subscriber is too slow, drop it")
+			bus.unsubscribe(sub)
+		}
+	}
+}
+
+//Subscribe returns a subscriber receiving events after lastId (0 for new events only),
+//an EVENTS_LOST event is sent first if events after lastId are no longer kept, or lastId is not
+//published by this bus (node restarted with a clock going back)
+func (bus *EventBus) Subscribe(filter *Filter, lastId uint64) *Subscriber {
+	bus.mu.Lock()
+	defer bus.mu.Unlock()
+
+	sub := &Subscriber{C: make(chan *Event, SUBSCRIBER_CHAN_SIZE+EVENT_HISTORY_SIZE), filter: filter}
+	if lastId > bus.lastId {
+		//resume from the latest event of this bus
+		sub.C <- &Event{Id: bus.lastId, Type: EVENTS_LOST, TimeStamp: time.Now().UnixNano()}
+	} else if lastId > 0 && lastId < bus.lastId {
+		if len(bus.history) == 0 || bus.history[0].Id > lastId+1 {
+			sub.C <- &Event{Id: lastId, Type: EVENTS_LOST, TimeStamp: time.Now().UnixNano()}
+		}
+		for _, event := range bus.history {
+			if event.Id > lastId && filter.Match(event) {
+				sub.C <- event
+			}
+		}
+	}
+
+	bus.subscribers[sub] = true
+	return sub
+}
+
+func (bus *EventBus) Unsubscribe(sub *Subscriber) {
+	bus.mu.Lock()
+	defer bus.mu.Unlock()
+	bus.unsubscribe(sub)
+}
+
+func (bus *EventBus) unsubscribe(sub *Subscriber) {
+	if sub.closed {
+		return
+	}
+	sub.closed = true
+	delete(bus.subscribers, sub)
+	close(sub.C)
+}
+
+func matchString(list []string, value string) bool {
+	if len(list) == 0 {
+		return true
+	}
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+func matchType(list []EventType, value EventType) bool {
+	if len(list) == 0 {
+		return true
+	}
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,75 @@
+package eventbus
+
+import (
+	"testing"
+	"time"
+)
+
+func publishTestEvents(bus *EventBus, count int) uint64 {
+	for i := 0; i < count; i++ {
+		bus.Publish(&Event{Type: POST_ADDED, GroupId: "group"})
+	}
+	return bus.lastId
+}
+
+//receiveTestEvents returns the events already sent to the subscriber
+func receiveTestEvents(sub *Subscriber) []*Event {
+	var events []*Event
+	for {
+		select {
+		case event := <-sub.C:
+			events = append(events, event)
+		default:
+			return events
+		}
+	}
+}
+
+func TestSubscribeResume(t *testing.T) {
+	bus := newEventBus(time.Now())
+	lastId := publishTestEvents(bus, 5)
+	publishTestEvents(bus, 3)
+
+	events := receiveTestEvents(bus.Subscribe(&Filter{}, lastId))
+	if len(events) != 3 {
+		t.Fatalf("got %d events, want 3", len(events))
+	}
+	for i, event := range events {
+		if event.Type == EVENTS_LOST || event.Id != lastId+uint64(i)+1 {
+			t.Errorf("got event %d %s at %d, want %d", event.Id, event.Type, i, lastId+uint64(i)+1)
+		}
+	}
+
+	//the latest id resumes without events
+	if events := receiveTestEvents(bus.Subscribe(&Filter{}, bus.lastId)); len(events) != 0 {
+		t.Errorf("got %d events after the latest id", len(events))
+	}
+}
+
+func TestSubscribeEventsLost(t *testing.T) {
+	boot := time.Now()
+	bus := newEventBus(boot)
+	firstId := publishTestEvents(bus, 1)
+	lastId := publishTestEvents(bus, EVENT_HISTORY_SIZE+1)
+
+	//the event after firstId is out of the history
+	events := receiveTestEvents(bus.Subscribe(&Filter{}, firstId))
+	if len(events) != EVENT_HISTORY_SIZE+1 || events[0].Type != EVENTS_LOST {
+		t.Errorf("got %d events, want EVENTS_LOST and %d events", len(events), EVENT_HISTORY_SIZE)
+	}
+
+	//node restarted, ids seen before are older than the new ones
+	restarted := newEventBus(boot.Add(time.Second))
+	publishTestEvents(restarted, 2)
+	if events := receiveTestEvents(restarted.Subscribe(&Filter{}, lastId)); len(events) != 3 || events[0].Type != EVENTS_LOST {
+		t.Errorf("got %d events after restarted, want EVENTS_LOST and 2 events", len(events))
+	}
+
+	//node restarted with a clock going back, id seen before is newer than the bus
+	restarted = newEventBus(boot.Add(-time.Hour))
+	publishTestEvents(restarted, 2)
+	events = receiveTestEvents(restarted.Subscribe(&Filter{}, lastId))
+	if len(events) != 1 || events[0].Type != EVENTS_LOST || events[0].Id != restarted.lastId {
+		t.Errorf("got %d events after restarted with an old clock, want EVENTS_LOST of the latest id only", len(events))
+	}
+}
@@ -15,5 +15,17 @@ type NodeOptions struct {
 	JWTToken         string
 	JWTKey           string
 	SignKeyMap       map[string]string
+	APITokens        []*APIToken
+	RevokedTokens    []string
 	mu               sync.RWMutex
 }
+
+//APIToken is the record of a token issued by /app/api/v1/token, the token string is not saved
+type APIToken struct {
+	Id        string
+	Name      string
+	Scopes    []string
+	Groups    []string
+	IssuedAt  int64
+	ExpiresAt int64
+}
@@ -40,9 +40,27 @@ func (opt *NodeOptions) writeToconfig() error {
 	v.Set("SignKeyMap", opt.SignKeyMap)
 	v.Set("JWTKey", opt.JWTKey)
 	v.Set("JWTToken", opt.JWTToken)
+	v.Set("APITokens", apiTokensToconfig(opt.APITokens))
+	v.Set("RevokedTokens", opt.RevokedTokens)
 	return v.WriteConfig()
 }
 
+//apiTokensToconfig converts the tokens to maps, the toml writer of viper does not accept structs
+func apiTokensToconfig(tokens []*APIToken) []map[string]interface{} {
+	items := []map[string]interface{}{}
+	for _, t := range tokens {
+		items = append(items, map[string]interface{}{
+			"Id":        t.Id,
+			"Name":      t.Name,
+			"Scopes":    t.Scopes,
+			"Groups":    t.Groups,
+			"IssuedAt":  t.IssuedAt,
+			"ExpiresAt": t.ExpiresAt,
+		})
+	}
+	return items
+}
+
 func (opt *NodeOptions) SetJWTKey(jwtKey string) error {
 	opt.mu.Lock()
 	defer opt.mu.Unlock()
@@ -64,6 +82,63 @@ func (opt *NodeOptions) SetSignKeyMap(keyname, addr string) error {
 	return opt.writeToconfig()
 }
 
+//AddAPIToken saves a new issued token or updates the expiry of an existed one
+func (opt *NodeOptions) AddAPIToken(token *APIToken) error {
+	opt.mu.Lock()
+	defer opt.mu.Unlock()
+	for i, t := range opt.APITokens {
+		if t.Id == token.Id {
+			opt.APITokens[i] = token
+			return opt.writeToconfig()
+		}
+	}
+	opt.APITokens = append(opt.APITokens, token)
+	return opt.writeToconfig()
+}
+
+func (opt *NodeOptions) GetAPIToken(id string) *APIToken {
+	opt.mu.RLock()
+	defer opt.mu.RUnlock()
+	for _, t := range opt.APITokens {
+		if t.Id == id {
+			return t
+		}
+	}
+	return nil
+}
+
+func (opt *NodeOptions) GetAPITokens() []*APIToken {
+	opt.mu.RLock()
+	defer opt.mu.RUnlock()
+	tokens := make([]*APIToken, len(opt.APITokens))
+	copy(tokens, opt.APITokens)
+	return tokens
+}
+
+//RevokeAPIToken adds the token id to the revocation list, the token is rejected from now on
+func (opt *NodeOptions) RevokeAPIToken(id string) error {
+	opt.mu.Lock()
+	defer opt.mu.Unlock()
+	for _, revoked := range opt.RevokedTokens {
+		if revoked == id {
+			return nil
+		}
+	}
+	opt.RevokedTokens = append(opt.RevokedTokens, id)
+	return opt.writeToconfig()
+}
+
+func (opt *NodeOptions) IsTokenRevoked(id string) bool {
+	opt.mu.RLock()
+	defer opt.mu.RUnlock()
+	for _, revoked := range opt.RevokedTokens {
+		if revoked == id {
+			return true
+		}
+	}
+	return false
+}
+
 func writeDefaultToconfig(v *viper.Viper) error {
 	v.Set("EnableNat", true)
 	v.Set("EnableDevNetwork", false)
@@ -71,6 +146,8 @@ func writeDefaultToconfig(v *viper.Viper) error {
 	v.Set("JWTKey", utils.GetRandomStr(JWTKeyLength))
 	v.Set("JWTToken", "")
 	v.Set("SignKeyMap", map[string]string{})
+	v.Set("APITokens", []map[string]interface{}{})
+	v.Set("RevokedTokens", []string{})
 	return v.SafeWriteConfig()
 }
 
@@ -126,5 +203,9 @@ func load(dir string, keyname string) (*NodeOptions, error) {
 	options.SignKeyMap = v.GetStringMapString("SignKeyMap")
 	options.JWTKey = v.GetString("JWTKey")
 	options.JWTToken = v.GetString("JWTToken")
+	if err := v.UnmarshalKey("APITokens", &options.APITokens); err != nil {
+		return nil, err
+	}
+	options.RevokedTokens = v.GetStringSlice("RevokedTokens")
 	return options, nil
 }
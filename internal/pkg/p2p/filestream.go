@@ -0,0 +1,107 @@
+package p2p
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	logging "github.com/ipfs/go-log/v2"
+	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+)
+
+var filelog = logging.Logger("filestream")
+
+const FileID = "/quorum/file/1.0.0"
+
+const fileStreamTimeout = time.Second * 30
+
+//FileRequestHandler handles a request frame and returns the response frame
+type FileRequestHandler func(req []byte) ([]byte, error)
+
+//FileService serves file chunks to peers over a direct stream, one request and one response per stream
+type FileService struct {
+	Host    host.Host
+	handler FileRequestHandler
+	mu      sync.RWMutex
+}
+
+func NewFileService(h host.Host) *FileService {
+	fs := &FileService{Host: h}
+	h.SetStreamHandler(FileID, fs.FileHandler)
+	return fs
+}
+
+func (fs *FileService) SetRequestHandler(handler FileRequestHandler) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	fs.handler = handler
+}
+
+func (fs *FileService) FileHandler(s network.Stream) {
+	defer s.Close()
+	s.SetDeadline(time.Now().Add(fileStreamTimeout))
+
+	fs.mu.RLock()
+	handler := fs.handler
+	fs.mu.RUnlock()
+
+	if handler == nil {
+		filelog.Debug("no file request handler, reset stream")
+		s.Reset()
+		return
+	}
+
+	req, err := ReadFrame(bufio.NewReader(s))
+	if err != nil {
+		filelog.Debug(err)
+		s.Reset()
+		return
+	}
+
+	resp, err := handler(req)
+	if err != nil {
+		filelog.Debugf("handle file request from <%s> failed: %s", s.Conn().RemotePeer(), err)
+		s.Reset()
+		return
+	}
+	if err := WriteFrame(s, resp); err != nil {
+		filelog.Debugf("write file response to <%s> failed: %s", s.Conn().RemotePeer(), err)
+		s.Reset()
+	}
+}
+
+//Request sends a request frame to the peer and returns the response frame
+func (fs *FileService) Request(ctx context.Context, p peer.ID, req []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, fileStreamTimeout)
+	defer cancel()
+
+	s, err := fs.Host.NewStream(ctx, p, FileID)
+	if err != nil {
+		return nil, err
+	}
+	defer s.Close()
+	s.SetDeadline(time.Now().Add(fileStreamTimeout))
+
+	if err := WriteFrame(s, req); err != nil {
+		s.Reset()
+		return nil, err
+	}
+	if err := s.CloseWrite(); err != nil {
+		s.Reset()
+		return nil, err
+	}
+
+	resp, err := ReadFrame(bufio.NewReader(s))
+	if err != nil {
+		s.Reset()
+		return nil, err
+	}
+	if len(resp) == 0 {
+		return nil, errors.New("empty file response")
+	}
+	return resp, nil
+}
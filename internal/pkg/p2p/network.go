@@ -30,6 +30,8 @@ type Node struct {
 	Ddht             *dual.DHT
 	Info             *NodeInfo
 	RoutingDiscovery *discovery.RoutingDiscovery
+	SyncService      *SyncService
+	FileService      *FileService
 }
 
 func (node *Node) eventhandler(ctx context.Context) {
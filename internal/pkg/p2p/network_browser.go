@@ -72,6 +72,10 @@ func NewBrowserNode(ctx context.Context, nodeOpt *options.NodeOptions, key *ethk
 	// configure our own ping protocol
 	pingService := &PingService{Host: host}
 	host.SetStreamHandler(PingID, pingService.PingHandler)
+	// block sync over direct stream
+	syncService := NewSyncService(host)
+	// file chunks over direct stream
+	fileService := NewFileService(host)
 	options := []pubsub.Option{pubsub.WithPeerExchange(true)}
 
 	networklog.Infof("Network Name %s", nodeNetwork)
@@ -104,7 +108,7 @@ func NewBrowserNode(ctx context.Context, nodeOpt *options.NodeOptions, key *ethk
 	psPing.EnablePing()
 	info := &NodeInfo{NATType: network.ReachabilityUnknown}
 
-	newNode := &Node{NetworkName: nodeNetwork, Host: host, Pubsub: ps, Ddht: ddht, RoutingDiscovery: routingDiscovery, Info: info}
+	newNode := &Node{NetworkName: nodeNetwork, Host: host, Pubsub: ps, Ddht: ddht, RoutingDiscovery: routingDiscovery, Info: info, SyncService: syncService, FileService: fileService}
 
 	// TODO: store peers and reconnect them
 
@@ -98,6 +98,10 @@ func NewNode(ctx context.Context, nodeopt *options.NodeOptions, isBootstrap bool
 	// configure our own ping protocol
 	pingService := &PingService{Host: host}
 	host.SetStreamHandler(PingID, pingService.PingHandler)
+	// block sync over direct stream
+	syncService := NewSyncService(host)
+	// file chunks over direct stream
+	fileService := NewFileService(host)
 	options := []pubsub.Option{pubsub.WithPeerExchange(true)}
 
 	networklog.Infof("Network Name %s", nodenetworkname)
@@ -146,7 +150,7 @@ func NewNode(ctx context.Context, nodeopt *options.NodeOptions, isBootstrap bool
 	psping.EnablePing()
 	info := &NodeInfo{NATType: network.ReachabilityUnknown}
 
-	newnode := &Node{NetworkName: nodenetworkname, Host: host, Pubsub: ps, Ddht: ddht, RoutingDiscovery: routingDiscovery, Info: info}
+	newnode := &Node{NetworkName: nodenetworkname, Host: host, Pubsub: ps, Ddht: ddht, RoutingDiscovery: routingDiscovery, Info: info, SyncService: syncService, FileService: fileService}
 
 	//reconnect peers
 
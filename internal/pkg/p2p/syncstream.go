@@ -0,0 +1,161 @@
+package p2p
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	logging "github.com/ipfs/go-log/v2"
+	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+)
+
+var synclog = logging.Logger("syncstream")
+
+const SyncID = "/quorum/sync/1.0.0"
+
+const syncStreamTimeout = time.Second * 60
+
+const MaxSyncFrameSize = 4 * 1024 * 1024
+
+//SyncRequestHandler handles a request frame, response frames are written by respond,
+//the stream is closed after the handler returns
+type SyncRequestHandler func(req []byte, respond func(resp []byte) error) error
+
+//SyncService serves request/response block sync between two peers over a direct stream
+type SyncService struct {
+	Host    host.Host
+	handler SyncRequestHandler
+	peers   map[string]peer.ID //provider pubkey -> peer
+	mu      sync.RWMutex
+}
+
+func NewSyncService(h host.Host) *SyncService {
+	ss := &SyncService{Host: h, peers: make(map[string]peer.ID)}
+	h.SetStreamHandler(SyncID, ss.SyncHandler)
+	return ss
+}
+
+func (ss *SyncService) SetRequestHandler(handler SyncRequestHandler) {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	ss.handler = handler
+}
+
+func (ss *SyncService) SyncHandler(s network.Stream) {
+	defer s.Close()
+	s.SetDeadline(time.Now().Add(syncStreamTimeout))
+
+	ss.mu.RLock()
+	handler := ss.handler
+	ss.mu.RUnlock()
+
+	if handler == nil {
+		synclog.Debug("no sync request handler, reset stream")
+		s.Reset()
+		return
+	}
+
+	req, err := ReadFrame(bufio.NewReader(s))
+	if err != nil {
+		synclog.Debug(err)
+		s.Reset()
+		return
+	}
+
+	err = handler(req, func(resp []byte) error {
+		return WriteFrame(s, resp)
+	})
+	if err != nil {
+		synclog.Debugf("handle sync request from <%s> failed: %s", s.Conn().RemotePeer(), err)
+	}
+}
+
+//Request sends a request frame to the peer and calls onResp for each response frame until the peer closes the stream
+func (ss *SyncService) Request(ctx context.Context, p peer.ID, req []byte, onResp func(resp []byte) error) error {
+	ctx, cancel := context.WithTimeout(ctx, syncStreamTimeout)
+	defer cancel()
+
+	s, err := ss.Host.NewStream(ctx, p, SyncID)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+	s.SetDeadline(time.Now().Add(syncStreamTimeout))
+
+	if err := WriteFrame(s, req); err != nil {
+		s.Reset()
+		return err
+	}
+	if err := s.CloseWrite(); err != nil {
+		s.Reset()
+		return err
+	}
+
+	reader := bufio.NewReader(s)
+	for {
+		resp, err := ReadFrame(reader)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			s.Reset()
+			return err
+		}
+		if err := onResp(resp); err != nil {
+			synclog.Debugf("handle sync response from <%s> failed: %s", p, err)
+		}
+	}
+}
+
+func (ss *SyncService) AddProviderPeer(pubkey string, p peer.ID) {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	ss.peers[pubkey] = p
+}
+
+func (ss *SyncService) RemoveProviderPeer(pubkey string) {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	delete(ss.peers, pubkey)
+}
+
+func (ss *SyncService) GetProviderPeer(pubkey string) (peer.ID, bool) {
+	ss.mu.RLock()
+	defer ss.mu.RUnlock()
+	p, ok := ss.peers[pubkey]
+	return p, ok
+}
+
+//WriteFrame writes an uvarint length prefixed frame
+func WriteFrame(w io.Writer, data []byte) error {
+	if len(data) > MaxSyncFrameSize {
+		return errors.New("sync frame too large")
+	}
+	buf := make([]byte, binary.MaxVarintLen64+len(data))
+	n := binary.PutUvarint(buf, uint64(len(data)))
+	n += copy(buf[n:], data)
+	_, err := w.Write(buf[:n])
+	return err
+}
+
+//ReadFrame reads an uvarint length prefixed frame, io.EOF is returned if no more frame
+func ReadFrame(r *bufio.Reader) ([]byte, error) {
+	length, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	if length > MaxSyncFrameSize {
+		return nil, errors.New("sync frame too large")
+	}
+	data := make([]byte, length)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
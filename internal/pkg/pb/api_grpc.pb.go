@@ -0,0 +1,1082 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// QuorumApiClient is the client API for QuorumApi service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QuorumApiClient interface {
+	//groups
+	CreateGroup(ctx context.Context, in *CreateGroupParam, opts ...grpc.CallOption) (*CreateGroupResult, error)
+	JoinGroup(ctx context.Context, in *JoinGroupParam, opts ...grpc.CallOption) (*JoinGroupResult, error)
+	LeaveGroup(ctx context.Context, in *LeaveGroupParam, opts ...grpc.CallOption) (*LeaveGroupResult, error)
+	ClearGroupData(ctx context.Context, in *ClearGroupDataParam, opts ...grpc.CallOption) (*ClearGroupDataResult, error)
+	GetGroups(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GroupInfoList, error)
+	StartSync(ctx context.Context, in *GroupParam, opts ...grpc.CallOption) (*StartSyncResult, error)
+	//content
+	PostToGroup(ctx context.Context, in *Activity, opts ...grpc.CallOption) (*TrxResult, error)
+	UpdateProfile(ctx context.Context, in *Activity, opts ...grpc.CallOption) (*TrxResult, error)
+	GetGroupContent(ctx context.Context, in *GroupContentParam, opts ...grpc.CallOption) (*GroupContentList, error)
+	StreamGroupContent(ctx context.Context, in *GroupParam, opts ...grpc.CallOption) (QuorumApi_StreamGroupContentClient, error)
+	//blocks and trxs
+	GetBlock(ctx context.Context, in *BlockParam, opts ...grpc.CallOption) (*Block, error)
+	GetTrx(ctx context.Context, in *TrxParam, opts ...grpc.CallOption) (*Trx, error)
+	//producers
+	UpdateProducer(ctx context.Context, in *GrpProducerParam, opts ...grpc.CallOption) (*GrpProducerResult, error)
+	GetProducers(ctx context.Context, in *GroupParam, opts ...grpc.CallOption) (*ProducerList, error)
+	//announce
+	Announce(ctx context.Context, in *AnnounceParam, opts ...grpc.CallOption) (*AnnounceResult, error)
+	GetAnnouncedUsers(ctx context.Context, in *GroupParam, opts ...grpc.CallOption) (*AnnouncedUserList, error)
+	GetAnnouncedProducers(ctx context.Context, in *GroupParam, opts ...grpc.CallOption) (*AnnouncedProducerList, error)
+	ApproveAnnouncedUser(ctx context.Context, in *AnnouncedUserParam, opts ...grpc.CallOption) (*AnnounceResultResult, error)
+	RejectAnnouncedUser(ctx context.Context, in *AnnouncedUserParam, opts ...grpc.CallOption) (*AnnounceResultResult, error)
+	//deny list
+	UpdateDeniedList(ctx context.Context, in *DenyListParam, opts ...grpc.CallOption) (*DenyUserResult, error)
+	GetDeniedList(ctx context.Context, in *GroupParam, opts ...grpc.CallOption) (*DeniedUserList, error)
+	//schema
+	UpdateSchema(ctx context.Context, in *SchemaParam, opts ...grpc.CallOption) (*SchemaResult, error)
+	GetSchema(ctx context.Context, in *GroupParam, opts ...grpc.CallOption) (*SchemaList, error)
+	//network
+	GetNodeInfo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*NodeInfo, error)
+	GetNetwork(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*NetworkInfo, error)
+	AddPeers(ctx context.Context, in *AddPeersParam, opts ...grpc.CallOption) (*AddPeerResult, error)
+	PSPing(ctx context.Context, in *PSPingParam, opts ...grpc.CallOption) (*PingResult, error)
+}
+
+type quorumApiClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQuorumApiClient(cc grpc.ClientConnInterface) QuorumApiClient {
+	return &quorumApiClient{cc}
+}
+
+func (c *quorumApiClient) CreateGroup(ctx context.Context, in *CreateGroupParam, opts ...grpc.CallOption) (*CreateGroupResult, error) {
+	out := new(CreateGroupResult)
+	err := c.cc.Invoke(ctx, "/quorum.pb.QuorumApi/CreateGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quorumApiClient) JoinGroup(ctx context.Context, in *JoinGroupParam, opts ...grpc.CallOption) (*JoinGroupResult, error) {
+	out := new(JoinGroupResult)
+	err := c.cc.Invoke(ctx, "/quorum.pb.QuorumApi/JoinGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quorumApiClient) LeaveGroup(ctx context.Context, in *LeaveGroupParam, opts ...grpc.CallOption) (*LeaveGroupResult, error) {
+	out := new(LeaveGroupResult)
+	err := c.cc.Invoke(ctx, "/quorum.pb.QuorumApi/LeaveGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quorumApiClient) ClearGroupData(ctx context.Context, in *ClearGroupDataParam, opts ...grpc.CallOption) (*ClearGroupDataResult, error) {
+	out := new(ClearGroupDataResult)
+	err := c.cc.Invoke(ctx, "/quorum.pb.QuorumApi/ClearGroupData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quorumApiClient) GetGroups(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GroupInfoList, error) {
+	out := new(GroupInfoList)
+	err := c.cc.Invoke(ctx, "/quorum.pb.QuorumApi/GetGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quorumApiClient) StartSync(ctx context.Context, in *GroupParam, opts ...grpc.CallOption) (*StartSyncResult, error) {
+	out := new(StartSyncResult)
+	err := c.cc.Invoke(ctx, "/quorum.pb.QuorumApi/StartSync", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quorumApiClient) PostToGroup(ctx context.Context, in *Activity, opts ...grpc.CallOption) (*TrxResult, error) {
+	out := new(TrxResult)
+	err := c.cc.Invoke(ctx, "/quorum.pb.QuorumApi/PostToGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quorumApiClient) UpdateProfile(ctx context.Context, in *Activity, opts ...grpc.CallOption) (*TrxResult, error) {
+	out := new(TrxResult)
+	err := c.cc.Invoke(ctx, "/quorum.pb.QuorumApi/UpdateProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quorumApiClient) GetGroupContent(ctx context.Context, in *GroupContentParam, opts ...grpc.CallOption) (*GroupContentList, error) {
+	out := new(GroupContentList)
+	err := c.cc.Invoke(ctx, "/quorum.pb.QuorumApi/GetGroupContent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quorumApiClient) StreamGroupContent(ctx context.Context, in *GroupParam, opts ...grpc.CallOption) (QuorumApi_StreamGroupContentClient, error) {
+	stream, err := c.cc.NewStream(ctx, &QuorumApi_ServiceDesc.Streams[0], "/quorum.pb.QuorumApi/StreamGroupContent", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &quorumApiStreamGroupContentClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type QuorumApi_StreamGroupContentClient interface {
+	Recv() (*GroupContentObjectItem, error)
+	grpc.ClientStream
+}
+
+type quorumApiStreamGroupContentClient struct {
+	grpc.ClientStream
+}
+
+func (x *quorumApiStreamGroupContentClient) Recv() (*GroupContentObjectItem, error) {
+	m := new(GroupContentObjectItem)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *quorumApiClient) GetBlock(ctx context.Context, in *BlockParam, opts ...grpc.CallOption) (*Block, error) {
+	out := new(Block)
+	err := c.cc.Invoke(ctx, "/quorum.pb.QuorumApi/GetBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quorumApiClient) GetTrx(ctx context.Context, in *TrxParam, opts ...grpc.CallOption) (*Trx, error) {
+	out := new(Trx)
+	err := c.cc.Invoke(ctx, "/quorum.pb.QuorumApi/GetTrx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quorumApiClient) UpdateProducer(ctx context.Context, in *GrpProducerParam, opts ...grpc.CallOption) (*GrpProducerResult, error) {
+	out := new(GrpProducerResult)
+	err := c.cc.Invoke(ctx, "/quorum.pb.QuorumApi/UpdateProducer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quorumApiClient) GetProducers(ctx context.Context, in *GroupParam, opts ...grpc.CallOption) (*ProducerList, error) {
+	out := new(ProducerList)
+	err := c.cc.Invoke(ctx, "/quorum.pb.QuorumApi/GetProducers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quorumApiClient) Announce(ctx context.Context, in *AnnounceParam, opts ...grpc.CallOption) (*AnnounceResult, error) {
+	out := new(AnnounceResult)
+	err := c.cc.Invoke(ctx, "/quorum.pb.QuorumApi/Announce", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quorumApiClient) GetAnnouncedUsers(ctx context.Context, in *GroupParam, opts ...grpc.CallOption) (*AnnouncedUserList, error) {
+	out := new(AnnouncedUserList)
+	err := c.cc.Invoke(ctx, "/quorum.pb.QuorumApi/GetAnnouncedUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quorumApiClient) GetAnnouncedProducers(ctx context.Context, in *GroupParam, opts ...grpc.CallOption) (*AnnouncedProducerList, error) {
+	out := new(AnnouncedProducerList)
+	err := c.cc.Invoke(ctx, "/quorum.pb.QuorumApi/GetAnnouncedProducers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quorumApiClient) ApproveAnnouncedUser(ctx context.Context, in *AnnouncedUserParam, opts ...grpc.CallOption) (*AnnounceResultResult, error) {
+	out := new(AnnounceResultResult)
+	err := c.cc.Invoke(ctx, "/quorum.pb.QuorumApi/ApproveAnnouncedUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quorumApiClient) RejectAnnouncedUser(ctx context.Context, in *AnnouncedUserParam, opts ...grpc.CallOption) (*AnnounceResultResult, error) {
+	out := new(AnnounceResultResult)
+	err := c.cc.Invoke(ctx, "/quorum.pb.QuorumApi/RejectAnnouncedUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quorumApiClient) UpdateDeniedList(ctx context.Context, in *DenyListParam, opts ...grpc.CallOption) (*DenyUserResult, error) {
+	out := new(DenyUserResult)
+	err := c.cc.Invoke(ctx, "/quorum.pb.QuorumApi/UpdateDeniedList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quorumApiClient) GetDeniedList(ctx context.Context, in *GroupParam, opts ...grpc.CallOption) (*DeniedUserList, error) {
+	out := new(DeniedUserList)
+	err := c.cc.Invoke(ctx, "/quorum.pb.QuorumApi/GetDeniedList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quorumApiClient) UpdateSchema(ctx context.Context, in *SchemaParam, opts ...grpc.CallOption) (*SchemaResult, error) {
+	out := new(SchemaResult)
+	err := c.cc.Invoke(ctx, "/quorum.pb.QuorumApi/UpdateSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quorumApiClient) GetSchema(ctx context.Context, in *GroupParam, opts ...grpc.CallOption) (*SchemaList, error) {
+	out := new(SchemaList)
+	err := c.cc.Invoke(ctx, "/quorum.pb.QuorumApi/GetSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quorumApiClient) GetNodeInfo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*NodeInfo, error) {
+	out := new(NodeInfo)
+	err := c.cc.Invoke(ctx, "/quorum.pb.QuorumApi/GetNodeInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quorumApiClient) GetNetwork(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*NetworkInfo, error) {
+	out := new(NetworkInfo)
+	err := c.cc.Invoke(ctx, "/quorum.pb.QuorumApi/GetNetwork", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quorumApiClient) AddPeers(ctx context.Context, in *AddPeersParam, opts ...grpc.CallOption) (*AddPeerResult, error) {
+	out := new(AddPeerResult)
+	err := c.cc.Invoke(ctx, "/quorum.pb.QuorumApi/AddPeers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quorumApiClient) PSPing(ctx context.Context, in *PSPingParam, opts ...grpc.CallOption) (*PingResult, error) {
+	out := new(PingResult)
+	err := c.cc.Invoke(ctx, "/quorum.pb.QuorumApi/PSPing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QuorumApiServer is the server API for QuorumApi service.
+// All implementations must embed UnimplementedQuorumApiServer
+// for forward compatibility
+type QuorumApiServer interface {
+	//groups
+	CreateGroup(context.Context, *CreateGroupParam) (*CreateGroupResult, error)
+	JoinGroup(context.Context, *JoinGroupParam) (*JoinGroupResult, error)
+	LeaveGroup(context.Context, *LeaveGroupParam) (*LeaveGroupResult, error)
+	ClearGroupData(context.Context, *ClearGroupDataParam) (*ClearGroupDataResult, error)
+	GetGroups(context.Context, *emptypb.Empty) (*GroupInfoList, error)
+	StartSync(context.Context, *GroupParam) (*StartSyncResult, error)
+	//content
+	PostToGroup(context.Context, *Activity) (*TrxResult, error)
+	UpdateProfile(context.Context, *Activity) (*TrxResult, error)
+	GetGroupContent(context.Context, *GroupContentParam) (*GroupContentList, error)
+	StreamGroupContent(*GroupParam, QuorumApi_StreamGroupContentServer) error
+	//blocks and trxs
+	GetBlock(context.Context, *BlockParam) (*Block, error)
+	GetTrx(context.Context, *TrxParam) (*Trx, error)
+	//producers
+	UpdateProducer(context.Context, *GrpProducerParam) (*GrpProducerResult, error)
+	GetProducers(context.Context, *GroupParam) (*ProducerList, error)
+	//announce
+	Announce(context.Context, *AnnounceParam) (*AnnounceResult, error)
+	GetAnnouncedUsers(context.Context, *GroupParam) (*AnnouncedUserList, error)
+	GetAnnouncedProducers(context.Context, *GroupParam) (*AnnouncedProducerList, error)
+	ApproveAnnouncedUser(context.Context, *AnnouncedUserParam) (*AnnounceResultResult, error)
+	RejectAnnouncedUser(context.Context, *AnnouncedUserParam) (*AnnounceResultResult, error)
+	//deny list
+	UpdateDeniedList(context.Context, *DenyListParam) (*DenyUserResult, error)
+	GetDeniedList(context.Context, *GroupParam) (*DeniedUserList, error)
+	//schema
+	UpdateSchema(context.Context, *SchemaParam) (*SchemaResult, error)
+	GetSchema(context.Context, *GroupParam) (*SchemaList, error)
+	//network
+	GetNodeInfo(context.Context, *emptypb.Empty) (*NodeInfo, error)
+	GetNetwork(context.Context, *emptypb.Empty) (*NetworkInfo, error)
+	AddPeers(context.Context, *AddPeersParam) (*AddPeerResult, error)
+	PSPing(context.Context, *PSPingParam) (*PingResult, error)
+	mustEmbedUnimplementedQuorumApiServer()
+}
+
+// UnimplementedQuorumApiServer must be embedded to have forward compatible implementations.
+type UnimplementedQuorumApiServer struct {
+}
+
+func (UnimplementedQuorumApiServer) CreateGroup(context.Context, *CreateGroupParam) (*CreateGroupResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroup not implemented")
+}
+func (UnimplementedQuorumApiServer) JoinGroup(context.Context, *JoinGroupParam) (*JoinGroupResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinGroup not implemented")
+}
+func (UnimplementedQuorumApiServer) LeaveGroup(context.Context, *LeaveGroupParam) (*LeaveGroupResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveGroup not implemented")
+}
+func (UnimplementedQuorumApiServer) ClearGroupData(context.Context, *ClearGroupDataParam) (*ClearGroupDataResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearGroupData not implemented")
+}
+func (UnimplementedQuorumApiServer) GetGroups(context.Context, *emptypb.Empty) (*GroupInfoList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroups not implemented")
+}
+func (UnimplementedQuorumApiServer) StartSync(context.Context, *GroupParam) (*StartSyncResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartSync not implemented")
+}
+func (UnimplementedQuorumApiServer) PostToGroup(context.Context, *Activity) (*TrxResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostToGroup not implemented")
+}
+func (UnimplementedQuorumApiServer) UpdateProfile(context.Context, *Activity) (*TrxResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProfile not implemented")
+}
+func (UnimplementedQuorumApiServer) GetGroupContent(context.Context, *GroupContentParam) (*GroupContentList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupContent not implemented")
+}
+func (UnimplementedQuorumApiServer) StreamGroupContent(*GroupParam, QuorumApi_StreamGroupContentServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamGroupContent not implemented")
+}
+func (UnimplementedQuorumApiServer) GetBlock(context.Context, *BlockParam) (*Block, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlock not implemented")
+}
+func (UnimplementedQuorumApiServer) GetTrx(context.Context, *TrxParam) (*Trx, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrx not implemented")
+}
+func (UnimplementedQuorumApiServer) UpdateProducer(context.Context, *GrpProducerParam) (*GrpProducerResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProducer not implemented")
+}
+func (UnimplementedQuorumApiServer) GetProducers(context.Context, *GroupParam) (*ProducerList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProducers not implemented")
+}
+func (UnimplementedQuorumApiServer) Announce(context.Context, *AnnounceParam) (*AnnounceResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Announce not implemented")
+}
+func (UnimplementedQuorumApiServer) GetAnnouncedUsers(context.Context, *GroupParam) (*AnnouncedUserList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAnnouncedUsers not implemented")
+}
+func (UnimplementedQuorumApiServer) GetAnnouncedProducers(context.Context, *GroupParam) (*AnnouncedProducerList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAnnouncedProducers not implemented")
+}
+func (UnimplementedQuorumApiServer) ApproveAnnouncedUser(context.Context, *AnnouncedUserParam) (*AnnounceResultResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveAnnouncedUser not implemented")
+}
+func (UnimplementedQuorumApiServer) RejectAnnouncedUser(context.Context, *AnnouncedUserParam) (*AnnounceResultResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectAnnouncedUser not implemented")
+}
+func (UnimplementedQuorumApiServer) UpdateDeniedList(context.Context, *DenyListParam) (*DenyUserResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDeniedList not implemented")
+}
+func (UnimplementedQuorumApiServer) GetDeniedList(context.Context, *GroupParam) (*DeniedUserList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeniedList not implemented")
+}
+func (UnimplementedQuorumApiServer) UpdateSchema(context.Context, *SchemaParam) (*SchemaResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSchema not implemented")
+}
+func (UnimplementedQuorumApiServer) GetSchema(context.Context, *GroupParam) (*SchemaList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchema not implemented")
+}
+func (UnimplementedQuorumApiServer) GetNodeInfo(context.Context, *emptypb.Empty) (*NodeInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNodeInfo not implemented")
+}
+func (UnimplementedQuorumApiServer) GetNetwork(context.Context, *emptypb.Empty) (*NetworkInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNetwork not implemented")
+}
+func (UnimplementedQuorumApiServer) AddPeers(context.Context, *AddPeersParam) (*AddPeerResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPeers not implemented")
+}
+func (UnimplementedQuorumApiServer) PSPing(context.Context, *PSPingParam) (*PingResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PSPing not implemented")
+}
+func (UnimplementedQuorumApiServer) mustEmbedUnimplementedQuorumApiServer() {}
+
+// UnsafeQuorumApiServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QuorumApiServer will
+// result in compilation errors.
+type UnsafeQuorumApiServer interface {
+	mustEmbedUnimplementedQuorumApiServer()
+}
+
+func RegisterQuorumApiServer(s grpc.ServiceRegistrar, srv QuorumApiServer) {
+	s.RegisterService(&QuorumApi_ServiceDesc, srv)
+}
+
+func _QuorumApi_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuorumApiServer).CreateGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quorum.pb.QuorumApi/CreateGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuorumApiServer).CreateGroup(ctx, req.(*CreateGroupParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuorumApi_JoinGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinGroupParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuorumApiServer).JoinGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quorum.pb.QuorumApi/JoinGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuorumApiServer).JoinGroup(ctx, req.(*JoinGroupParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuorumApi_LeaveGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveGroupParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuorumApiServer).LeaveGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quorum.pb.QuorumApi/LeaveGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuorumApiServer).LeaveGroup(ctx, req.(*LeaveGroupParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuorumApi_ClearGroupData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearGroupDataParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuorumApiServer).ClearGroupData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quorum.pb.QuorumApi/ClearGroupData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuorumApiServer).ClearGroupData(ctx, req.(*ClearGroupDataParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuorumApi_GetGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuorumApiServer).GetGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quorum.pb.QuorumApi/GetGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuorumApiServer).GetGroups(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuorumApi_StartSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuorumApiServer).StartSync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quorum.pb.QuorumApi/StartSync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuorumApiServer).StartSync(ctx, req.(*GroupParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuorumApi_PostToGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Activity)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuorumApiServer).PostToGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quorum.pb.QuorumApi/PostToGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuorumApiServer).PostToGroup(ctx, req.(*Activity))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuorumApi_UpdateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Activity)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuorumApiServer).UpdateProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quorum.pb.QuorumApi/UpdateProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuorumApiServer).UpdateProfile(ctx, req.(*Activity))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuorumApi_GetGroupContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupContentParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuorumApiServer).GetGroupContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quorum.pb.QuorumApi/GetGroupContent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuorumApiServer).GetGroupContent(ctx, req.(*GroupContentParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuorumApi_StreamGroupContent_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GroupParam)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QuorumApiServer).StreamGroupContent(m, &quorumApiStreamGroupContentServer{stream})
+}
+
+type QuorumApi_StreamGroupContentServer interface {
+	Send(*GroupContentObjectItem) error
+	grpc.ServerStream
+}
+
+type quorumApiStreamGroupContentServer struct {
+	grpc.ServerStream
+}
+
+func (x *quorumApiStreamGroupContentServer) Send(m *GroupContentObjectItem) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _QuorumApi_GetBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuorumApiServer).GetBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quorum.pb.QuorumApi/GetBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuorumApiServer).GetBlock(ctx, req.(*BlockParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuorumApi_GetTrx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrxParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuorumApiServer).GetTrx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quorum.pb.QuorumApi/GetTrx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuorumApiServer).GetTrx(ctx, req.(*TrxParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuorumApi_UpdateProducer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrpProducerParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuorumApiServer).UpdateProducer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quorum.pb.QuorumApi/UpdateProducer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuorumApiServer).UpdateProducer(ctx, req.(*GrpProducerParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuorumApi_GetProducers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuorumApiServer).GetProducers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quorum.pb.QuorumApi/GetProducers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuorumApiServer).GetProducers(ctx, req.(*GroupParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuorumApi_Announce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnnounceParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuorumApiServer).Announce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quorum.pb.QuorumApi/Announce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuorumApiServer).Announce(ctx, req.(*AnnounceParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuorumApi_GetAnnouncedUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuorumApiServer).GetAnnouncedUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quorum.pb.QuorumApi/GetAnnouncedUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuorumApiServer).GetAnnouncedUsers(ctx, req.(*GroupParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuorumApi_GetAnnouncedProducers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuorumApiServer).GetAnnouncedProducers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quorum.pb.QuorumApi/GetAnnouncedProducers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuorumApiServer).GetAnnouncedProducers(ctx, req.(*GroupParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuorumApi_ApproveAnnouncedUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnnouncedUserParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuorumApiServer).ApproveAnnouncedUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quorum.pb.QuorumApi/ApproveAnnouncedUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuorumApiServer).ApproveAnnouncedUser(ctx, req.(*AnnouncedUserParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuorumApi_RejectAnnouncedUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnnouncedUserParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuorumApiServer).RejectAnnouncedUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quorum.pb.QuorumApi/RejectAnnouncedUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuorumApiServer).RejectAnnouncedUser(ctx, req.(*AnnouncedUserParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuorumApi_UpdateDeniedList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DenyListParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuorumApiServer).UpdateDeniedList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quorum.pb.QuorumApi/UpdateDeniedList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuorumApiServer).UpdateDeniedList(ctx, req.(*DenyListParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuorumApi_GetDeniedList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuorumApiServer).GetDeniedList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quorum.pb.QuorumApi/GetDeniedList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuorumApiServer).GetDeniedList(ctx, req.(*GroupParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuorumApi_UpdateSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchemaParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuorumApiServer).UpdateSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quorum.pb.QuorumApi/UpdateSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuorumApiServer).UpdateSchema(ctx, req.(*SchemaParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuorumApi_GetSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuorumApiServer).GetSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quorum.pb.QuorumApi/GetSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuorumApiServer).GetSchema(ctx, req.(*GroupParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuorumApi_GetNodeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuorumApiServer).GetNodeInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quorum.pb.QuorumApi/GetNodeInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuorumApiServer).GetNodeInfo(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuorumApi_GetNetwork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuorumApiServer).GetNetwork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quorum.pb.QuorumApi/GetNetwork",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuorumApiServer).GetNetwork(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuorumApi_AddPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPeersParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuorumApiServer).AddPeers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quorum.pb.QuorumApi/AddPeers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuorumApiServer).AddPeers(ctx, req.(*AddPeersParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuorumApi_PSPing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PSPingParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuorumApiServer).PSPing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quorum.pb.QuorumApi/PSPing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuorumApiServer).PSPing(ctx, req.(*PSPingParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// QuorumApi_ServiceDesc is the grpc.ServiceDesc for QuorumApi service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var QuorumApi_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "quorum.pb.QuorumApi",
+	HandlerType: (*QuorumApiServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateGroup",
+			Handler:    _QuorumApi_CreateGroup_Handler,
+		},
+		{
+			MethodName: "JoinGroup",
+			Handler:    _QuorumApi_JoinGroup_Handler,
+		},
+		{
+			MethodName: "LeaveGroup",
+			Handler:    _QuorumApi_LeaveGroup_Handler,
+		},
+		{
+			MethodName: "ClearGroupData",
+			Handler:    _QuorumApi_ClearGroupData_Handler,
+		},
+		{
+			MethodName: "GetGroups",
+			Handler:    _QuorumApi_GetGroups_Handler,
+		},
+		{
+			MethodName: "StartSync",
+			Handler:    _QuorumApi_StartSync_Handler,
+		},
+		{
+			MethodName: "PostToGroup",
+			Handler:    _QuorumApi_PostToGroup_Handler,
+		},
+		{
+			MethodName: "UpdateProfile",
+			Handler:    _QuorumApi_UpdateProfile_Handler,
+		},
+		{
+			MethodName: "GetGroupContent",
+			Handler:    _QuorumApi_GetGroupContent_Handler,
+		},
+		{
+			MethodName: "GetBlock",
+			Handler:    _QuorumApi_GetBlock_Handler,
+		},
+		{
+			MethodName: "GetTrx",
+			Handler:    _QuorumApi_GetTrx_Handler,
+		},
+		{
+			MethodName: "UpdateProducer",
+			Handler:    _QuorumApi_UpdateProducer_Handler,
+		},
+		{
+			MethodName: "GetProducers",
+			Handler:    _QuorumApi_GetProducers_Handler,
+		},
+		{
+			MethodName: "Announce",
+			Handler:    _QuorumApi_Announce_Handler,
+		},
+		{
+			MethodName: "GetAnnouncedUsers",
+			Handler:    _QuorumApi_GetAnnouncedUsers_Handler,
+		},
+		{
+			MethodName: "GetAnnouncedProducers",
+			Handler:    _QuorumApi_GetAnnouncedProducers_Handler,
+		},
+		{
+			MethodName: "ApproveAnnouncedUser",
+			Handler:    _QuorumApi_ApproveAnnouncedUser_Handler,
+		},
+		{
+			MethodName: "RejectAnnouncedUser",
+			Handler:    _QuorumApi_RejectAnnouncedUser_Handler,
+		},
+		{
+			MethodName: "UpdateDeniedList",
+			Handler:    _QuorumApi_UpdateDeniedList_Handler,
+		},
+		{
+			MethodName: "GetDeniedList",
+			Handler:    _QuorumApi_GetDeniedList_Handler,
+		},
+		{
+			MethodName: "UpdateSchema",
+			Handler:    _QuorumApi_UpdateSchema_Handler,
+		},
+		{
+			MethodName: "GetSchema",
+			Handler:    _QuorumApi_GetSchema_Handler,
+		},
+		{
+			MethodName: "GetNodeInfo",
+			Handler:    _QuorumApi_GetNodeInfo_Handler,
+		},
+		{
+			MethodName: "GetNetwork",
+			Handler:    _QuorumApi_GetNetwork_Handler,
+		},
+		{
+			MethodName: "AddPeers",
+			Handler:    _QuorumApi_AddPeers_Handler,
+		},
+		{
+			MethodName: "PSPing",
+			Handler:    _QuorumApi_PSPing_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamGroupContent",
+			Handler:       _QuorumApi_StreamGroupContent_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api.proto",
+}
@@ -69,43 +69,70 @@ func (PackageType) EnumDescriptor() ([]byte, []int) {
 type TrxType int32
 
 const (
-	TrxType_POST               TrxType = 0 // post to group
-	TrxType_AUTH               TrxType = 1 // group auth update
-	TrxType_SCHEMA             TrxType = 2 // group schema
-	TrxType_PRODUCER           TrxType = 3 // update group producer
-	TrxType_ANNOUNCE           TrxType = 4 // self announce, producer or user)
-	TrxType_REQ_BLOCK_FORWARD  TrxType = 5 // request next block
-	TrxType_REQ_BLOCK_BACKWARD TrxType = 6 // request previous block
-	TrxType_REQ_BLOCK_RESP     TrxType = 7 // response request next block
-	TrxType_BLOCK_SYNCED       TrxType = 8 // block for producer to sync (old block)
-	TrxType_BLOCK_PRODUCED     TrxType = 9 // block for producer to merge (newly produced block)
+	TrxType_POST                 TrxType = 0  // post to group
+	TrxType_AUTH                 TrxType = 1  // group auth update
+	TrxType_SCHEMA               TrxType = 2  // group schema
+	TrxType_PRODUCER             TrxType = 3  // update group producer
+	TrxType_ANNOUNCE             TrxType = 4  // self announce, producer or user)
+	TrxType_REQ_BLOCK_FORWARD    TrxType = 5  // request next block
+	TrxType_REQ_BLOCK_BACKWARD   TrxType = 6  // request previous block
+	TrxType_REQ_BLOCK_RESP       TrxType = 7  // response request next block
+	TrxType_BLOCK_SYNCED         TrxType = 8  // block for producer to sync (old block)
+	TrxType_BLOCK_PRODUCED       TrxType = 9  // block for producer to merge (newly produced block)
+	TrxType_STAKE                TrxType = 10 // update producer stake (pos group)
+	TrxType_BLOCK_VOTE           TrxType = 11 // producer vote (prevote or commit) for a proposed block
+	TrxType_REQ_SNAPSHOT         TrxType = 12 // request the latest group snapshot
+	TrxType_SNAPSHOT             TrxType = 13 // response request snapshot
+	TrxType_REQ_BLOCK_RANGE      TrxType = 14 // request a range of descendant blocks
+	TrxType_REQ_BLOCK_RANGE_RESP TrxType = 15 // response request block range (in batches)
+	TrxType_GROUP_KEY            TrxType = 16 // rotate group cipher key (new key epoch, private group)
+	TrxType_ANNOUNCE_RESULT      TrxType = 17 // owner approves or rejects an announced user
+	TrxType_ROLE                 TrxType = 18 // grant or revoke a group role
 )
 
 // Enum value maps for TrxType.
 var (
 	TrxType_name = map[int32]string{
-		0: "POST",
-		1: "AUTH",
-		2: "SCHEMA",
-		3: "PRODUCER",
-		4: "ANNOUNCE",
-		5: "REQ_BLOCK_FORWARD",
-		6: "REQ_BLOCK_BACKWARD",
-		7: "REQ_BLOCK_RESP",
-		8: "BLOCK_SYNCED",
-		9: "BLOCK_PRODUCED",
+		0:  "POST",
+		1:  "AUTH",
+		2:  "SCHEMA",
+		3:  "PRODUCER",
+		4:  "ANNOUNCE",
+		5:  "REQ_BLOCK_FORWARD",
+		6:  "REQ_BLOCK_BACKWARD",
+		7:  "REQ_BLOCK_RESP",
+		8:  "BLOCK_SYNCED",
+		9:  "BLOCK_PRODUCED",
+		10: "STAKE",
+		11: "BLOCK_VOTE",
+		12: "REQ_SNAPSHOT",
+		13: "SNAPSHOT",
+		14: "REQ_BLOCK_RANGE",
+		15: "REQ_BLOCK_RANGE_RESP",
+		16: "GROUP_KEY",
+		17: "ANNOUNCE_RESULT",
+		18: "ROLE",
 	}
 	TrxType_value = map[string]int32{
-		"POST":               0,
-		"AUTH":               1,
-		"SCHEMA":             2,
-		"PRODUCER":           3,
-		"ANNOUNCE":           4,
-		"REQ_BLOCK_FORWARD":  5,
-		"REQ_BLOCK_BACKWARD": 6,
-		"REQ_BLOCK_RESP":     7,
-		"BLOCK_SYNCED":       8,
-		"BLOCK_PRODUCED":     9,
+		"POST":                 0,
+		"AUTH":                 1,
+		"SCHEMA":               2,
+		"PRODUCER":             3,
+		"ANNOUNCE":             4,
+		"REQ_BLOCK_FORWARD":    5,
+		"REQ_BLOCK_BACKWARD":   6,
+		"REQ_BLOCK_RESP":       7,
+		"BLOCK_SYNCED":         8,
+		"BLOCK_PRODUCED":       9,
+		"STAKE":                10,
+		"BLOCK_VOTE":           11,
+		"REQ_SNAPSHOT":         12,
+		"SNAPSHOT":             13,
+		"REQ_BLOCK_RANGE":      14,
+		"REQ_BLOCK_RANGE_RESP": 15,
+		"GROUP_KEY":            16,
+		"ANNOUNCE_RESULT":      17,
+		"ROLE":                 18,
 	}
 )
 
@@ -277,6 +304,107 @@ func (ActionType) EnumDescriptor() ([]byte, []int) {
 	return file_chain_proto_rawDescGZIP(), []int{4}
 }
 
+type GroupRole int32
+
+const (
+	GroupRole_READER    GroupRole = 0 // can read and announce only
+	GroupRole_WRITER    GroupRole = 1 // can post to group, role of user without a grant
+	GroupRole_MODERATOR GroupRole = 2 // can manage denied list
+	GroupRole_ADMIN     GroupRole = 3 // can manage schema and producers
+	GroupRole_OWNER     GroupRole = 4 // group owner, can not be granted
+)
+
+// Enum value maps for GroupRole.
+var (
+	GroupRole_name = map[int32]string{
+		0: "READER",
+		1: "WRITER",
+		2: "MODERATOR",
+		3: "ADMIN",
+		4: "OWNER",
+	}
+	GroupRole_value = map[string]int32{
+		"READER":    0,
+		"WRITER":    1,
+		"MODERATOR": 2,
+		"ADMIN":     3,
+		"OWNER":     4,
+	}
+)
+
+func (x GroupRole) Enum() *GroupRole {
+	p := new(GroupRole)
+	*p = x
+	return p
+}
+
+func (x GroupRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GroupRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_chain_proto_enumTypes[5].Descriptor()
+}
+
+func (GroupRole) Type() protoreflect.EnumType {
+	return &file_chain_proto_enumTypes[5]
+}
+
+func (x GroupRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GroupRole.Descriptor instead.
+func (GroupRole) EnumDescriptor() ([]byte, []int) {
+	return file_chain_proto_rawDescGZIP(), []int{5}
+}
+
+type VoteType int32
+
+const (
+	VoteType_PREVOTE VoteType = 0
+	VoteType_COMMIT  VoteType = 1
+)
+
+// Enum value maps for VoteType.
+var (
+	VoteType_name = map[int32]string{
+		0: "PREVOTE",
+		1: "COMMIT",
+	}
+	VoteType_value = map[string]int32{
+		"PREVOTE": 0,
+		"COMMIT":  1,
+	}
+)
+
+func (x VoteType) Enum() *VoteType {
+	p := new(VoteType)
+	*p = x
+	return p
+}
+
+func (x VoteType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VoteType) Descriptor() protoreflect.EnumDescriptor {
+	return file_chain_proto_enumTypes[6].Descriptor()
+}
+
+func (VoteType) Type() protoreflect.EnumType {
+	return &file_chain_proto_enumTypes[6]
+}
+
+func (x VoteType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VoteType.Descriptor instead.
+func (VoteType) EnumDescriptor() ([]byte, []int) {
+	return file_chain_proto_rawDescGZIP(), []int{6}
+}
+
 type ReqBlkResult int32
 
 const (
@@ -307,11 +435,11 @@ func (x ReqBlkResult) String() string {
 }
 
 func (ReqBlkResult) Descriptor() protoreflect.EnumDescriptor {
-	return file_chain_proto_enumTypes[5].Descriptor()
+	return file_chain_proto_enumTypes[7].Descriptor()
 }
 
 func (ReqBlkResult) Type() protoreflect.EnumType {
-	return &file_chain_proto_enumTypes[5]
+	return &file_chain_proto_enumTypes[7]
 }
 
 func (x ReqBlkResult) Number() protoreflect.EnumNumber {
@@ -320,7 +448,7 @@ func (x ReqBlkResult) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ReqBlkResult.Descriptor instead.
 func (ReqBlkResult) EnumDescriptor() ([]byte, []int) {
-	return file_chain_proto_rawDescGZIP(), []int{5}
+	return file_chain_proto_rawDescGZIP(), []int{7}
 }
 
 type GroupEncryptType int32
@@ -353,11 +481,11 @@ func (x GroupEncryptType) String() string {
 }
 
 func (GroupEncryptType) Descriptor() protoreflect.EnumDescriptor {
-	return file_chain_proto_enumTypes[6].Descriptor()
+	return file_chain_proto_enumTypes[8].Descriptor()
 }
 
 func (GroupEncryptType) Type() protoreflect.EnumType {
-	return &file_chain_proto_enumTypes[6]
+	return &file_chain_proto_enumTypes[8]
 }
 
 func (x GroupEncryptType) Number() protoreflect.EnumNumber {
@@ -366,7 +494,7 @@ func (x GroupEncryptType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use GroupEncryptType.Descriptor instead.
 func (GroupEncryptType) EnumDescriptor() ([]byte, []int) {
-	return file_chain_proto_rawDescGZIP(), []int{6}
+	return file_chain_proto_rawDescGZIP(), []int{8}
 }
 
 type GroupConsenseType int32
@@ -399,11 +527,11 @@ func (x GroupConsenseType) String() string {
 }
 
 func (GroupConsenseType) Descriptor() protoreflect.EnumDescriptor {
-	return file_chain_proto_enumTypes[7].Descriptor()
+	return file_chain_proto_enumTypes[9].Descriptor()
 }
 
 func (GroupConsenseType) Type() protoreflect.EnumType {
-	return &file_chain_proto_enumTypes[7]
+	return &file_chain_proto_enumTypes[9]
 }
 
 func (x GroupConsenseType) Number() protoreflect.EnumNumber {
@@ -412,7 +540,7 @@ func (x GroupConsenseType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use GroupConsenseType.Descriptor instead.
 func (GroupConsenseType) EnumDescriptor() ([]byte, []int) {
-	return file_chain_proto_rawDescGZIP(), []int{7}
+	return file_chain_proto_rawDescGZIP(), []int{9}
 }
 
 type RoleV0 int32
@@ -445,11 +573,11 @@ func (x RoleV0) String() string {
 }
 
 func (RoleV0) Descriptor() protoreflect.EnumDescriptor {
-	return file_chain_proto_enumTypes[8].Descriptor()
+	return file_chain_proto_enumTypes[10].Descriptor()
 }
 
 func (RoleV0) Type() protoreflect.EnumType {
-	return &file_chain_proto_enumTypes[8]
+	return &file_chain_proto_enumTypes[10]
 }
 
 func (x RoleV0) Number() protoreflect.EnumNumber {
@@ -458,7 +586,7 @@ func (x RoleV0) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use RoleV0.Descriptor instead.
 func (RoleV0) EnumDescriptor() ([]byte, []int) {
-	return file_chain_proto_rawDescGZIP(), []int{8}
+	return file_chain_proto_rawDescGZIP(), []int{10}
 }
 
 type Package struct {
@@ -532,6 +660,7 @@ type Trx struct {
 	Nonce        int64   `protobuf:"varint,9,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
 	SenderPubkey string  `protobuf:"bytes,10,opt,name=SenderPubkey,proto3" json:"SenderPubkey,omitempty"`
 	SenderSign   []byte  `protobuf:"bytes,11,opt,name=SenderSign,proto3" json:"SenderSign,omitempty"`
+	KeyEpoch     int64   `protobuf:"varint,12,opt,name=KeyEpoch,proto3" json:"KeyEpoch,omitempty"`
 }
 
 func (x *Trx) Reset() {
@@ -643,20 +772,28 @@ func (x *Trx) GetSenderSign() []byte {
 	return nil
 }
 
+func (x *Trx) GetKeyEpoch() int64 {
+	if x != nil {
+		return x.KeyEpoch
+	}
+	return 0
+}
+
 type Block struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BlockId        string `protobuf:"bytes,1,opt,name=BlockId,proto3" json:"BlockId,omitempty"`
-	GroupId        string `protobuf:"bytes,2,opt,name=GroupId,proto3" json:"GroupId,omitempty"`
-	PrevBlockId    string `protobuf:"bytes,3,opt,name=PrevBlockId,proto3" json:"PrevBlockId,omitempty"`
-	PreviousHash   []byte `protobuf:"bytes,4,opt,name=PreviousHash,proto3" json:"PreviousHash,omitempty"`
-	Trxs           []*Trx `protobuf:"bytes,5,rep,name=Trxs,proto3" json:"Trxs,omitempty"`
-	ProducerPubKey string `protobuf:"bytes,6,opt,name=ProducerPubKey,proto3" json:"ProducerPubKey,omitempty"`
-	Hash           []byte `protobuf:"bytes,7,opt,name=Hash,proto3" json:"Hash,omitempty"`
-	Signature      []byte `protobuf:"bytes,8,opt,name=Signature,proto3" json:"Signature,omitempty"`
-	TimeStamp      int64  `protobuf:"varint,9,opt,name=TimeStamp,proto3" json:"TimeStamp,omitempty,string"`
+	BlockId        string       `protobuf:"bytes,1,opt,name=BlockId,proto3" json:"BlockId,omitempty"`
+	GroupId        string       `protobuf:"bytes,2,opt,name=GroupId,proto3" json:"GroupId,omitempty"`
+	PrevBlockId    string       `protobuf:"bytes,3,opt,name=PrevBlockId,proto3" json:"PrevBlockId,omitempty"`
+	PreviousHash   []byte       `protobuf:"bytes,4,opt,name=PreviousHash,proto3" json:"PreviousHash,omitempty"`
+	Trxs           []*Trx       `protobuf:"bytes,5,rep,name=Trxs,proto3" json:"Trxs,omitempty"`
+	ProducerPubKey string       `protobuf:"bytes,6,opt,name=ProducerPubKey,proto3" json:"ProducerPubKey,omitempty"`
+	Hash           []byte       `protobuf:"bytes,7,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	Signature      []byte       `protobuf:"bytes,8,opt,name=Signature,proto3" json:"Signature,omitempty"`
+	TimeStamp      int64        `protobuf:"varint,9,opt,name=TimeStamp,proto3" json:"TimeStamp,omitempty,string"`
+	Commits        []*BlockVote `protobuf:"bytes,10,rep,name=Commits,proto3" json:"Commits,omitempty"` // commit votes of producers, not included in block hash
 }
 
 func (x *Block) Reset() {
@@ -754,6 +891,108 @@ func (x *Block) GetTimeStamp() int64 {
 	return 0
 }
 
+func (x *Block) GetCommits() []*BlockVote {
+	if x != nil {
+		return x.Commits
+	}
+	return nil
+}
+
+type BlockVote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupId     string   `protobuf:"bytes,1,opt,name=GroupId,proto3" json:"GroupId,omitempty"`
+	BlockId     string   `protobuf:"bytes,2,opt,name=BlockId,proto3" json:"BlockId,omitempty"`
+	PrevBlockId string   `protobuf:"bytes,3,opt,name=PrevBlockId,proto3" json:"PrevBlockId,omitempty"`
+	Type        VoteType `protobuf:"varint,4,opt,name=Type,proto3,enum=quorum.pb.VoteType" json:"Type,omitempty"`
+	VoterPubkey string   `protobuf:"bytes,5,opt,name=VoterPubkey,proto3" json:"VoterPubkey,omitempty"`
+	TimeStamp   int64    `protobuf:"varint,6,opt,name=TimeStamp,proto3" json:"TimeStamp,omitempty,string"`
+	Signature   []byte   `protobuf:"bytes,7,opt,name=Signature,proto3" json:"Signature,omitempty"`
+}
+
+func (x *BlockVote) Reset() {
+	*x = BlockVote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chain_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockVote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockVote) ProtoMessage() {}
+
+func (x *BlockVote) ProtoReflect() protoreflect.Message {
+	mi := &file_chain_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockVote.ProtoReflect.Descriptor instead.
+func (*BlockVote) Descriptor() ([]byte, []int) {
+	return file_chain_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BlockVote) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *BlockVote) GetBlockId() string {
+	if x != nil {
+		return x.BlockId
+	}
+	return ""
+}
+
+func (x *BlockVote) GetPrevBlockId() string {
+	if x != nil {
+		return x.PrevBlockId
+	}
+	return ""
+}
+
+func (x *BlockVote) GetType() VoteType {
+	if x != nil {
+		return x.Type
+	}
+	return VoteType_PREVOTE
+}
+
+func (x *BlockVote) GetVoterPubkey() string {
+	if x != nil {
+		return x.VoterPubkey
+	}
+	return ""
+}
+
+func (x *BlockVote) GetTimeStamp() int64 {
+	if x != nil {
+		return x.TimeStamp
+	}
+	return 0
+}
+
+func (x *BlockVote) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
 type BlockDbChunk struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -764,12 +1003,13 @@ type BlockDbChunk struct {
 	ParentBlockId string   `protobuf:"bytes,3,opt,name=ParentBlockId,proto3" json:"ParentBlockId,omitempty"`
 	SubBlockId    []string `protobuf:"bytes,4,rep,name=SubBlockId,proto3" json:"SubBlockId,omitempty"`
 	Height        int64    `protobuf:"varint,6,opt,name=Height,proto3" json:"Height,omitempty"`
+	Finalized     bool     `protobuf:"varint,7,opt,name=Finalized,proto3" json:"Finalized,omitempty"`
 }
 
 func (x *BlockDbChunk) Reset() {
 	*x = BlockDbChunk{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chain_proto_msgTypes[3]
+		mi := &file_chain_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -782,7 +1022,7 @@ func (x *BlockDbChunk) String() string {
 func (*BlockDbChunk) ProtoMessage() {}
 
 func (x *BlockDbChunk) ProtoReflect() protoreflect.Message {
-	mi := &file_chain_proto_msgTypes[3]
+	mi := &file_chain_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -795,7 +1035,7 @@ func (x *BlockDbChunk) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlockDbChunk.ProtoReflect.Descriptor instead.
 func (*BlockDbChunk) Descriptor() ([]byte, []int) {
-	return file_chain_proto_rawDescGZIP(), []int{3}
+	return file_chain_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *BlockDbChunk) GetBlockId() string {
@@ -833,6 +1073,13 @@ func (x *BlockDbChunk) GetHeight() int64 {
 	return 0
 }
 
+func (x *BlockDbChunk) GetFinalized() bool {
+	if x != nil {
+		return x.Finalized
+	}
+	return false
+}
+
 type ReqBlock struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -846,7 +1093,7 @@ type ReqBlock struct {
 func (x *ReqBlock) Reset() {
 	*x = ReqBlock{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chain_proto_msgTypes[4]
+		mi := &file_chain_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -859,7 +1106,7 @@ func (x *ReqBlock) String() string {
 func (*ReqBlock) ProtoMessage() {}
 
 func (x *ReqBlock) ProtoReflect() protoreflect.Message {
-	mi := &file_chain_proto_msgTypes[4]
+	mi := &file_chain_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -872,7 +1119,7 @@ func (x *ReqBlock) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReqBlock.ProtoReflect.Descriptor instead.
 func (*ReqBlock) Descriptor() ([]byte, []int) {
-	return file_chain_proto_rawDescGZIP(), []int{4}
+	return file_chain_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ReqBlock) GetBlockId() string {
@@ -907,7 +1154,7 @@ type BlockSynced struct {
 func (x *BlockSynced) Reset() {
 	*x = BlockSynced{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chain_proto_msgTypes[5]
+		mi := &file_chain_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -920,7 +1167,7 @@ func (x *BlockSynced) String() string {
 func (*BlockSynced) ProtoMessage() {}
 
 func (x *BlockSynced) ProtoReflect() protoreflect.Message {
-	mi := &file_chain_proto_msgTypes[5]
+	mi := &file_chain_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -933,7 +1180,7 @@ func (x *BlockSynced) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlockSynced.ProtoReflect.Descriptor instead.
 func (*BlockSynced) Descriptor() ([]byte, []int) {
-	return file_chain_proto_rawDescGZIP(), []int{5}
+	return file_chain_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *BlockSynced) GetBlockItem() *Block {
@@ -954,7 +1201,7 @@ type BlockProduced struct {
 func (x *BlockProduced) Reset() {
 	*x = BlockProduced{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chain_proto_msgTypes[6]
+		mi := &file_chain_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -967,7 +1214,7 @@ func (x *BlockProduced) String() string {
 func (*BlockProduced) ProtoMessage() {}
 
 func (x *BlockProduced) ProtoReflect() protoreflect.Message {
-	mi := &file_chain_proto_msgTypes[6]
+	mi := &file_chain_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -980,7 +1227,7 @@ func (x *BlockProduced) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlockProduced.ProtoReflect.Descriptor instead.
 func (*BlockProduced) Descriptor() ([]byte, []int) {
-	return file_chain_proto_rawDescGZIP(), []int{6}
+	return file_chain_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *BlockProduced) GetBlockItem() *Block {
@@ -1006,7 +1253,7 @@ type ReqBlockResp struct {
 func (x *ReqBlockResp) Reset() {
 	*x = ReqBlockResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chain_proto_msgTypes[7]
+		mi := &file_chain_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1019,7 +1266,7 @@ func (x *ReqBlockResp) String() string {
 func (*ReqBlockResp) ProtoMessage() {}
 
 func (x *ReqBlockResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chain_proto_msgTypes[7]
+	mi := &file_chain_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1032,7 +1279,7 @@ func (x *ReqBlockResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReqBlockResp.ProtoReflect.Descriptor instead.
 func (*ReqBlockResp) Descriptor() ([]byte, []int) {
-	return file_chain_proto_rawDescGZIP(), []int{7}
+	return file_chain_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ReqBlockResp) GetResult() ReqBlkResult {
@@ -1077,34 +1324,36 @@ func (x *ReqBlockResp) GetBlock() []byte {
 	return nil
 }
 
-type PostItem struct {
+type ReqBlockRange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TrxId           string `protobuf:"bytes,1,opt,name=TrxId,proto3" json:"TrxId,omitempty"`
-	PublisherPubkey string `protobuf:"bytes,2,opt,name=PublisherPubkey,proto3" json:"PublisherPubkey,omitempty"`
-	Content         []byte `protobuf:"bytes,3,opt,name=Content,proto3" json:"Content,omitempty"`
-	TimeStamp       int64  `protobuf:"varint,4,opt,name=TimeStamp,proto3" json:"TimeStamp,omitempty,string"`
+	BlockId        string `protobuf:"bytes,1,opt,name=BlockId,proto3" json:"BlockId,omitempty"` //range is counted from this block
+	GroupId        string `protobuf:"bytes,2,opt,name=GroupId,proto3" json:"GroupId,omitempty"`
+	UserId         string `protobuf:"bytes,3,opt,name=UserId,proto3" json:"UserId,omitempty"`                 //requester
+	ProviderPubkey string `protobuf:"bytes,4,opt,name=ProviderPubkey,proto3" json:"ProviderPubkey,omitempty"` //producer asked to provide the range
+	Offset         int64  `protobuf:"varint,5,opt,name=Offset,proto3" json:"Offset,omitempty"`                //descendants deeper than Offset levels
+	Count          int64  `protobuf:"varint,6,opt,name=Count,proto3" json:"Count,omitempty"`                  //and not deeper than Offset + Count levels
 }
 
-func (x *PostItem) Reset() {
-	*x = PostItem{}
+func (x *ReqBlockRange) Reset() {
+	*x = ReqBlockRange{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chain_proto_msgTypes[8]
+		mi := &file_chain_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PostItem) String() string {
+func (x *ReqBlockRange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PostItem) ProtoMessage() {}
+func (*ReqBlockRange) ProtoMessage() {}
 
-func (x *PostItem) ProtoReflect() protoreflect.Message {
-	mi := &file_chain_proto_msgTypes[8]
+func (x *ReqBlockRange) ProtoReflect() protoreflect.Message {
+	mi := &file_chain_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1115,70 +1364,85 @@ func (x *PostItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PostItem.ProtoReflect.Descriptor instead.
-func (*PostItem) Descriptor() ([]byte, []int) {
-	return file_chain_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ReqBlockRange.ProtoReflect.Descriptor instead.
+func (*ReqBlockRange) Descriptor() ([]byte, []int) {
+	return file_chain_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *PostItem) GetTrxId() string {
+func (x *ReqBlockRange) GetBlockId() string {
 	if x != nil {
-		return x.TrxId
+		return x.BlockId
 	}
 	return ""
 }
 
-func (x *PostItem) GetPublisherPubkey() string {
+func (x *ReqBlockRange) GetGroupId() string {
 	if x != nil {
-		return x.PublisherPubkey
+		return x.GroupId
 	}
 	return ""
 }
 
-func (x *PostItem) GetContent() []byte {
+func (x *ReqBlockRange) GetUserId() string {
 	if x != nil {
-		return x.Content
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-func (x *PostItem) GetTimeStamp() int64 {
+func (x *ReqBlockRange) GetProviderPubkey() string {
 	if x != nil {
-		return x.TimeStamp
+		return x.ProviderPubkey
+	}
+	return ""
+}
+
+func (x *ReqBlockRange) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
 	}
 	return 0
 }
 
-type DenyUserItem struct {
+func (x *ReqBlockRange) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ReqBlockRangeResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GroupId          string `protobuf:"bytes,1,opt,name=GroupId,proto3" json:"GroupId,omitempty"`
-	PeerId           string `protobuf:"bytes,2,opt,name=PeerId,proto3" json:"PeerId,omitempty"`
-	GroupOwnerPubkey string `protobuf:"bytes,3,opt,name=GroupOwnerPubkey,proto3" json:"GroupOwnerPubkey,omitempty"`
-	GroupOwnerSign   string `protobuf:"bytes,4,opt,name=GroupOwnerSign,proto3" json:"GroupOwnerSign,omitempty"`
-	TimeStamp        int64  `protobuf:"varint,5,opt,name=TimeStamp,proto3" json:"TimeStamp,omitempty,string"`
-	Action           string `protobuf:"bytes,6,opt,name=Action,proto3" json:"Action,omitempty"`
-	Memo             string `protobuf:"bytes,7,opt,name=Memo,proto3" json:"Memo,omitempty"`
+	Result          ReqBlkResult `protobuf:"varint,1,opt,name=Result,proto3,enum=quorum.pb.ReqBlkResult" json:"Result,omitempty"`
+	ProviderPubkey  string       `protobuf:"bytes,2,opt,name=ProviderPubkey,proto3" json:"ProviderPubkey,omitempty"`
+	RequesterPubkey string       `protobuf:"bytes,3,opt,name=RequesterPubkey,proto3" json:"RequesterPubkey,omitempty"`
+	GroupId         string       `protobuf:"bytes,4,opt,name=GroupId,proto3" json:"GroupId,omitempty"`
+	BlockId         string       `protobuf:"bytes,5,opt,name=BlockId,proto3" json:"BlockId,omitempty"`
+	Offset          int64        `protobuf:"varint,6,opt,name=Offset,proto3" json:"Offset,omitempty"`
+	Blocks          []*Block     `protobuf:"bytes,7,rep,name=Blocks,proto3" json:"Blocks,omitempty"`
+	Last            bool         `protobuf:"varint,8,opt,name=Last,proto3" json:"Last,omitempty"` //last batch of the range
 }
 
-func (x *DenyUserItem) Reset() {
-	*x = DenyUserItem{}
+func (x *ReqBlockRangeResp) Reset() {
+	*x = ReqBlockRangeResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chain_proto_msgTypes[9]
+		mi := &file_chain_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DenyUserItem) String() string {
+func (x *ReqBlockRangeResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DenyUserItem) ProtoMessage() {}
+func (*ReqBlockRangeResp) ProtoMessage() {}
 
-func (x *DenyUserItem) ProtoReflect() protoreflect.Message {
-	mi := &file_chain_proto_msgTypes[9]
+func (x *ReqBlockRangeResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chain_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1189,92 +1453,93 @@ func (x *DenyUserItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DenyUserItem.ProtoReflect.Descriptor instead.
-func (*DenyUserItem) Descriptor() ([]byte, []int) {
-	return file_chain_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ReqBlockRangeResp.ProtoReflect.Descriptor instead.
+func (*ReqBlockRangeResp) Descriptor() ([]byte, []int) {
+	return file_chain_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *DenyUserItem) GetGroupId() string {
+func (x *ReqBlockRangeResp) GetResult() ReqBlkResult {
 	if x != nil {
-		return x.GroupId
+		return x.Result
+	}
+	return ReqBlkResult_BLOCK_IN_TRX
+}
+
+func (x *ReqBlockRangeResp) GetProviderPubkey() string {
+	if x != nil {
+		return x.ProviderPubkey
 	}
 	return ""
 }
 
-func (x *DenyUserItem) GetPeerId() string {
+func (x *ReqBlockRangeResp) GetRequesterPubkey() string {
 	if x != nil {
-		return x.PeerId
+		return x.RequesterPubkey
 	}
 	return ""
 }
 
-func (x *DenyUserItem) GetGroupOwnerPubkey() string {
+func (x *ReqBlockRangeResp) GetGroupId() string {
 	if x != nil {
-		return x.GroupOwnerPubkey
+		return x.GroupId
 	}
 	return ""
 }
 
-func (x *DenyUserItem) GetGroupOwnerSign() string {
+func (x *ReqBlockRangeResp) GetBlockId() string {
 	if x != nil {
-		return x.GroupOwnerSign
+		return x.BlockId
 	}
 	return ""
 }
 
-func (x *DenyUserItem) GetTimeStamp() int64 {
+func (x *ReqBlockRangeResp) GetOffset() int64 {
 	if x != nil {
-		return x.TimeStamp
+		return x.Offset
 	}
 	return 0
 }
 
-func (x *DenyUserItem) GetAction() string {
+func (x *ReqBlockRangeResp) GetBlocks() []*Block {
 	if x != nil {
-		return x.Action
+		return x.Blocks
 	}
-	return ""
+	return nil
 }
 
-func (x *DenyUserItem) GetMemo() string {
+func (x *ReqBlockRangeResp) GetLast() bool {
 	if x != nil {
-		return x.Memo
+		return x.Last
 	}
-	return ""
+	return false
 }
 
-type ProducerItem struct {
+type ReqSnapshot struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GroupId          string     `protobuf:"bytes,1,opt,name=GroupId,proto3" json:"GroupId,omitempty"`
-	ProducerPubkey   string     `protobuf:"bytes,2,opt,name=ProducerPubkey,proto3" json:"ProducerPubkey,omitempty"`
-	GroupOwnerPubkey string     `protobuf:"bytes,3,opt,name=GroupOwnerPubkey,proto3" json:"GroupOwnerPubkey,omitempty"`
-	GroupOwnerSign   string     `protobuf:"bytes,4,opt,name=GroupOwnerSign,proto3" json:"GroupOwnerSign,omitempty"`
-	TimeStamp        int64      `protobuf:"varint,5,opt,name=TimeStamp,proto3" json:"TimeStamp,omitempty,string"`
-	Action           ActionType `protobuf:"varint,6,opt,name=Action,proto3,enum=quorum.pb.ActionType" json:"Action,omitempty"`
-	BlockProduced    int64      `protobuf:"varint,7,opt,name=BlockProduced,proto3" json:"BlockProduced,omitempty"`
-	Memo             string     `protobuf:"bytes,8,opt,name=Memo,proto3" json:"Memo,omitempty"`
+	GroupId string `protobuf:"bytes,1,opt,name=GroupId,proto3" json:"GroupId,omitempty"` //group id
+	UserId  string `protobuf:"bytes,2,opt,name=UserId,proto3" json:"UserId,omitempty"`   //requester
 }
 
-func (x *ProducerItem) Reset() {
-	*x = ProducerItem{}
+func (x *ReqSnapshot) Reset() {
+	*x = ReqSnapshot{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chain_proto_msgTypes[10]
+		mi := &file_chain_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProducerItem) String() string {
+func (x *ReqSnapshot) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProducerItem) ProtoMessage() {}
+func (*ReqSnapshot) ProtoMessage() {}
 
-func (x *ProducerItem) ProtoReflect() protoreflect.Message {
-	mi := &file_chain_proto_msgTypes[10]
+func (x *ReqSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_chain_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1285,102 +1550,232 @@ func (x *ProducerItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProducerItem.ProtoReflect.Descriptor instead.
-func (*ProducerItem) Descriptor() ([]byte, []int) {
-	return file_chain_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ReqSnapshot.ProtoReflect.Descriptor instead.
+func (*ReqSnapshot) Descriptor() ([]byte, []int) {
+	return file_chain_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ProducerItem) GetGroupId() string {
+func (x *ReqSnapshot) GetGroupId() string {
 	if x != nil {
 		return x.GroupId
 	}
 	return ""
 }
 
-func (x *ProducerItem) GetProducerPubkey() string {
+func (x *ReqSnapshot) GetUserId() string {
 	if x != nil {
-		return x.ProducerPubkey
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *ProducerItem) GetGroupOwnerPubkey() string {
+type Snapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupId         string          `protobuf:"bytes,1,opt,name=GroupId,proto3" json:"GroupId,omitempty"`
+	SnapshotId      string          `protobuf:"bytes,2,opt,name=SnapshotId,proto3" json:"SnapshotId,omitempty"`
+	BlockId         string          `protobuf:"bytes,3,opt,name=BlockId,proto3" json:"BlockId,omitempty"` //snapshot is taken after this block applied
+	Height          int64           `protobuf:"varint,4,opt,name=Height,proto3" json:"Height,omitempty"`
+	Block           *Block          `protobuf:"bytes,5,opt,name=Block,proto3" json:"Block,omitempty"`
+	Producers       []*ProducerItem `protobuf:"bytes,6,rep,name=Producers,proto3" json:"Producers,omitempty"`
+	Announces       []*AnnounceItem `protobuf:"bytes,7,rep,name=Announces,proto3" json:"Announces,omitempty"`
+	DenyList        []*DenyUserItem `protobuf:"bytes,8,rep,name=DenyList,proto3" json:"DenyList,omitempty"`
+	Schemas         []*SchemaItem   `protobuf:"bytes,9,rep,name=Schemas,proto3" json:"Schemas,omitempty"`
+	Stakes          []*StakeItem    `protobuf:"bytes,10,rep,name=Stakes,proto3" json:"Stakes,omitempty"`
+	RequesterPubkey string          `protobuf:"bytes,12,opt,name=RequesterPubkey,proto3" json:"RequesterPubkey,omitempty"`
+	ProducerPubkey  string          `protobuf:"bytes,13,opt,name=ProducerPubkey,proto3" json:"ProducerPubkey,omitempty"`
+	TimeStamp       int64           `protobuf:"varint,14,opt,name=TimeStamp,proto3" json:"TimeStamp,omitempty,string"`
+	Hash            []byte          `protobuf:"bytes,15,opt,name=Hash,proto3" json:"Hash,omitempty"` //hash of group state, same for all producers on the same block
+	Signature       []byte          `protobuf:"bytes,16,opt,name=Signature,proto3" json:"Signature,omitempty"`
+	GroupKeys       []*GroupKeyItem `protobuf:"bytes,17,rep,name=GroupKeys,proto3" json:"GroupKeys,omitempty"`
+	Roles           []*RoleItem     `protobuf:"bytes,18,rep,name=Roles,proto3" json:"Roles,omitempty"`
+}
+
+func (x *Snapshot) Reset() {
+	*x = Snapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chain_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Snapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Snapshot) ProtoMessage() {}
+
+func (x *Snapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_chain_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Snapshot.ProtoReflect.Descriptor instead.
+func (*Snapshot) Descriptor() ([]byte, []int) {
+	return file_chain_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Snapshot) GetGroupId() string {
 	if x != nil {
-		return x.GroupOwnerPubkey
+		return x.GroupId
 	}
 	return ""
 }
 
-func (x *ProducerItem) GetGroupOwnerSign() string {
+func (x *Snapshot) GetSnapshotId() string {
 	if x != nil {
-		return x.GroupOwnerSign
+		return x.SnapshotId
 	}
 	return ""
 }
 
-func (x *ProducerItem) GetTimeStamp() int64 {
+func (x *Snapshot) GetBlockId() string {
 	if x != nil {
-		return x.TimeStamp
+		return x.BlockId
+	}
+	return ""
+}
+
+func (x *Snapshot) GetHeight() int64 {
+	if x != nil {
+		return x.Height
 	}
 	return 0
 }
 
-func (x *ProducerItem) GetAction() ActionType {
+func (x *Snapshot) GetBlock() *Block {
 	if x != nil {
-		return x.Action
+		return x.Block
 	}
-	return ActionType_ADD
+	return nil
 }
 
-func (x *ProducerItem) GetBlockProduced() int64 {
+func (x *Snapshot) GetProducers() []*ProducerItem {
 	if x != nil {
-		return x.BlockProduced
+		return x.Producers
 	}
-	return 0
+	return nil
 }
 
-func (x *ProducerItem) GetMemo() string {
+func (x *Snapshot) GetAnnounces() []*AnnounceItem {
 	if x != nil {
-		return x.Memo
+		return x.Announces
+	}
+	return nil
+}
+
+func (x *Snapshot) GetDenyList() []*DenyUserItem {
+	if x != nil {
+		return x.DenyList
+	}
+	return nil
+}
+
+func (x *Snapshot) GetSchemas() []*SchemaItem {
+	if x != nil {
+		return x.Schemas
+	}
+	return nil
+}
+
+func (x *Snapshot) GetStakes() []*StakeItem {
+	if x != nil {
+		return x.Stakes
+	}
+	return nil
+}
+
+func (x *Snapshot) GetRequesterPubkey() string {
+	if x != nil {
+		return x.RequesterPubkey
 	}
 	return ""
 }
 
-type AnnounceItem struct {
+func (x *Snapshot) GetProducerPubkey() string {
+	if x != nil {
+		return x.ProducerPubkey
+	}
+	return ""
+}
+
+func (x *Snapshot) GetTimeStamp() int64 {
+	if x != nil {
+		return x.TimeStamp
+	}
+	return 0
+}
+
+func (x *Snapshot) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *Snapshot) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *Snapshot) GetGroupKeys() []*GroupKeyItem {
+	if x != nil {
+		return x.GroupKeys
+	}
+	return nil
+}
+
+func (x *Snapshot) GetRoles() []*RoleItem {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type PostItem struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GroupId            string       `protobuf:"bytes,1,opt,name=GroupId,proto3" json:"GroupId,omitempty"`
-	SignPubkey         string       `protobuf:"bytes,2,opt,name=SignPubkey,proto3" json:"SignPubkey,omitempty"`
-	EncryptPubkey      string       `protobuf:"bytes,3,opt,name=EncryptPubkey,proto3" json:"EncryptPubkey,omitempty"`
-	AnnouncerSignature string       `protobuf:"bytes,4,opt,name=AnnouncerSignature,proto3" json:"AnnouncerSignature,omitempty"`
-	Type               AnnounceType `protobuf:"varint,5,opt,name=Type,proto3,enum=quorum.pb.AnnounceType" json:"Type,omitempty"`
-	OwnerPubkey        string       `protobuf:"bytes,6,opt,name=OwnerPubkey,proto3" json:"OwnerPubkey,omitempty"`
-	OwnerSignature     string       `protobuf:"bytes,7,opt,name=OwnerSignature,proto3" json:"OwnerSignature,omitempty"`
-	Result             ApproveType  `protobuf:"varint,8,opt,name=Result,proto3,enum=quorum.pb.ApproveType" json:"Result,omitempty"`
-	TimeStamp          int64        `protobuf:"varint,9,opt,name=TimeStamp,proto3" json:"TimeStamp,omitempty,string"`
-	Action             ActionType   `protobuf:"varint,10,opt,name=Action,proto3,enum=quorum.pb.ActionType" json:"Action,omitempty"`
-	Memo               string       `protobuf:"bytes,11,opt,name=Memo,proto3" json:"Memo,omitempty"`
+	TrxId            string `protobuf:"bytes,1,opt,name=TrxId,proto3" json:"TrxId,omitempty"`
+	PublisherPubkey  string `protobuf:"bytes,2,opt,name=PublisherPubkey,proto3" json:"PublisherPubkey,omitempty"`
+	Content          []byte `protobuf:"bytes,3,opt,name=Content,proto3" json:"Content,omitempty"`
+	TimeStamp        int64  `protobuf:"varint,4,opt,name=TimeStamp,proto3" json:"TimeStamp,omitempty,string"`
+	UpdatedTrxId     string `protobuf:"bytes,5,opt,name=UpdatedTrxId,proto3" json:"UpdatedTrxId,omitempty"` //the last Update or Delete trx applied to the post
+	UpdatedTimeStamp int64  `protobuf:"varint,6,opt,name=UpdatedTimeStamp,proto3" json:"UpdatedTimeStamp,omitempty,string"`
+	Edited           bool   `protobuf:"varint,7,opt,name=Edited,proto3" json:"Edited,omitempty"`
+	Deleted          bool   `protobuf:"varint,8,opt,name=Deleted,proto3" json:"Deleted,omitempty"` //content of deleted post is removed
 }
 
-func (x *AnnounceItem) Reset() {
-	*x = AnnounceItem{}
+func (x *PostItem) Reset() {
+	*x = PostItem{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chain_proto_msgTypes[11]
+		mi := &file_chain_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AnnounceItem) String() string {
+func (x *PostItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AnnounceItem) ProtoMessage() {}
+func (*PostItem) ProtoMessage() {}
 
-func (x *AnnounceItem) ProtoReflect() protoreflect.Message {
-	mi := &file_chain_proto_msgTypes[11]
+func (x *PostItem) ProtoReflect() protoreflect.Message {
+	mi := &file_chain_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1391,119 +1786,1279 @@ func (x *AnnounceItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AnnounceItem.ProtoReflect.Descriptor instead.
-func (*AnnounceItem) Descriptor() ([]byte, []int) {
-	return file_chain_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use PostItem.ProtoReflect.Descriptor instead.
+func (*PostItem) Descriptor() ([]byte, []int) {
+	return file_chain_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *AnnounceItem) GetGroupId() string {
+func (x *PostItem) GetTrxId() string {
 	if x != nil {
-		return x.GroupId
+		return x.TrxId
 	}
 	return ""
 }
 
-func (x *AnnounceItem) GetSignPubkey() string {
+func (x *PostItem) GetPublisherPubkey() string {
 	if x != nil {
-		return x.SignPubkey
+		return x.PublisherPubkey
 	}
 	return ""
 }
 
-func (x *AnnounceItem) GetEncryptPubkey() string {
+func (x *PostItem) GetContent() []byte {
 	if x != nil {
-		return x.EncryptPubkey
+		return x.Content
 	}
-	return ""
+	return nil
 }
 
-func (x *AnnounceItem) GetAnnouncerSignature() string {
+func (x *PostItem) GetTimeStamp() int64 {
 	if x != nil {
-		return x.AnnouncerSignature
+		return x.TimeStamp
 	}
-	return ""
+	return 0
 }
 
-func (x *AnnounceItem) GetType() AnnounceType {
+func (x *PostItem) GetUpdatedTrxId() string {
 	if x != nil {
-		return x.Type
+		return x.UpdatedTrxId
 	}
-	return AnnounceType_AS_USER
+	return ""
 }
 
-func (x *AnnounceItem) GetOwnerPubkey() string {
+func (x *PostItem) GetUpdatedTimeStamp() int64 {
 	if x != nil {
-		return x.OwnerPubkey
+		return x.UpdatedTimeStamp
 	}
-	return ""
+	return 0
 }
 
-func (x *AnnounceItem) GetOwnerSignature() string {
+func (x *PostItem) GetEdited() bool {
 	if x != nil {
-		return x.OwnerSignature
+		return x.Edited
 	}
-	return ""
+	return false
 }
 
-func (x *AnnounceItem) GetResult() ApproveType {
+func (x *PostItem) GetDeleted() bool {
 	if x != nil {
-		return x.Result
+		return x.Deleted
 	}
-	return ApproveType_ANNOUNCED
+	return false
+}
+
+type DenyUserItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupId          string `protobuf:"bytes,1,opt,name=GroupId,proto3" json:"GroupId,omitempty"`
+	PeerId           string `protobuf:"bytes,2,opt,name=PeerId,proto3" json:"PeerId,omitempty"`
+	GroupOwnerPubkey string `protobuf:"bytes,3,opt,name=GroupOwnerPubkey,proto3" json:"GroupOwnerPubkey,omitempty"`
+	GroupOwnerSign   string `protobuf:"bytes,4,opt,name=GroupOwnerSign,proto3" json:"GroupOwnerSign,omitempty"`
+	TimeStamp        int64  `protobuf:"varint,5,opt,name=TimeStamp,proto3" json:"TimeStamp,omitempty,string"`
+	Action           string `protobuf:"bytes,6,opt,name=Action,proto3" json:"Action,omitempty"`
+	Memo             string `protobuf:"bytes,7,opt,name=Memo,proto3" json:"Memo,omitempty"`
+}
+
+func (x *DenyUserItem) Reset() {
+	*x = DenyUserItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chain_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DenyUserItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DenyUserItem) ProtoMessage() {}
+
+func (x *DenyUserItem) ProtoReflect() protoreflect.Message {
+	mi := &file_chain_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DenyUserItem.ProtoReflect.Descriptor instead.
+func (*DenyUserItem) Descriptor() ([]byte, []int) {
+	return file_chain_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DenyUserItem) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *DenyUserItem) GetPeerId() string {
+	if x != nil {
+		return x.PeerId
+	}
+	return ""
+}
+
+func (x *DenyUserItem) GetGroupOwnerPubkey() string {
+	if x != nil {
+		return x.GroupOwnerPubkey
+	}
+	return ""
+}
+
+func (x *DenyUserItem) GetGroupOwnerSign() string {
+	if x != nil {
+		return x.GroupOwnerSign
+	}
+	return ""
+}
+
+func (x *DenyUserItem) GetTimeStamp() int64 {
+	if x != nil {
+		return x.TimeStamp
+	}
+	return 0
+}
+
+func (x *DenyUserItem) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *DenyUserItem) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+type ProducerItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupId          string     `protobuf:"bytes,1,opt,name=GroupId,proto3" json:"GroupId,omitempty"`
+	ProducerPubkey   string     `protobuf:"bytes,2,opt,name=ProducerPubkey,proto3" json:"ProducerPubkey,omitempty"`
+	GroupOwnerPubkey string     `protobuf:"bytes,3,opt,name=GroupOwnerPubkey,proto3" json:"GroupOwnerPubkey,omitempty"`
+	GroupOwnerSign   string     `protobuf:"bytes,4,opt,name=GroupOwnerSign,proto3" json:"GroupOwnerSign,omitempty"`
+	TimeStamp        int64      `protobuf:"varint,5,opt,name=TimeStamp,proto3" json:"TimeStamp,omitempty,string"`
+	Action           ActionType `protobuf:"varint,6,opt,name=Action,proto3,enum=quorum.pb.ActionType" json:"Action,omitempty"`
+	BlockProduced    int64      `protobuf:"varint,7,opt,name=BlockProduced,proto3" json:"BlockProduced,omitempty"`
+	Memo             string     `protobuf:"bytes,8,opt,name=Memo,proto3" json:"Memo,omitempty"`
+	SignerPubkey     string     `protobuf:"bytes,9,opt,name=SignerPubkey,proto3" json:"SignerPubkey,omitempty"` //group owner or admin signed the item, empty is group owner
+}
+
+func (x *ProducerItem) Reset() {
+	*x = ProducerItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chain_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProducerItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProducerItem) ProtoMessage() {}
+
+func (x *ProducerItem) ProtoReflect() protoreflect.Message {
+	mi := &file_chain_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProducerItem.ProtoReflect.Descriptor instead.
+func (*ProducerItem) Descriptor() ([]byte, []int) {
+	return file_chain_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ProducerItem) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *ProducerItem) GetProducerPubkey() string {
+	if x != nil {
+		return x.ProducerPubkey
+	}
+	return ""
+}
+
+func (x *ProducerItem) GetGroupOwnerPubkey() string {
+	if x != nil {
+		return x.GroupOwnerPubkey
+	}
+	return ""
+}
+
+func (x *ProducerItem) GetGroupOwnerSign() string {
+	if x != nil {
+		return x.GroupOwnerSign
+	}
+	return ""
+}
+
+func (x *ProducerItem) GetTimeStamp() int64 {
+	if x != nil {
+		return x.TimeStamp
+	}
+	return 0
+}
+
+func (x *ProducerItem) GetAction() ActionType {
+	if x != nil {
+		return x.Action
+	}
+	return ActionType_ADD
+}
+
+func (x *ProducerItem) GetBlockProduced() int64 {
+	if x != nil {
+		return x.BlockProduced
+	}
+	return 0
+}
+
+func (x *ProducerItem) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *ProducerItem) GetSignerPubkey() string {
+	if x != nil {
+		return x.SignerPubkey
+	}
+	return ""
+}
+
+type StakeItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupId          string     `protobuf:"bytes,1,opt,name=GroupId,proto3" json:"GroupId,omitempty"`
+	ProducerPubkey   string     `protobuf:"bytes,2,opt,name=ProducerPubkey,proto3" json:"ProducerPubkey,omitempty"`
+	Stake            int64      `protobuf:"varint,3,opt,name=Stake,proto3" json:"Stake,omitempty"`
+	Slashed          bool       `protobuf:"varint,4,opt,name=Slashed,proto3" json:"Slashed,omitempty"`
+	GroupOwnerPubkey string     `protobuf:"bytes,5,opt,name=GroupOwnerPubkey,proto3" json:"GroupOwnerPubkey,omitempty"`
+	GroupOwnerSign   string     `protobuf:"bytes,6,opt,name=GroupOwnerSign,proto3" json:"GroupOwnerSign,omitempty"`
+	TimeStamp        int64      `protobuf:"varint,7,opt,name=TimeStamp,proto3" json:"TimeStamp,omitempty,string"`
+	Action           ActionType `protobuf:"varint,8,opt,name=Action,proto3,enum=quorum.pb.ActionType" json:"Action,omitempty"`
+	Memo             string     `protobuf:"bytes,9,opt,name=Memo,proto3" json:"Memo,omitempty"`
+	Evidence         []*Block   `protobuf:"bytes,10,rep,name=Evidence,proto3" json:"Evidence,omitempty"` //blocks produced by the slashed producer on the same parent
+}
+
+func (x *StakeItem) Reset() {
+	*x = StakeItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chain_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StakeItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StakeItem) ProtoMessage() {}
+
+func (x *StakeItem) ProtoReflect() protoreflect.Message {
+	mi := &file_chain_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StakeItem.ProtoReflect.Descriptor instead.
+func (*StakeItem) Descriptor() ([]byte, []int) {
+	return file_chain_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *StakeItem) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *StakeItem) GetProducerPubkey() string {
+	if x != nil {
+		return x.ProducerPubkey
+	}
+	return ""
+}
+
+func (x *StakeItem) GetStake() int64 {
+	if x != nil {
+		return x.Stake
+	}
+	return 0
+}
+
+func (x *StakeItem) GetSlashed() bool {
+	if x != nil {
+		return x.Slashed
+	}
+	return false
+}
+
+func (x *StakeItem) GetGroupOwnerPubkey() string {
+	if x != nil {
+		return x.GroupOwnerPubkey
+	}
+	return ""
+}
+
+func (x *StakeItem) GetGroupOwnerSign() string {
+	if x != nil {
+		return x.GroupOwnerSign
+	}
+	return ""
+}
+
+func (x *StakeItem) GetTimeStamp() int64 {
+	if x != nil {
+		return x.TimeStamp
+	}
+	return 0
+}
+
+func (x *StakeItem) GetAction() ActionType {
+	if x != nil {
+		return x.Action
+	}
+	return ActionType_ADD
+}
+
+func (x *StakeItem) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *StakeItem) GetEvidence() []*Block {
+	if x != nil {
+		return x.Evidence
+	}
+	return nil
+}
+
+type AnnounceItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupId            string       `protobuf:"bytes,1,opt,name=GroupId,proto3" json:"GroupId,omitempty"`
+	SignPubkey         string       `protobuf:"bytes,2,opt,name=SignPubkey,proto3" json:"SignPubkey,omitempty"`
+	EncryptPubkey      string       `protobuf:"bytes,3,opt,name=EncryptPubkey,proto3" json:"EncryptPubkey,omitempty"`
+	AnnouncerSignature string       `protobuf:"bytes,4,opt,name=AnnouncerSignature,proto3" json:"AnnouncerSignature,omitempty"`
+	Type               AnnounceType `protobuf:"varint,5,opt,name=Type,proto3,enum=quorum.pb.AnnounceType" json:"Type,omitempty"`
+	OwnerPubkey        string       `protobuf:"bytes,6,opt,name=OwnerPubkey,proto3" json:"OwnerPubkey,omitempty"`
+	OwnerSignature     string       `protobuf:"bytes,7,opt,name=OwnerSignature,proto3" json:"OwnerSignature,omitempty"`
+	Result             ApproveType  `protobuf:"varint,8,opt,name=Result,proto3,enum=quorum.pb.ApproveType" json:"Result,omitempty"`
+	TimeStamp          int64        `protobuf:"varint,9,opt,name=TimeStamp,proto3" json:"TimeStamp,omitempty,string"`
+	Action             ActionType   `protobuf:"varint,10,opt,name=Action,proto3,enum=quorum.pb.ActionType" json:"Action,omitempty"`
+	Memo               string       `protobuf:"bytes,11,opt,name=Memo,proto3" json:"Memo,omitempty"`
+}
+
+func (x *AnnounceItem) Reset() {
+	*x = AnnounceItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chain_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnnounceItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnnounceItem) ProtoMessage() {}
+
+func (x *AnnounceItem) ProtoReflect() protoreflect.Message {
+	mi := &file_chain_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnnounceItem.ProtoReflect.Descriptor instead.
+func (*AnnounceItem) Descriptor() ([]byte, []int) {
+	return file_chain_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AnnounceItem) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *AnnounceItem) GetSignPubkey() string {
+	if x != nil {
+		return x.SignPubkey
+	}
+	return ""
+}
+
+func (x *AnnounceItem) GetEncryptPubkey() string {
+	if x != nil {
+		return x.EncryptPubkey
+	}
+	return ""
+}
+
+func (x *AnnounceItem) GetAnnouncerSignature() string {
+	if x != nil {
+		return x.AnnouncerSignature
+	}
+	return ""
+}
+
+func (x *AnnounceItem) GetType() AnnounceType {
+	if x != nil {
+		return x.Type
+	}
+	return AnnounceType_AS_USER
+}
+
+func (x *AnnounceItem) GetOwnerPubkey() string {
+	if x != nil {
+		return x.OwnerPubkey
+	}
+	return ""
+}
+
+func (x *AnnounceItem) GetOwnerSignature() string {
+	if x != nil {
+		return x.OwnerSignature
+	}
+	return ""
+}
+
+func (x *AnnounceItem) GetResult() ApproveType {
+	if x != nil {
+		return x.Result
+	}
+	return ApproveType_ANNOUNCED
+}
+
+func (x *AnnounceItem) GetTimeStamp() int64 {
+	if x != nil {
+		return x.TimeStamp
+	}
+	return 0
+}
+
+func (x *AnnounceItem) GetAction() ActionType {
+	if x != nil {
+		return x.Action
+	}
+	return ActionType_ADD
+}
+
+func (x *AnnounceItem) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+type WrappedKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SignPubkey string `protobuf:"bytes,1,opt,name=SignPubkey,proto3" json:"SignPubkey,omitempty"`
+	Key        []byte `protobuf:"bytes,2,opt,name=Key,proto3" json:"Key,omitempty"`
+}
+
+func (x *WrappedKey) Reset() {
+	*x = WrappedKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chain_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WrappedKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WrappedKey) ProtoMessage() {}
+
+func (x *WrappedKey) ProtoReflect() protoreflect.Message {
+	mi := &file_chain_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WrappedKey.ProtoReflect.Descriptor instead.
+func (*WrappedKey) Descriptor() ([]byte, []int) {
+	return file_chain_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *WrappedKey) GetSignPubkey() string {
+	if x != nil {
+		return x.SignPubkey
+	}
+	return ""
+}
+
+func (x *WrappedKey) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+type GroupKeyItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupId      string        `protobuf:"bytes,1,opt,name=GroupId,proto3" json:"GroupId,omitempty"`
+	Epoch        int64         `protobuf:"varint,2,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
+	Keys         []*WrappedKey `protobuf:"bytes,3,rep,name=Keys,proto3" json:"Keys,omitempty"`
+	RemovedUsers []string      `protobuf:"bytes,4,rep,name=RemovedUsers,proto3" json:"RemovedUsers,omitempty"`
+	OwnerPubkey  string        `protobuf:"bytes,5,opt,name=OwnerPubkey,proto3" json:"OwnerPubkey,omitempty"`
+	TimeStamp    int64         `protobuf:"varint,6,opt,name=TimeStamp,proto3" json:"TimeStamp,omitempty,string"`
+	Memo         string        `protobuf:"bytes,7,opt,name=Memo,proto3" json:"Memo,omitempty"`
+}
+
+func (x *GroupKeyItem) Reset() {
+	*x = GroupKeyItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chain_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupKeyItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupKeyItem) ProtoMessage() {}
+
+func (x *GroupKeyItem) ProtoReflect() protoreflect.Message {
+	mi := &file_chain_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupKeyItem.ProtoReflect.Descriptor instead.
+func (*GroupKeyItem) Descriptor() ([]byte, []int) {
+	return file_chain_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GroupKeyItem) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GroupKeyItem) GetEpoch() int64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *GroupKeyItem) GetKeys() []*WrappedKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *GroupKeyItem) GetRemovedUsers() []string {
+	if x != nil {
+		return x.RemovedUsers
+	}
+	return nil
+}
+
+func (x *GroupKeyItem) GetOwnerPubkey() string {
+	if x != nil {
+		return x.OwnerPubkey
+	}
+	return ""
+}
+
+func (x *GroupKeyItem) GetTimeStamp() int64 {
+	if x != nil {
+		return x.TimeStamp
+	}
+	return 0
+}
+
+func (x *GroupKeyItem) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+type RoleItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupId       string     `protobuf:"bytes,1,opt,name=GroupId,proto3" json:"GroupId,omitempty"`
+	UserPubkey    string     `protobuf:"bytes,2,opt,name=UserPubkey,proto3" json:"UserPubkey,omitempty"`
+	Role          GroupRole  `protobuf:"varint,3,opt,name=Role,proto3,enum=quorum.pb.GroupRole" json:"Role,omitempty"`
+	GranterPubkey string     `protobuf:"bytes,4,opt,name=GranterPubkey,proto3" json:"GranterPubkey,omitempty"`
+	GranterSign   string     `protobuf:"bytes,5,opt,name=GranterSign,proto3" json:"GranterSign,omitempty"`
+	TimeStamp     int64      `protobuf:"varint,6,opt,name=TimeStamp,proto3" json:"TimeStamp,omitempty,string"`
+	Action        ActionType `protobuf:"varint,7,opt,name=Action,proto3,enum=quorum.pb.ActionType" json:"Action,omitempty"`
+	Memo          string     `protobuf:"bytes,8,opt,name=Memo,proto3" json:"Memo,omitempty"`
+}
+
+func (x *RoleItem) Reset() {
+	*x = RoleItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chain_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoleItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleItem) ProtoMessage() {}
+
+func (x *RoleItem) ProtoReflect() protoreflect.Message {
+	mi := &file_chain_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleItem.ProtoReflect.Descriptor instead.
+func (*RoleItem) Descriptor() ([]byte, []int) {
+	return file_chain_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RoleItem) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *RoleItem) GetUserPubkey() string {
+	if x != nil {
+		return x.UserPubkey
+	}
+	return ""
+}
+
+func (x *RoleItem) GetRole() GroupRole {
+	if x != nil {
+		return x.Role
+	}
+	return GroupRole_READER
+}
+
+func (x *RoleItem) GetGranterPubkey() string {
+	if x != nil {
+		return x.GranterPubkey
+	}
+	return ""
+}
+
+func (x *RoleItem) GetGranterSign() string {
+	if x != nil {
+		return x.GranterSign
+	}
+	return ""
+}
+
+func (x *RoleItem) GetTimeStamp() int64 {
+	if x != nil {
+		return x.TimeStamp
+	}
+	return 0
+}
+
+func (x *RoleItem) GetAction() ActionType {
+	if x != nil {
+		return x.Action
+	}
+	return ActionType_ADD
+}
+
+func (x *RoleItem) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+type SchemaItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupId          string     `protobuf:"bytes,1,opt,name=GroupId,proto3" json:"GroupId,omitempty"`
+	GroupOwnerPubkey string     `protobuf:"bytes,2,opt,name=GroupOwnerPubkey,proto3" json:"GroupOwnerPubkey,omitempty"`
+	GroupOwnerSign   string     `protobuf:"bytes,3,opt,name=GroupOwnerSign,proto3" json:"GroupOwnerSign,omitempty"`
+	Type             string     `protobuf:"bytes,4,opt,name=Type,proto3" json:"Type,omitempty"`
+	Rule             string     `protobuf:"bytes,5,opt,name=Rule,proto3" json:"Rule,omitempty"`
+	TimeStamp        int64      `protobuf:"varint,6,opt,name=TimeStamp,proto3" json:"TimeStamp,omitempty,string"`
+	Action           ActionType `protobuf:"varint,7,opt,name=Action,proto3,enum=quorum.pb.ActionType" json:"Action,omitempty"`
+	SignerPubkey     string     `protobuf:"bytes,8,opt,name=SignerPubkey,proto3" json:"SignerPubkey,omitempty"` //group owner or admin signed the item, empty is group owner
+}
+
+func (x *SchemaItem) Reset() {
+	*x = SchemaItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chain_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SchemaItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaItem) ProtoMessage() {}
+
+func (x *SchemaItem) ProtoReflect() protoreflect.Message {
+	mi := &file_chain_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaItem.ProtoReflect.Descriptor instead.
+func (*SchemaItem) Descriptor() ([]byte, []int) {
+	return file_chain_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SchemaItem) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *SchemaItem) GetGroupOwnerPubkey() string {
+	if x != nil {
+		return x.GroupOwnerPubkey
+	}
+	return ""
+}
+
+func (x *SchemaItem) GetGroupOwnerSign() string {
+	if x != nil {
+		return x.GroupOwnerSign
+	}
+	return ""
+}
+
+func (x *SchemaItem) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SchemaItem) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *SchemaItem) GetTimeStamp() int64 {
+	if x != nil {
+		return x.TimeStamp
+	}
+	return 0
+}
+
+func (x *SchemaItem) GetAction() ActionType {
+	if x != nil {
+		return x.Action
+	}
+	return ActionType_ADD
+}
+
+func (x *SchemaItem) GetSignerPubkey() string {
+	if x != nil {
+		return x.SignerPubkey
+	}
+	return ""
+}
+
+type GroupItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupId           string            `protobuf:"bytes,1,opt,name=GroupId,proto3" json:"GroupId,omitempty"`
+	GroupName         string            `protobuf:"bytes,2,opt,name=GroupName,proto3" json:"GroupName,omitempty"`
+	OwnerPubKey       string            `protobuf:"bytes,3,opt,name=OwnerPubKey,proto3" json:"OwnerPubKey,omitempty"`
+	UserSignPubkey    string            `protobuf:"bytes,4,opt,name=UserSignPubkey,proto3" json:"UserSignPubkey,omitempty"`
+	UserEncryptPubkey string            `protobuf:"bytes,5,opt,name=UserEncryptPubkey,proto3" json:"UserEncryptPubkey,omitempty"`
+	LastUpdate        int64             `protobuf:"varint,6,opt,name=LastUpdate,proto3" json:"LastUpdate,omitempty"`
+	HighestHeight     int64             `protobuf:"varint,7,opt,name=HighestHeight,proto3" json:"HighestHeight,omitempty"`
+	HighestBlockId    string            `protobuf:"bytes,8,opt,name=HighestBlockId,proto3" json:"HighestBlockId,omitempty"`
+	GenesisBlock      *Block            `protobuf:"bytes,9,opt,name=GenesisBlock,proto3" json:"GenesisBlock,omitempty"`
+	EncryptType       GroupEncryptType  `protobuf:"varint,10,opt,name=EncryptType,proto3,enum=quorum.pb.GroupEncryptType" json:"EncryptType,omitempty"`
+	ConsenseType      GroupConsenseType `protobuf:"varint,11,opt,name=ConsenseType,proto3,enum=quorum.pb.GroupConsenseType" json:"ConsenseType,omitempty"`
+	CipherKey         string            `protobuf:"bytes,12,opt,name=CipherKey,proto3" json:"CipherKey,omitempty"`
+	AppKey            string            `protobuf:"bytes,13,opt,name=AppKey,proto3" json:"AppKey,omitempty"`
+	KeyEpoch          int64             `protobuf:"varint,14,opt,name=KeyEpoch,proto3" json:"KeyEpoch,omitempty"`
+}
+
+func (x *GroupItem) Reset() {
+	*x = GroupItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chain_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupItem) ProtoMessage() {}
+
+func (x *GroupItem) ProtoReflect() protoreflect.Message {
+	mi := &file_chain_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupItem.ProtoReflect.Descriptor instead.
+func (*GroupItem) Descriptor() ([]byte, []int) {
+	return file_chain_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GroupItem) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GroupItem) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *GroupItem) GetOwnerPubKey() string {
+	if x != nil {
+		return x.OwnerPubKey
+	}
+	return ""
+}
+
+func (x *GroupItem) GetUserSignPubkey() string {
+	if x != nil {
+		return x.UserSignPubkey
+	}
+	return ""
+}
+
+func (x *GroupItem) GetUserEncryptPubkey() string {
+	if x != nil {
+		return x.UserEncryptPubkey
+	}
+	return ""
+}
+
+func (x *GroupItem) GetLastUpdate() int64 {
+	if x != nil {
+		return x.LastUpdate
+	}
+	return 0
+}
+
+func (x *GroupItem) GetHighestHeight() int64 {
+	if x != nil {
+		return x.HighestHeight
+	}
+	return 0
+}
+
+func (x *GroupItem) GetHighestBlockId() string {
+	if x != nil {
+		return x.HighestBlockId
+	}
+	return ""
+}
+
+func (x *GroupItem) GetGenesisBlock() *Block {
+	if x != nil {
+		return x.GenesisBlock
+	}
+	return nil
+}
+
+func (x *GroupItem) GetEncryptType() GroupEncryptType {
+	if x != nil {
+		return x.EncryptType
+	}
+	return GroupEncryptType_PUBLIC
+}
+
+func (x *GroupItem) GetConsenseType() GroupConsenseType {
+	if x != nil {
+		return x.ConsenseType
+	}
+	return GroupConsenseType_POA
+}
+
+func (x *GroupItem) GetCipherKey() string {
+	if x != nil {
+		return x.CipherKey
+	}
+	return ""
+}
+
+func (x *GroupItem) GetAppKey() string {
+	if x != nil {
+		return x.AppKey
+	}
+	return ""
+}
+
+func (x *GroupItem) GetKeyEpoch() int64 {
+	if x != nil {
+		return x.KeyEpoch
+	}
+	return 0
+}
+
+type GroupItemV0 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupId           string            `protobuf:"bytes,1,opt,name=GroupId,proto3" json:"GroupId,omitempty"`
+	GroupName         string            `protobuf:"bytes,2,opt,name=GroupName,proto3" json:"GroupName,omitempty"`
+	OwnerPubKey       string            `protobuf:"bytes,3,opt,name=OwnerPubKey,proto3" json:"OwnerPubKey,omitempty"`
+	UserSignPubkey    string            `protobuf:"bytes,4,opt,name=UserSignPubkey,proto3" json:"UserSignPubkey,omitempty"`
+	UserEncryptPubkey string            `protobuf:"bytes,5,opt,name=UserEncryptPubkey,proto3" json:"UserEncryptPubkey,omitempty"`
+	UserRole          RoleV0            `protobuf:"varint,6,opt,name=UserRole,proto3,enum=quorum.pb.RoleV0" json:"UserRole,omitempty"`
+	LastUpdate        int64             `protobuf:"varint,7,opt,name=LastUpdate,proto3" json:"LastUpdate,omitempty"`
+	HighestHeight     int64             `protobuf:"varint,8,opt,name=HighestHeight,proto3" json:"HighestHeight,omitempty"`
+	HighestBlockId    string            `protobuf:"bytes,9,opt,name=HighestBlockId,proto3" json:"HighestBlockId,omitempty"`
+	GenesisBlock      *Block            `protobuf:"bytes,10,opt,name=GenesisBlock,proto3" json:"GenesisBlock,omitempty"`
+	EncryptType       GroupEncryptType  `protobuf:"varint,11,opt,name=EncryptType,proto3,enum=quorum.pb.GroupEncryptType" json:"EncryptType,omitempty"`
+	ConsenseType      GroupConsenseType `protobuf:"varint,12,opt,name=ConsenseType,proto3,enum=quorum.pb.GroupConsenseType" json:"ConsenseType,omitempty"`
+	CipherKey         string            `protobuf:"bytes,13,opt,name=CipherKey,proto3" json:"CipherKey,omitempty"`
+	AppKey            string            `protobuf:"bytes,14,opt,name=AppKey,proto3" json:"AppKey,omitempty"`
+}
+
+func (x *GroupItemV0) Reset() {
+	*x = GroupItemV0{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chain_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupItemV0) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupItemV0) ProtoMessage() {}
+
+func (x *GroupItemV0) ProtoReflect() protoreflect.Message {
+	mi := &file_chain_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupItemV0.ProtoReflect.Descriptor instead.
+func (*GroupItemV0) Descriptor() ([]byte, []int) {
+	return file_chain_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GroupItemV0) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GroupItemV0) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *GroupItemV0) GetOwnerPubKey() string {
+	if x != nil {
+		return x.OwnerPubKey
+	}
+	return ""
+}
+
+func (x *GroupItemV0) GetUserSignPubkey() string {
+	if x != nil {
+		return x.UserSignPubkey
+	}
+	return ""
+}
+
+func (x *GroupItemV0) GetUserEncryptPubkey() string {
+	if x != nil {
+		return x.UserEncryptPubkey
+	}
+	return ""
+}
+
+func (x *GroupItemV0) GetUserRole() RoleV0 {
+	if x != nil {
+		return x.UserRole
+	}
+	return RoleV0_GROUP_PRODUCER
+}
+
+func (x *GroupItemV0) GetLastUpdate() int64 {
+	if x != nil {
+		return x.LastUpdate
+	}
+	return 0
+}
+
+func (x *GroupItemV0) GetHighestHeight() int64 {
+	if x != nil {
+		return x.HighestHeight
+	}
+	return 0
+}
+
+func (x *GroupItemV0) GetHighestBlockId() string {
+	if x != nil {
+		return x.HighestBlockId
+	}
+	return ""
+}
+
+func (x *GroupItemV0) GetGenesisBlock() *Block {
+	if x != nil {
+		return x.GenesisBlock
+	}
+	return nil
+}
+
+func (x *GroupItemV0) GetEncryptType() GroupEncryptType {
+	if x != nil {
+		return x.EncryptType
+	}
+	return GroupEncryptType_PUBLIC
+}
+
+func (x *GroupItemV0) GetConsenseType() GroupConsenseType {
+	if x != nil {
+		return x.ConsenseType
+	}
+	return GroupConsenseType_POA
+}
+
+func (x *GroupItemV0) GetCipherKey() string {
+	if x != nil {
+		return x.CipherKey
+	}
+	return ""
+}
+
+func (x *GroupItemV0) GetAppKey() string {
+	if x != nil {
+		return x.AppKey
+	}
+	return ""
+}
+
+type PSPing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seqnum    int32  `protobuf:"varint,1,opt,name=Seqnum,proto3" json:"Seqnum,omitempty"`
+	IsResp    bool   `protobuf:"varint,2,opt,name=IsResp,proto3" json:"IsResp,omitempty"`
+	TimeStamp int64  `protobuf:"varint,3,opt,name=TimeStamp,proto3" json:"TimeStamp,omitempty,string"`
+	Payload   []byte `protobuf:"bytes,4,opt,name=Payload,proto3" json:"Payload,omitempty"`
+}
+
+func (x *PSPing) Reset() {
+	*x = PSPing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chain_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PSPing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PSPing) ProtoMessage() {}
+
+func (x *PSPing) ProtoReflect() protoreflect.Message {
+	mi := &file_chain_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PSPing.ProtoReflect.Descriptor instead.
+func (*PSPing) Descriptor() ([]byte, []int) {
+	return file_chain_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PSPing) GetSeqnum() int32 {
+	if x != nil {
+		return x.Seqnum
+	}
+	return 0
+}
+
+func (x *PSPing) GetIsResp() bool {
+	if x != nil {
+		return x.IsResp
+	}
+	return false
 }
 
-func (x *AnnounceItem) GetTimeStamp() int64 {
+func (x *PSPing) GetTimeStamp() int64 {
 	if x != nil {
 		return x.TimeStamp
 	}
 	return 0
 }
 
-func (x *AnnounceItem) GetAction() ActionType {
-	if x != nil {
-		return x.Action
-	}
-	return ActionType_ADD
-}
-
-func (x *AnnounceItem) GetMemo() string {
+func (x *PSPing) GetPayload() []byte {
 	if x != nil {
-		return x.Memo
+		return x.Payload
 	}
-	return ""
+	return nil
 }
 
-type SchemaItem struct {
+type WebhookItem struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GroupId          string     `protobuf:"bytes,1,opt,name=GroupId,proto3" json:"GroupId,omitempty"`
-	GroupOwnerPubkey string     `protobuf:"bytes,2,opt,name=GroupOwnerPubkey,proto3" json:"GroupOwnerPubkey,omitempty"`
-	GroupOwnerSign   string     `protobuf:"bytes,3,opt,name=GroupOwnerSign,proto3" json:"GroupOwnerSign,omitempty"`
-	Type             string     `protobuf:"bytes,4,opt,name=Type,proto3" json:"Type,omitempty"`
-	Rule             string     `protobuf:"bytes,5,opt,name=Rule,proto3" json:"Rule,omitempty"`
-	TimeStamp        int64      `protobuf:"varint,6,opt,name=TimeStamp,proto3" json:"TimeStamp,omitempty,string"`
-	Action           ActionType `protobuf:"varint,7,opt,name=Action,proto3,enum=quorum.pb.ActionType" json:"Action,omitempty"`
+	WebhookId string    `protobuf:"bytes,1,opt,name=WebhookId,proto3" json:"WebhookId,omitempty"`
+	Url       string    `protobuf:"bytes,2,opt,name=Url,proto3" json:"Url,omitempty"`
+	GroupId   string    `protobuf:"bytes,3,opt,name=GroupId,proto3" json:"GroupId,omitempty"`
+	TrxTypes  []TrxType `protobuf:"varint,4,rep,packed,name=TrxTypes,proto3,enum=quorum.pb.TrxType" json:"TrxTypes,omitempty"`
+	Secret    string    `protobuf:"bytes,5,opt,name=Secret,proto3" json:"Secret,omitempty"`
+	Memo      string    `protobuf:"bytes,6,opt,name=Memo,proto3" json:"Memo,omitempty"`
+	TimeStamp int64     `protobuf:"varint,7,opt,name=TimeStamp,proto3" json:"TimeStamp,omitempty,string"`
 }
 
-func (x *SchemaItem) Reset() {
-	*x = SchemaItem{}
+func (x *WebhookItem) Reset() {
+	*x = WebhookItem{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chain_proto_msgTypes[12]
+		mi := &file_chain_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SchemaItem) String() string {
+func (x *WebhookItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SchemaItem) ProtoMessage() {}
+func (*WebhookItem) ProtoMessage() {}
 
-func (x *SchemaItem) ProtoReflect() protoreflect.Message {
-	mi := &file_chain_proto_msgTypes[12]
+func (x *WebhookItem) ProtoReflect() protoreflect.Message {
+	mi := &file_chain_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1514,97 +3069,93 @@ func (x *SchemaItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SchemaItem.ProtoReflect.Descriptor instead.
-func (*SchemaItem) Descriptor() ([]byte, []int) {
-	return file_chain_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use WebhookItem.ProtoReflect.Descriptor instead.
+func (*WebhookItem) Descriptor() ([]byte, []int) {
+	return file_chain_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *SchemaItem) GetGroupId() string {
+func (x *WebhookItem) GetWebhookId() string {
 	if x != nil {
-		return x.GroupId
+		return x.WebhookId
 	}
 	return ""
 }
 
-func (x *SchemaItem) GetGroupOwnerPubkey() string {
+func (x *WebhookItem) GetUrl() string {
 	if x != nil {
-		return x.GroupOwnerPubkey
+		return x.Url
 	}
 	return ""
 }
 
-func (x *SchemaItem) GetGroupOwnerSign() string {
+func (x *WebhookItem) GetGroupId() string {
 	if x != nil {
-		return x.GroupOwnerSign
+		return x.GroupId
 	}
 	return ""
 }
 
-func (x *SchemaItem) GetType() string {
+func (x *WebhookItem) GetTrxTypes() []TrxType {
 	if x != nil {
-		return x.Type
+		return x.TrxTypes
 	}
-	return ""
+	return nil
 }
 
-func (x *SchemaItem) GetRule() string {
+func (x *WebhookItem) GetSecret() string {
 	if x != nil {
-		return x.Rule
+		return x.Secret
 	}
 	return ""
 }
 
-func (x *SchemaItem) GetTimeStamp() int64 {
+func (x *WebhookItem) GetMemo() string {
 	if x != nil {
-		return x.TimeStamp
+		return x.Memo
 	}
-	return 0
+	return ""
 }
 
-func (x *SchemaItem) GetAction() ActionType {
+func (x *WebhookItem) GetTimeStamp() int64 {
 	if x != nil {
-		return x.Action
+		return x.TimeStamp
 	}
-	return ActionType_ADD
+	return 0
 }
 
-type GroupItem struct {
+type WebhookDeadLetter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GroupId           string            `protobuf:"bytes,1,opt,name=GroupId,proto3" json:"GroupId,omitempty"`
-	GroupName         string            `protobuf:"bytes,2,opt,name=GroupName,proto3" json:"GroupName,omitempty"`
-	OwnerPubKey       string            `protobuf:"bytes,3,opt,name=OwnerPubKey,proto3" json:"OwnerPubKey,omitempty"`
-	UserSignPubkey    string            `protobuf:"bytes,4,opt,name=UserSignPubkey,proto3" json:"UserSignPubkey,omitempty"`
-	UserEncryptPubkey string            `protobuf:"bytes,5,opt,name=UserEncryptPubkey,proto3" json:"UserEncryptPubkey,omitempty"`
-	LastUpdate        int64             `protobuf:"varint,6,opt,name=LastUpdate,proto3" json:"LastUpdate,omitempty"`
-	HighestHeight     int64             `protobuf:"varint,7,opt,name=HighestHeight,proto3" json:"HighestHeight,omitempty"`
-	HighestBlockId    string            `protobuf:"bytes,8,opt,name=HighestBlockId,proto3" json:"HighestBlockId,omitempty"`
-	GenesisBlock      *Block            `protobuf:"bytes,9,opt,name=GenesisBlock,proto3" json:"GenesisBlock,omitempty"`
-	EncryptType       GroupEncryptType  `protobuf:"varint,10,opt,name=EncryptType,proto3,enum=quorum.pb.GroupEncryptType" json:"EncryptType,omitempty"`
-	ConsenseType      GroupConsenseType `protobuf:"varint,11,opt,name=ConsenseType,proto3,enum=quorum.pb.GroupConsenseType" json:"ConsenseType,omitempty"`
-	CipherKey         string            `protobuf:"bytes,12,opt,name=CipherKey,proto3" json:"CipherKey,omitempty"`
-	AppKey            string            `protobuf:"bytes,13,opt,name=AppKey,proto3" json:"AppKey,omitempty"`
+	WebhookId  string  `protobuf:"bytes,1,opt,name=WebhookId,proto3" json:"WebhookId,omitempty"`
+	DeliveryId string  `protobuf:"bytes,2,opt,name=DeliveryId,proto3" json:"DeliveryId,omitempty"`
+	GroupId    string  `protobuf:"bytes,3,opt,name=GroupId,proto3" json:"GroupId,omitempty"`
+	TrxId      string  `protobuf:"bytes,4,opt,name=TrxId,proto3" json:"TrxId,omitempty"`
+	TrxType    TrxType `protobuf:"varint,5,opt,name=TrxType,proto3,enum=quorum.pb.TrxType" json:"TrxType,omitempty"`
+	Payload    []byte  `protobuf:"bytes,6,opt,name=Payload,proto3" json:"Payload,omitempty"`
+	Attempts   int32   `protobuf:"varint,7,opt,name=Attempts,proto3" json:"Attempts,omitempty"`
+	LastError  string  `protobuf:"bytes,8,opt,name=LastError,proto3" json:"LastError,omitempty"`
+	TimeStamp  int64   `protobuf:"varint,9,opt,name=TimeStamp,proto3" json:"TimeStamp,omitempty,string"`
 }
 
-func (x *GroupItem) Reset() {
-	*x = GroupItem{}
+func (x *WebhookDeadLetter) Reset() {
+	*x = WebhookDeadLetter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chain_proto_msgTypes[13]
+		mi := &file_chain_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GroupItem) String() string {
+func (x *WebhookDeadLetter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupItem) ProtoMessage() {}
+func (*WebhookDeadLetter) ProtoMessage() {}
 
-func (x *GroupItem) ProtoReflect() protoreflect.Message {
-	mi := &file_chain_proto_msgTypes[13]
+func (x *WebhookDeadLetter) ProtoReflect() protoreflect.Message {
+	mi := &file_chain_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1615,140 +3166,176 @@ func (x *GroupItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupItem.ProtoReflect.Descriptor instead.
-func (*GroupItem) Descriptor() ([]byte, []int) {
-	return file_chain_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use WebhookDeadLetter.ProtoReflect.Descriptor instead.
+func (*WebhookDeadLetter) Descriptor() ([]byte, []int) {
+	return file_chain_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *GroupItem) GetGroupId() string {
+func (x *WebhookDeadLetter) GetWebhookId() string {
 	if x != nil {
-		return x.GroupId
+		return x.WebhookId
 	}
 	return ""
 }
 
-func (x *GroupItem) GetGroupName() string {
+func (x *WebhookDeadLetter) GetDeliveryId() string {
 	if x != nil {
-		return x.GroupName
+		return x.DeliveryId
 	}
 	return ""
 }
 
-func (x *GroupItem) GetOwnerPubKey() string {
+func (x *WebhookDeadLetter) GetGroupId() string {
 	if x != nil {
-		return x.OwnerPubKey
+		return x.GroupId
 	}
 	return ""
 }
 
-func (x *GroupItem) GetUserSignPubkey() string {
+func (x *WebhookDeadLetter) GetTrxId() string {
 	if x != nil {
-		return x.UserSignPubkey
+		return x.TrxId
 	}
 	return ""
 }
 
-func (x *GroupItem) GetUserEncryptPubkey() string {
+func (x *WebhookDeadLetter) GetTrxType() TrxType {
 	if x != nil {
-		return x.UserEncryptPubkey
+		return x.TrxType
 	}
-	return ""
+	return TrxType_POST
 }
 
-func (x *GroupItem) GetLastUpdate() int64 {
+func (x *WebhookDeadLetter) GetPayload() []byte {
 	if x != nil {
-		return x.LastUpdate
+		return x.Payload
 	}
-	return 0
+	return nil
 }
 
-func (x *GroupItem) GetHighestHeight() int64 {
+func (x *WebhookDeadLetter) GetAttempts() int32 {
 	if x != nil {
-		return x.HighestHeight
+		return x.Attempts
 	}
 	return 0
 }
 
-func (x *GroupItem) GetHighestBlockId() string {
+func (x *WebhookDeadLetter) GetLastError() string {
 	if x != nil {
-		return x.HighestBlockId
+		return x.LastError
 	}
 	return ""
 }
 
-func (x *GroupItem) GetGenesisBlock() *Block {
+func (x *WebhookDeadLetter) GetTimeStamp() int64 {
 	if x != nil {
-		return x.GenesisBlock
+		return x.TimeStamp
 	}
-	return nil
+	return 0
 }
 
-func (x *GroupItem) GetEncryptType() GroupEncryptType {
+type GroupArchive struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version   int32      `protobuf:"varint,1,opt,name=Version,proto3" json:"Version,omitempty"`
+	GroupItem *GroupItem `protobuf:"bytes,2,opt,name=GroupItem,proto3" json:"GroupItem,omitempty"` //without user keys and chain info of the exporting node
+	Blocks    []*Block   `protobuf:"bytes,3,rep,name=Blocks,proto3" json:"Blocks,omitempty"`       //blocks except the genesis block, parent block comes first
+	TimeStamp int64      `protobuf:"varint,4,opt,name=TimeStamp,proto3" json:"TimeStamp,omitempty,string"`
+}
+
+func (x *GroupArchive) Reset() {
+	*x = GroupArchive{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chain_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupArchive) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupArchive) ProtoMessage() {}
+
+func (x *GroupArchive) ProtoReflect() protoreflect.Message {
+	mi := &file_chain_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupArchive.ProtoReflect.Descriptor instead.
+func (*GroupArchive) Descriptor() ([]byte, []int) {
+	return file_chain_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GroupArchive) GetVersion() int32 {
 	if x != nil {
-		return x.EncryptType
+		return x.Version
 	}
-	return GroupEncryptType_PUBLIC
+	return 0
 }
 
-func (x *GroupItem) GetConsenseType() GroupConsenseType {
+func (x *GroupArchive) GetGroupItem() *GroupItem {
 	if x != nil {
-		return x.ConsenseType
+		return x.GroupItem
 	}
-	return GroupConsenseType_POA
+	return nil
 }
 
-func (x *GroupItem) GetCipherKey() string {
+func (x *GroupArchive) GetBlocks() []*Block {
 	if x != nil {
-		return x.CipherKey
+		return x.Blocks
 	}
-	return ""
+	return nil
 }
 
-func (x *GroupItem) GetAppKey() string {
+func (x *GroupArchive) GetTimeStamp() int64 {
 	if x != nil {
-		return x.AppKey
+		return x.TimeStamp
 	}
-	return ""
+	return 0
 }
 
-type GroupItemV0 struct {
+type FileManifest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GroupId           string            `protobuf:"bytes,1,opt,name=GroupId,proto3" json:"GroupId,omitempty"`
-	GroupName         string            `protobuf:"bytes,2,opt,name=GroupName,proto3" json:"GroupName,omitempty"`
-	OwnerPubKey       string            `protobuf:"bytes,3,opt,name=OwnerPubKey,proto3" json:"OwnerPubKey,omitempty"`
-	UserSignPubkey    string            `protobuf:"bytes,4,opt,name=UserSignPubkey,proto3" json:"UserSignPubkey,omitempty"`
-	UserEncryptPubkey string            `protobuf:"bytes,5,opt,name=UserEncryptPubkey,proto3" json:"UserEncryptPubkey,omitempty"`
-	UserRole          RoleV0            `protobuf:"varint,6,opt,name=UserRole,proto3,enum=quorum.pb.RoleV0" json:"UserRole,omitempty"`
-	LastUpdate        int64             `protobuf:"varint,7,opt,name=LastUpdate,proto3" json:"LastUpdate,omitempty"`
-	HighestHeight     int64             `protobuf:"varint,8,opt,name=HighestHeight,proto3" json:"HighestHeight,omitempty"`
-	HighestBlockId    string            `protobuf:"bytes,9,opt,name=HighestBlockId,proto3" json:"HighestBlockId,omitempty"`
-	GenesisBlock      *Block            `protobuf:"bytes,10,opt,name=GenesisBlock,proto3" json:"GenesisBlock,omitempty"`
-	EncryptType       GroupEncryptType  `protobuf:"varint,11,opt,name=EncryptType,proto3,enum=quorum.pb.GroupEncryptType" json:"EncryptType,omitempty"`
-	ConsenseType      GroupConsenseType `protobuf:"varint,12,opt,name=ConsenseType,proto3,enum=quorum.pb.GroupConsenseType" json:"ConsenseType,omitempty"`
-	CipherKey         string            `protobuf:"bytes,13,opt,name=CipherKey,proto3" json:"CipherKey,omitempty"`
-	AppKey            string            `protobuf:"bytes,14,opt,name=AppKey,proto3" json:"AppKey,omitempty"`
+	Name      string   `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	MediaType string   `protobuf:"bytes,2,opt,name=MediaType,proto3" json:"MediaType,omitempty"`
+	Size      int64    `protobuf:"varint,3,opt,name=Size,proto3" json:"Size,omitempty"`           //size of the file
+	Sha256    string   `protobuf:"bytes,4,opt,name=Sha256,proto3" json:"Sha256,omitempty"`        //hex sha256 of the file
+	ChunkSize int64    `protobuf:"varint,5,opt,name=ChunkSize,proto3" json:"ChunkSize,omitempty"` //size of plain chunks, the last chunk may be smaller
+	KeyEpoch  int64    `protobuf:"varint,6,opt,name=KeyEpoch,proto3" json:"KeyEpoch,omitempty"`   //chunks are encrypted by the cipher key of this key epoch
+	Chunks    []string `protobuf:"bytes,7,rep,name=Chunks,proto3" json:"Chunks,omitempty"`        //hex sha256 of the encrypted chunks, in order
 }
 
-func (x *GroupItemV0) Reset() {
-	*x = GroupItemV0{}
+func (x *FileManifest) Reset() {
+	*x = FileManifest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chain_proto_msgTypes[14]
+		mi := &file_chain_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GroupItemV0) String() string {
+func (x *FileManifest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupItemV0) ProtoMessage() {}
+func (*FileManifest) ProtoMessage() {}
 
-func (x *GroupItemV0) ProtoReflect() protoreflect.Message {
-	mi := &file_chain_proto_msgTypes[14]
+func (x *FileManifest) ProtoReflect() protoreflect.Message {
+	mi := &file_chain_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1759,137 +3346,207 @@ func (x *GroupItemV0) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupItemV0.ProtoReflect.Descriptor instead.
-func (*GroupItemV0) Descriptor() ([]byte, []int) {
-	return file_chain_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use FileManifest.ProtoReflect.Descriptor instead.
+func (*FileManifest) Descriptor() ([]byte, []int) {
+	return file_chain_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *GroupItemV0) GetGroupId() string {
+func (x *FileManifest) GetName() string {
 	if x != nil {
-		return x.GroupId
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GroupItemV0) GetGroupName() string {
+func (x *FileManifest) GetMediaType() string {
 	if x != nil {
-		return x.GroupName
+		return x.MediaType
 	}
 	return ""
 }
 
-func (x *GroupItemV0) GetOwnerPubKey() string {
+func (x *FileManifest) GetSize() int64 {
 	if x != nil {
-		return x.OwnerPubKey
+		return x.Size
 	}
-	return ""
+	return 0
 }
 
-func (x *GroupItemV0) GetUserSignPubkey() string {
+func (x *FileManifest) GetSha256() string {
 	if x != nil {
-		return x.UserSignPubkey
+		return x.Sha256
 	}
 	return ""
 }
 
-func (x *GroupItemV0) GetUserEncryptPubkey() string {
+func (x *FileManifest) GetChunkSize() int64 {
 	if x != nil {
-		return x.UserEncryptPubkey
+		return x.ChunkSize
 	}
-	return ""
+	return 0
 }
 
-func (x *GroupItemV0) GetUserRole() RoleV0 {
+func (x *FileManifest) GetKeyEpoch() int64 {
 	if x != nil {
-		return x.UserRole
+		return x.KeyEpoch
 	}
-	return RoleV0_GROUP_PRODUCER
+	return 0
 }
 
-func (x *GroupItemV0) GetLastUpdate() int64 {
+func (x *FileManifest) GetChunks() []string {
 	if x != nil {
-		return x.LastUpdate
+		return x.Chunks
 	}
-	return 0
+	return nil
 }
 
-func (x *GroupItemV0) GetHighestHeight() int64 {
-	if x != nil {
-		return x.HighestHeight
+type ReqFileChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupId string `protobuf:"bytes,1,opt,name=GroupId,proto3" json:"GroupId,omitempty"`
+	Hash    string `protobuf:"bytes,2,opt,name=Hash,proto3" json:"Hash,omitempty"`
+}
+
+func (x *ReqFileChunk) Reset() {
+	*x = ReqFileChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chain_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *GroupItemV0) GetHighestBlockId() string {
+func (x *ReqFileChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqFileChunk) ProtoMessage() {}
+
+func (x *ReqFileChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_chain_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqFileChunk.ProtoReflect.Descriptor instead.
+func (*ReqFileChunk) Descriptor() ([]byte, []int) {
+	return file_chain_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ReqFileChunk) GetGroupId() string {
 	if x != nil {
-		return x.HighestBlockId
+		return x.GroupId
 	}
 	return ""
 }
 
-func (x *GroupItemV0) GetGenesisBlock() *Block {
+func (x *ReqFileChunk) GetHash() string {
 	if x != nil {
-		return x.GenesisBlock
+		return x.Hash
+	}
+	return ""
+}
+
+type FileChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupId string `protobuf:"bytes,1,opt,name=GroupId,proto3" json:"GroupId,omitempty"`
+	Hash    string `protobuf:"bytes,2,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	Data    []byte `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"` //encrypted chunk, empty if the peer does not have it
+}
+
+func (x *FileChunk) Reset() {
+	*x = FileChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chain_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileChunk) ProtoMessage() {}
+
+func (x *FileChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_chain_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *GroupItemV0) GetEncryptType() GroupEncryptType {
-	if x != nil {
-		return x.EncryptType
-	}
-	return GroupEncryptType_PUBLIC
+// Deprecated: Use FileChunk.ProtoReflect.Descriptor instead.
+func (*FileChunk) Descriptor() ([]byte, []int) {
+	return file_chain_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *GroupItemV0) GetConsenseType() GroupConsenseType {
+func (x *FileChunk) GetGroupId() string {
 	if x != nil {
-		return x.ConsenseType
+		return x.GroupId
 	}
-	return GroupConsenseType_POA
+	return ""
 }
 
-func (x *GroupItemV0) GetCipherKey() string {
+func (x *FileChunk) GetHash() string {
 	if x != nil {
-		return x.CipherKey
+		return x.Hash
 	}
 	return ""
 }
 
-func (x *GroupItemV0) GetAppKey() string {
+func (x *FileChunk) GetData() []byte {
 	if x != nil {
-		return x.AppKey
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-type PSPing struct {
+type ProfileItem struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Seqnum    int32  `protobuf:"varint,1,opt,name=Seqnum,proto3" json:"Seqnum,omitempty"`
-	IsResp    bool   `protobuf:"varint,2,opt,name=IsResp,proto3" json:"IsResp,omitempty"`
-	TimeStamp int64  `protobuf:"varint,3,opt,name=TimeStamp,proto3" json:"TimeStamp,omitempty,string"`
-	Payload   []byte `protobuf:"bytes,4,opt,name=Payload,proto3" json:"Payload,omitempty"`
+	GroupId   string  `protobuf:"bytes,1,opt,name=GroupId,proto3" json:"GroupId,omitempty"`
+	Pubkey    string  `protobuf:"bytes,2,opt,name=Pubkey,proto3" json:"Pubkey,omitempty"` //sender of the profile
+	Person    *Person `protobuf:"bytes,3,opt,name=Person,proto3" json:"Person,omitempty"`
+	TrxId     string  `protobuf:"bytes,4,opt,name=TrxId,proto3" json:"TrxId,omitempty"`
+	TimeStamp int64   `protobuf:"varint,5,opt,name=TimeStamp,proto3" json:"TimeStamp,omitempty,string"`
 }
 
-func (x *PSPing) Reset() {
-	*x = PSPing{}
+func (x *ProfileItem) Reset() {
+	*x = ProfileItem{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chain_proto_msgTypes[15]
+		mi := &file_chain_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PSPing) String() string {
+func (x *ProfileItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PSPing) ProtoMessage() {}
+func (*ProfileItem) ProtoMessage() {}
 
-func (x *PSPing) ProtoReflect() protoreflect.Message {
-	mi := &file_chain_proto_msgTypes[15]
+func (x *ProfileItem) ProtoReflect() protoreflect.Message {
+	mi := &file_chain_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1900,323 +3557,591 @@ func (x *PSPing) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PSPing.ProtoReflect.Descriptor instead.
-func (*PSPing) Descriptor() ([]byte, []int) {
-	return file_chain_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ProfileItem.ProtoReflect.Descriptor instead.
+func (*ProfileItem) Descriptor() ([]byte, []int) {
+	return file_chain_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *PSPing) GetSeqnum() int32 {
+func (x *ProfileItem) GetGroupId() string {
 	if x != nil {
-		return x.Seqnum
+		return x.GroupId
 	}
-	return 0
+	return ""
 }
 
-func (x *PSPing) GetIsResp() bool {
+func (x *ProfileItem) GetPubkey() string {
 	if x != nil {
-		return x.IsResp
+		return x.Pubkey
 	}
-	return false
+	return ""
 }
 
-func (x *PSPing) GetTimeStamp() int64 {
+func (x *ProfileItem) GetPerson() *Person {
 	if x != nil {
-		return x.TimeStamp
+		return x.Person
 	}
-	return 0
+	return nil
 }
 
-func (x *PSPing) GetPayload() []byte {
+func (x *ProfileItem) GetTrxId() string {
 	if x != nil {
-		return x.Payload
+		return x.TrxId
 	}
-	return nil
+	return ""
+}
+
+func (x *ProfileItem) GetTimeStamp() int64 {
+	if x != nil {
+		return x.TimeStamp
+	}
+	return 0
 }
 
 var File_chain_proto protoreflect.FileDescriptor
 
 var file_chain_proto_rawDesc = []byte{
 	0x0a, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x71,
-	0x75, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x70, 0x62, 0x22, 0x49, 0x0a, 0x07, 0x50, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x16, 0x2e, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61,
-	0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x44,
-	0x61, 0x74, 0x61, 0x22, 0xbf, 0x02, 0x0a, 0x03, 0x54, 0x72, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x54,
-	0x72, 0x78, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x72, 0x78, 0x49,
-	0x64, 0x12, 0x26, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x12, 0x2e, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x78, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x53,
-	0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65,
-	0x53, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
-	0x18, 0x0a, 0x07, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x07, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x52, 0x65, 0x73,
-	0x65, 0x6e, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
-	0x52, 0x65, 0x73, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x4e,
-	0x6f, 0x6e, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x4e, 0x6f, 0x6e, 0x63,
-	0x65, 0x12, 0x22, 0x0a, 0x0c, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6b, 0x65,
-	0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x50,
-	0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x53,
-	0x69, 0x67, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x53, 0x65, 0x6e, 0x64, 0x65,
-	0x72, 0x53, 0x69, 0x67, 0x6e, 0x22, 0x9d, 0x02, 0x0a, 0x05, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
-	0x18, 0x0a, 0x07, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x72, 0x65, 0x76, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x50, 0x72, 0x65, 0x76, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75,
-	0x73, 0x48, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x50, 0x72, 0x65,
-	0x76, 0x69, 0x6f, 0x75, 0x73, 0x48, 0x61, 0x73, 0x68, 0x12, 0x22, 0x0a, 0x04, 0x54, 0x72, 0x78,
-	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d,
-	0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x78, 0x52, 0x04, 0x54, 0x72, 0x78, 0x73, 0x12, 0x26, 0x0a,
-	0x0e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x50,
-	0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x61, 0x73, 0x68, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x04, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x53, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x53,
-	0x74, 0x61, 0x6d, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65,
-	0x53, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xb6, 0x01, 0x0a, 0x0c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44,
-	0x62, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49,
+	0x75, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x70, 0x62, 0x1a, 0x15, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x49, 0x0a, 0x07, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x71, 0x75, 0x6f, 0x72, 0x75,
+	0x6d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x22, 0xdb, 0x02, 0x0a, 0x03, 0x54,
+	0x72, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x72, 0x78, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x54, 0x72, 0x78, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x2e,
+	0x70, 0x62, 0x2e, 0x54, 0x72, 0x78, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61,
+	0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1c,
+	0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x18, 0x0a, 0x07,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64,
+	0x12, 0x20, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x52, 0x65, 0x73, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x53, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x1e, 0x0a, 0x0a,
+	0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0a, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x4b, 0x65, 0x79, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x4b, 0x65, 0x79, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x22, 0xcd, 0x02, 0x0a, 0x05, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x72, 0x65, 0x76, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x50, 0x72, 0x65,
+	0x76, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x50, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x48, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c,
+	0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x48, 0x61, 0x73, 0x68, 0x12, 0x22, 0x0a, 0x04,
+	0x54, 0x72, 0x78, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x71, 0x75, 0x6f,
+	0x72, 0x75, 0x6d, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x78, 0x52, 0x04, 0x54, 0x72, 0x78, 0x73,
+	0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x50, 0x75, 0x62, 0x4b,
+	0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x65, 0x72, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x61, 0x73, 0x68,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69,
+	0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54,
+	0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2e, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x71, 0x75, 0x6f, 0x72,
+	0x75, 0x6d, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x6f, 0x74, 0x65, 0x52,
+	0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x22, 0xe8, 0x01, 0x0a, 0x09, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x72,
+	0x65, 0x76, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x50, 0x72, 0x65, 0x76, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x04,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x71, 0x75, 0x6f,
+	0x72, 0x75, 0x6d, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x50, 0x75,
+	0x62, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x56, 0x6f, 0x74, 0x65,
+	0x72, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x53,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65,
+	0x53, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x22, 0xd4, 0x01, 0x0a, 0x0c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x62, 0x43,
+	0x68, 0x75, 0x6e, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x2e,
+	0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x52, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x24,
+	0x0a, 0x0d, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x75, 0x62, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x49, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x75, 0x62, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x22, 0x56, 0x0a, 0x08, 0x52, 0x65,
+	0x71, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49,
 	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64,
-	0x12, 0x2e, 0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x74, 0x65, 0x6d, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x70, 0x62, 0x2e,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x74, 0x65, 0x6d,
-	0x12, 0x24, 0x0a, 0x0d, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x75, 0x62, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x49, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x75, 0x62, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x56,
-	0x0a, 0x08, 0x52, 0x65, 0x71, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x16,
-	0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x3d, 0x0a, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53,
-	0x79, 0x6e, 0x63, 0x65, 0x64, 0x12, 0x2e, 0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x74,
-	0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x71, 0x75, 0x6f, 0x72, 0x75,
-	0x6d, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x09, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x49, 0x74, 0x65, 0x6d, 0x22, 0x3f, 0x0a, 0x0d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x65, 0x64, 0x12, 0x2e, 0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49,
-	0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x71, 0x75, 0x6f, 0x72,
-	0x75, 0x6d, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x09, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x49, 0x74, 0x65, 0x6d, 0x22, 0xdb, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x71, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2f, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d,
-	0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x6c, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x52, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79,
-	0x12, 0x28, 0x0a, 0x0f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x50, 0x75, 0x62,
-	0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x14,
-	0x0a, 0x05, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x82, 0x01, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x74, 0x65,
-	0x6d, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x72, 0x78, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x54, 0x72, 0x78, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x50, 0x75, 0x62, 0x6c, 0x69,
-	0x73, 0x68, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0f, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6b, 0x65,
-	0x79, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x54,
-	0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xde, 0x01, 0x0a, 0x0c, 0x44, 0x65,
-	0x6e, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x65, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x50, 0x65, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x10,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4f, 0x77, 0x6e,
-	0x65, 0x72, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e,
-	0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x16,
-	0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x4d, 0x65, 0x6d, 0x6f, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4d, 0x65, 0x6d, 0x6f, 0x22, 0xab, 0x02, 0x0a, 0x0c, 0x50,
-	0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65,
-	0x72, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50,
-	0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a,
-	0x10, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6b, 0x65,
-	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4f, 0x77,
-	0x6e, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x53, 0x69, 0x67,
-	0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x12,
-	0x2d, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x15, 0x2e, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24,
-	0x0a, 0x0d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x64, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x4d, 0x65, 0x6d, 0x6f, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x4d, 0x65, 0x6d, 0x6f, 0x22, 0xa6, 0x03, 0x0a, 0x0c, 0x41, 0x6e, 0x6e,
-	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x69, 0x67, 0x6e, 0x50, 0x75, 0x62, 0x6b, 0x65,
-	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x69, 0x67, 0x6e, 0x50, 0x75, 0x62,
-	0x6b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x50, 0x75,
-	0x62, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x45, 0x6e, 0x63, 0x72,
-	0x79, 0x70, 0x74, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x12, 0x41, 0x6e, 0x6e,
-	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x72,
-	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x54, 0x79, 0x70,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d,
-	0x2e, 0x70, 0x62, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x50,
-	0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x4f, 0x77, 0x6e,
-	0x65, 0x72, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x4f, 0x77, 0x6e, 0x65,
-	0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x12, 0x2e, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x16, 0x2e, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70,
-	0x72, 0x6f, 0x76, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2d,
-	0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15,
-	0x2e, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
-	0x04, 0x4d, 0x65, 0x6d, 0x6f, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4d, 0x65, 0x6d,
-	0x6f, 0x22, 0xef, 0x01, 0x0a, 0x0a, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x49, 0x74, 0x65, 0x6d,
-	0x12, 0x18, 0x0a, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4f, 0x77, 0x6e, 0x65, 0x72,
-	0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4f,
-	0x77, 0x6e, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x12, 0x12,
-	0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x52, 0x75, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74,
-	0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x53,
-	0x74, 0x61, 0x6d, 0x70, 0x12, 0x2d, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x70, 0x62,
-	0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x41, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x22, 0x96, 0x04, 0x0a, 0x09, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x74, 0x65,
-	0x6d, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x4f, 0x77, 0x6e,
-	0x65, 0x72, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x55,
-	0x73, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x50, 0x75, 0x62,
-	0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x72, 0x45, 0x6e, 0x63, 0x72, 0x79,
-	0x70, 0x74, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
-	0x55, 0x73, 0x65, 0x72, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x50, 0x75, 0x62, 0x6b, 0x65,
-	0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x4c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x4c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x12, 0x24, 0x0a, 0x0d, 0x48, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x48, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x48, 0x69, 0x67, 0x68, 0x65, 0x73,
-	0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x48, 0x69, 0x67, 0x68, 0x65,
-	0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0e, 0x48, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x12,
-	0x34, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x70,
-	0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x3d, 0x0a, 0x0b, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74,
-	0x54, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x71, 0x75, 0x6f,
-	0x72, 0x75, 0x6d, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x6e, 0x63, 0x72,
-	0x79, 0x70, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x40, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x71, 0x75, 0x6f,
-	0x72, 0x75, 0x6d, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x6e, 0x73,
-	0x65, 0x6e, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e,
-	0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x69, 0x70, 0x68, 0x65, 0x72,
-	0x4b, 0x65, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x69, 0x70, 0x68, 0x65,
-	0x72, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x70, 0x70, 0x4b, 0x65, 0x79, 0x18, 0x0d,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x41, 0x70, 0x70, 0x4b, 0x65, 0x79, 0x22, 0xc7, 0x04, 0x0a,
-	0x0b, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x74, 0x65, 0x6d, 0x56, 0x30, 0x12, 0x18, 0x0a, 0x07,
+	0x12, 0x18, 0x0a, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x22, 0x3d, 0x0a, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x79, 0x6e, 0x63, 0x65,
+	0x64, 0x12, 0x2e, 0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x74, 0x65, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x70, 0x62,
+	0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x74, 0x65,
+	0x6d, 0x22, 0x3f, 0x0a, 0x0d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x65, 0x64, 0x12, 0x2e, 0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x74, 0x65, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x70,
+	0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x74,
+	0x65, 0x6d, 0x22, 0xdb, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x71, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x2f, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x71, 0x42, 0x6c, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x28, 0x0a, 0x0f,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72,
+	0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x22, 0xb1, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x71, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x26,
+	0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa0, 0x02, 0x0a, 0x11, 0x52, 0x65, 0x71, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2f, 0x0a, 0x06, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x71, 0x75, 0x6f,
+	0x72, 0x75, 0x6d, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x6c, 0x6b, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x52, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x50, 0x75, 0x62,
+	0x6b, 0x65, 0x79, 0x12, 0x28, 0x0a, 0x0f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72,
+	0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x18, 0x0a,
+	0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x28, 0x0a, 0x06, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x71, 0x75, 0x6f, 0x72,
+	0x75, 0x6d, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x06, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x4c, 0x61, 0x73, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x04, 0x4c, 0x61, 0x73, 0x74, 0x22, 0x3f, 0x0a, 0x0b, 0x52, 0x65, 0x71, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xaa, 0x05, 0x0a, 0x08, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12,
+	0x1e, 0x0a, 0x0a, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x26, 0x0a, 0x05, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x52, 0x05, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x35, 0x0a, 0x09, 0x50, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x71,
+	0x75, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65,
+	0x72, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x09, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73,
+	0x12, 0x35, 0x0a, 0x09, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x70, 0x62, 0x2e,
+	0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x09, 0x41, 0x6e,
+	0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x08, 0x44, 0x65, 0x6e, 0x79, 0x4c,
+	0x69, 0x73, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x71, 0x75, 0x6f, 0x72,
+	0x75, 0x6d, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6e, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x74,
+	0x65, 0x6d, 0x52, 0x08, 0x44, 0x65, 0x6e, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x07,
+	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x49, 0x74, 0x65, 0x6d, 0x52, 0x07, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x12, 0x2c, 0x0a,
+	0x06, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x49,
+	0x74, 0x65, 0x6d, 0x52, 0x06, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x50,
+	0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65,
+	0x72, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x1c, 0x0a,
+	0x09, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x48,
+	0x61, 0x73, 0x68, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x1c, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x35, 0x0a,
+	0x09, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x4b, 0x65, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x09, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x4b, 0x65, 0x79, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x12, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x70, 0x62, 0x2e,
+	0x52, 0x6f, 0x6c, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x4a,
+	0x04, 0x08, 0x0b, 0x10, 0x0c, 0x22, 0x84, 0x02, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x74,
+	0x65, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x72, 0x78, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x54, 0x72, 0x78, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6b,
+	0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x22, 0x0a, 0x0c, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x54, 0x72, 0x78, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x54, 0x72, 0x78, 0x49, 0x64, 0x12, 0x2a,
+	0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x45, 0x64,
+	0x69, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x45, 0x64, 0x69, 0x74,
+	0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0xde, 0x01, 0x0a,
+	0x0c, 0x44, 0x65, 0x6e, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x18, 0x0a,
+	0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x65, 0x65, 0x72, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x50, 0x65, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x2a, 0x0a, 0x10, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x50, 0x75, 0x62,
+	0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x53,
+	0x69, 0x67, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d,
+	0x70, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x4d, 0x65, 0x6d,
+	0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4d, 0x65, 0x6d, 0x6f, 0x22, 0xcf, 0x02,
+	0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x18,
+	0x0a, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79,
+	0x12, 0x2a, 0x0a, 0x10, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x50, 0x75,
+	0x62, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x0e,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4f, 0x77, 0x6e, 0x65, 0x72,
+	0x53, 0x69, 0x67, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x2d, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x15, 0x2e, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x70, 0x62, 0x2e, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x4d, 0x65, 0x6d, 0x6f, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4d, 0x65, 0x6d, 0x6f, 0x12, 0x22, 0x0a, 0x0c, 0x53,
+	0x69, 0x67, 0x6e, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x22,
+	0xe0, 0x02, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x18, 0x0a,
+	0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x12,
+	0x2a, 0x0a, 0x10, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x50, 0x75, 0x62,
+	0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x53,
+	0x69, 0x67, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d,
+	0x70, 0x12, 0x2d, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x15, 0x2e, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x4d, 0x65, 0x6d, 0x6f, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x4d, 0x65, 0x6d, 0x6f, 0x12, 0x2c, 0x0a, 0x08, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65,
+	0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x2e,
+	0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x08, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e,
+	0x63, 0x65, 0x22, 0xa6, 0x03, 0x0a, 0x0c, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x49,
+	0x74, 0x65, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x1e, 0x0a,
+	0x0a, 0x53, 0x69, 0x67, 0x6e, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x53, 0x69, 0x67, 0x6e, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x24, 0x0a,
+	0x0d, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x50, 0x75, 0x62,
+	0x6b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x12, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x72,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x12, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x17, 0x2e, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x6e,
+	0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6b,
+	0x65, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x4f, 0x77, 0x6e, 0x65,
+	0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x71, 0x75, 0x6f,
+	0x72, 0x75, 0x6d, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69,
+	0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54,
+	0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2d, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x71, 0x75, 0x6f, 0x72, 0x75,
+	0x6d, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x4d, 0x65, 0x6d, 0x6f, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4d, 0x65, 0x6d, 0x6f, 0x22, 0x3e, 0x0a, 0x0a, 0x57,
+	0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x69, 0x67,
+	0x6e, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53,
+	0x69, 0x67, 0x6e, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x4b, 0x65, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x4b, 0x65, 0x79, 0x22, 0xe1, 0x01, 0x0a, 0x0c,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x18, 0x0a, 0x07,
 	0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x50, 0x75, 0x62,
-	0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x4f, 0x77, 0x6e, 0x65, 0x72,
-	0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x69,
-	0x67, 0x6e, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
-	0x55, 0x73, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x2c,
-	0x0a, 0x11, 0x55, 0x73, 0x65, 0x72, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x50, 0x75, 0x62,
-	0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x55, 0x73, 0x65, 0x72, 0x45,
-	0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x08,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11,
-	0x2e, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x56,
-	0x30, 0x52, 0x08, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x4c,
-	0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0a, 0x4c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x48,
-	0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0d, 0x48, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x12, 0x26, 0x0a, 0x0e, 0x48, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x49, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x48, 0x69, 0x67, 0x68, 0x65,
-	0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x0c, 0x47, 0x65, 0x6e,
-	0x65, 0x73, 0x69, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x10, 0x2e, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x52, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
-	0x3d, 0x0a, 0x0b, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x70, 0x62,
-	0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x0b, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x40,
-	0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0c,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x70, 0x62,
-	0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x0c, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x1c, 0x0a, 0x09, 0x43, 0x69, 0x70, 0x68, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x18, 0x0d, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x69, 0x70, 0x68, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x16,
-	0x0a, 0x06, 0x41, 0x70, 0x70, 0x4b, 0x65, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x41, 0x70, 0x70, 0x4b, 0x65, 0x79, 0x22, 0x70, 0x0a, 0x06, 0x50, 0x53, 0x50, 0x69, 0x6e, 0x67,
-	0x12, 0x16, 0x0a, 0x06, 0x53, 0x65, 0x71, 0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x06, 0x53, 0x65, 0x71, 0x6e, 0x75, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x49, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x49, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x18,
-	0x0a, 0x07, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x07, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2a, 0x21, 0x0a, 0x0b, 0x50, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x52, 0x58, 0x10, 0x00,
-	0x12, 0x09, 0x0a, 0x05, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x01, 0x2a, 0xae, 0x01, 0x0a, 0x07,
-	0x54, 0x72, 0x78, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x4f, 0x53, 0x54, 0x10,
-	0x00, 0x12, 0x08, 0x0a, 0x04, 0x41, 0x55, 0x54, 0x48, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x53,
-	0x43, 0x48, 0x45, 0x4d, 0x41, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x52, 0x4f, 0x44, 0x55,
-	0x43, 0x45, 0x52, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x4e, 0x4e, 0x4f, 0x55, 0x4e, 0x43,
-	0x45, 0x10, 0x04, 0x12, 0x15, 0x0a, 0x11, 0x52, 0x45, 0x51, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b,
-	0x5f, 0x46, 0x4f, 0x52, 0x57, 0x41, 0x52, 0x44, 0x10, 0x05, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x45,
-	0x51, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x57, 0x41, 0x52, 0x44,
-	0x10, 0x06, 0x12, 0x12, 0x0a, 0x0e, 0x52, 0x45, 0x51, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f,
-	0x52, 0x45, 0x53, 0x50, 0x10, 0x07, 0x12, 0x10, 0x0a, 0x0c, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f,
-	0x53, 0x59, 0x4e, 0x43, 0x45, 0x44, 0x10, 0x08, 0x12, 0x12, 0x0a, 0x0e, 0x42, 0x4c, 0x4f, 0x43,
-	0x4b, 0x5f, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x45, 0x44, 0x10, 0x09, 0x2a, 0x2c, 0x0a, 0x0c,
-	0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07,
-	0x41, 0x53, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x53, 0x5f,
-	0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x45, 0x52, 0x10, 0x01, 0x2a, 0x38, 0x0a, 0x0b, 0x41, 0x70,
-	0x70, 0x72, 0x6f, 0x76, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x4e, 0x4e,
-	0x4f, 0x55, 0x4e, 0x43, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x50, 0x50, 0x52,
-	0x4f, 0x56, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54,
-	0x45, 0x44, 0x10, 0x02, 0x2a, 0x21, 0x0a, 0x0a, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x44, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x52,
-	0x45, 0x4d, 0x4f, 0x56, 0x45, 0x10, 0x01, 0x2a, 0x35, 0x0a, 0x0c, 0x52, 0x65, 0x71, 0x42, 0x6c,
-	0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x10, 0x0a, 0x0c, 0x42, 0x4c, 0x4f, 0x43, 0x4b,
-	0x5f, 0x49, 0x4e, 0x5f, 0x54, 0x52, 0x58, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x42, 0x4c, 0x4f,
-	0x43, 0x4b, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x2a, 0x2b,
-	0x0a, 0x10, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x43, 0x10, 0x00, 0x12, 0x0b,
-	0x0a, 0x07, 0x50, 0x52, 0x49, 0x56, 0x41, 0x54, 0x45, 0x10, 0x01, 0x2a, 0x25, 0x0a, 0x11, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x07, 0x0a, 0x03, 0x50, 0x4f, 0x41, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x50, 0x4f, 0x53,
-	0x10, 0x01, 0x2a, 0x2c, 0x0a, 0x06, 0x52, 0x6f, 0x6c, 0x65, 0x56, 0x30, 0x12, 0x12, 0x0a, 0x0e,
-	0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x45, 0x52, 0x10, 0x00,
-	0x12, 0x0e, 0x0a, 0x0a, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x10, 0x01,
-	0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72,
-	0x75, 0x6d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x2f,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x29, 0x0a, 0x04,
+	0x4b, 0x65, 0x79, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x71, 0x75, 0x6f,
+	0x72, 0x75, 0x6d, 0x2e, 0x70, 0x62, 0x2e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x4b, 0x65,
+	0x79, 0x52, 0x04, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x4f,
+	0x77, 0x6e, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x1c, 0x0a,
+	0x09, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x4d,
+	0x65, 0x6d, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4d, 0x65, 0x6d, 0x6f, 0x22,
+	0x97, 0x02, 0x0a, 0x08, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x18, 0x0a, 0x07,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75,
+	0x62, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x55, 0x73, 0x65, 0x72,
+	0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x28, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x70, 0x62,
+	0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x52, 0x6f, 0x6c, 0x65,
+	0x12, 0x24, 0x0a, 0x0d, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6b, 0x65,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72,
+	0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65,
+	0x72, 0x53, 0x69, 0x67, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x47, 0x72, 0x61,
+	0x6e, 0x74, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65,
+	0x53, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d,
+	0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2d, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x2e,
+	0x70, 0x62, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x4d, 0x65, 0x6d, 0x6f, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x4d, 0x65, 0x6d, 0x6f, 0x22, 0x93, 0x02, 0x0a, 0x0a, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x49, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4f, 0x77, 0x6e, 0x65, 0x72,
+	0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x26,
+	0x0a, 0x0e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4f, 0x77, 0x6e,
+	0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x52, 0x75,
+	0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2d, 0x0a, 0x06,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x71,
+	0x75, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x53,
+	0x69, 0x67, 0x6e, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x22,
+	0xb2, 0x04, 0x0a, 0x09, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x18, 0x0a,
+	0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x50, 0x75,
+	0x62, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x4f, 0x77, 0x6e, 0x65,
+	0x72, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x53,
+	0x69, 0x67, 0x6e, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12,
+	0x2c, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x72, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x50, 0x75,
+	0x62, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x55, 0x73, 0x65, 0x72,
+	0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x1e, 0x0a,
+	0x0a, 0x4c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x4c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a,
+	0x0d, 0x48, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x48, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x48, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x49, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x48, 0x69, 0x67,
+	0x68, 0x65, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x0c, 0x47,
+	0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x52, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x3d, 0x0a, 0x0b, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x2e,
+	0x70, 0x62, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x0b, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x40, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x2e,
+	0x70, 0x62, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x69, 0x70, 0x68, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x69, 0x70, 0x68, 0x65, 0x72, 0x4b, 0x65, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x41, 0x70, 0x70, 0x4b, 0x65, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x41, 0x70, 0x70, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x4b, 0x65, 0x79, 0x45,
+	0x70, 0x6f, 0x63, 0x68, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x4b, 0x65, 0x79, 0x45,
+	0x70, 0x6f, 0x63, 0x68, 0x22, 0xc7, 0x04, 0x0a, 0x0b, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x74,
+	0x65, 0x6d, 0x56, 0x30, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x26,
+	0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e,
+	0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x72, 0x45, 0x6e,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x11, 0x55, 0x73, 0x65, 0x72, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x50, 0x75,
+	0x62, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x2e,
+	0x70, 0x62, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x56, 0x30, 0x52, 0x08, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x6f, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x4c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x4c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x48, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x48, 0x69, 0x67, 0x68,
+	0x65, 0x73, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x48, 0x69, 0x67,
+	0x68, 0x65, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x48, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49,
+	0x64, 0x12, 0x34, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d,
+	0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73,
+	0x69, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x3d, 0x0a, 0x0b, 0x45, 0x6e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x71,
+	0x75, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x6e,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x45, 0x6e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x40, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e,
+	0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x71,
+	0x75, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f,
+	0x6e, 0x73, 0x65, 0x6e, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x43, 0x6f, 0x6e, 0x73,
+	0x65, 0x6e, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x69, 0x70, 0x68,
+	0x65, 0x72, 0x4b, 0x65, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x69, 0x70,
+	0x68, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x70, 0x70, 0x4b, 0x65, 0x79,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x41, 0x70, 0x70, 0x4b, 0x65, 0x79, 0x22, 0x70,
+	0x0a, 0x06, 0x50, 0x53, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x65, 0x71, 0x6e,
+	0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x53, 0x65, 0x71, 0x6e, 0x75, 0x6d,
+	0x12, 0x16, 0x0a, 0x06, 0x49, 0x73, 0x52, 0x65, 0x73, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x49, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65,
+	0x53, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d,
+	0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x22, 0xd1, 0x01, 0x0a, 0x0b, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x74, 0x65, 0x6d,
+	0x12, 0x1c, 0x0a, 0x09, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x12, 0x10,
+	0x0a, 0x03, 0x55, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x55, 0x72, 0x6c,
+	0x12, 0x18, 0x0a, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x08, 0x54, 0x72,
+	0x78, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x71,
+	0x75, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x78, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x08, 0x54, 0x72, 0x78, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x4d, 0x65, 0x6d, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x4d, 0x65, 0x6d, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x53,
+	0x74, 0x61, 0x6d, 0x70, 0x22, 0xa1, 0x02, 0x0a, 0x11, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x79, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x44, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x72, 0x78, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x54, 0x72, 0x78, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x07, 0x54, 0x72, 0x78, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x71, 0x75, 0x6f, 0x72,
+	0x75, 0x6d, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x78, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x54,
+	0x72, 0x78, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x4c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x4c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69,
+	0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54,
+	0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xa4, 0x01, 0x0a, 0x0c, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x09, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x74, 0x65, 0x6d,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x2e,
+	0x70, 0x62, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x09, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x28, 0x0a, 0x06, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d,
+	0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x06, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x22,
+	0xbe, 0x01, 0x0a, 0x0c, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x68, 0x61, 0x32, 0x35, 0x36,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x68, 0x61, 0x32, 0x35, 0x36, 0x12, 0x1c,
+	0x0a, 0x09, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x4b, 0x65, 0x79, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x4b, 0x65, 0x79, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x43, 0x68, 0x75, 0x6e,
+	0x6b, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x73,
+	0x22, 0x3c, 0x0a, 0x0c, 0x52, 0x65, 0x71, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x68, 0x75, 0x6e, 0x6b,
+	0x12, 0x18, 0x0a, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x61,
+	0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x48, 0x61, 0x73, 0x68, 0x22, 0x4d,
+	0x0a, 0x09, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74,
+	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x22, 0x9e, 0x01,
+	0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x18, 0x0a,
+	0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x75, 0x62, 0x6b, 0x65,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12,
+	0x29, 0x0a, 0x06, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x52, 0x06, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x72,
+	0x78, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x72, 0x78, 0x49, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x2a, 0x21,
+	0x0a, 0x0b, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x07, 0x0a,
+	0x03, 0x54, 0x52, 0x58, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x10,
+	0x01, 0x2a, 0xc6, 0x02, 0x0a, 0x07, 0x54, 0x72, 0x78, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a,
+	0x04, 0x50, 0x4f, 0x53, 0x54, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x41, 0x55, 0x54, 0x48, 0x10,
+	0x01, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x10, 0x02, 0x12, 0x0c, 0x0a,
+	0x08, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x45, 0x52, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x41,
+	0x4e, 0x4e, 0x4f, 0x55, 0x4e, 0x43, 0x45, 0x10, 0x04, 0x12, 0x15, 0x0a, 0x11, 0x52, 0x45, 0x51,
+	0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x46, 0x4f, 0x52, 0x57, 0x41, 0x52, 0x44, 0x10, 0x05,
+	0x12, 0x16, 0x0a, 0x12, 0x52, 0x45, 0x51, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x42, 0x41,
+	0x43, 0x4b, 0x57, 0x41, 0x52, 0x44, 0x10, 0x06, 0x12, 0x12, 0x0a, 0x0e, 0x52, 0x45, 0x51, 0x5f,
+	0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x10, 0x07, 0x12, 0x10, 0x0a, 0x0c,
+	0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x45, 0x44, 0x10, 0x08, 0x12, 0x12,
+	0x0a, 0x0e, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x45, 0x44,
+	0x10, 0x09, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x54, 0x41, 0x4b, 0x45, 0x10, 0x0a, 0x12, 0x0e, 0x0a,
+	0x0a, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x56, 0x4f, 0x54, 0x45, 0x10, 0x0b, 0x12, 0x10, 0x0a,
+	0x0c, 0x52, 0x45, 0x51, 0x5f, 0x53, 0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f, 0x54, 0x10, 0x0c, 0x12,
+	0x0c, 0x0a, 0x08, 0x53, 0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f, 0x54, 0x10, 0x0d, 0x12, 0x13, 0x0a,
+	0x0f, 0x52, 0x45, 0x51, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45,
+	0x10, 0x0e, 0x12, 0x18, 0x0a, 0x14, 0x52, 0x45, 0x51, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f,
+	0x52, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x10, 0x0f, 0x12, 0x0d, 0x0a, 0x09,
+	0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x4b, 0x45, 0x59, 0x10, 0x10, 0x12, 0x13, 0x0a, 0x0f, 0x41,
+	0x4e, 0x4e, 0x4f, 0x55, 0x4e, 0x43, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x10, 0x11,
+	0x12, 0x08, 0x0a, 0x04, 0x52, 0x4f, 0x4c, 0x45, 0x10, 0x12, 0x2a, 0x2c, 0x0a, 0x0c, 0x41, 0x6e,
+	0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x53,
+	0x5f, 0x55, 0x53, 0x45, 0x52, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x53, 0x5f, 0x50, 0x52,
+	0x4f, 0x44, 0x55, 0x43, 0x45, 0x52, 0x10, 0x01, 0x2a, 0x38, 0x0a, 0x0b, 0x41, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x4e, 0x4e, 0x4f, 0x55,
+	0x4e, 0x43, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56,
+	0x45, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44,
+	0x10, 0x02, 0x2a, 0x21, 0x0a, 0x0a, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x07, 0x0a, 0x03, 0x41, 0x44, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x4d,
+	0x4f, 0x56, 0x45, 0x10, 0x01, 0x2a, 0x48, 0x0a, 0x09, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f,
+	0x6c, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x41, 0x44, 0x45, 0x52, 0x10, 0x00, 0x12, 0x0a,
+	0x0a, 0x06, 0x57, 0x52, 0x49, 0x54, 0x45, 0x52, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x4d, 0x4f,
+	0x44, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x44, 0x4d,
+	0x49, 0x4e, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x4f, 0x57, 0x4e, 0x45, 0x52, 0x10, 0x04, 0x2a,
+	0x23, 0x0a, 0x08, 0x56, 0x6f, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x50,
+	0x52, 0x45, 0x56, 0x4f, 0x54, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x4f, 0x4d, 0x4d,
+	0x49, 0x54, 0x10, 0x01, 0x2a, 0x35, 0x0a, 0x0c, 0x52, 0x65, 0x71, 0x42, 0x6c, 0x6b, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x10, 0x0a, 0x0c, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x49, 0x4e,
+	0x5f, 0x54, 0x52, 0x58, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x2a, 0x2b, 0x0a, 0x10, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x0a, 0x0a, 0x06, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x43, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50,
+	0x52, 0x49, 0x56, 0x41, 0x54, 0x45, 0x10, 0x01, 0x2a, 0x25, 0x0a, 0x11, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x07, 0x0a,
+	0x03, 0x50, 0x4f, 0x41, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x50, 0x4f, 0x53, 0x10, 0x01, 0x2a,
+	0x2c, 0x0a, 0x06, 0x52, 0x6f, 0x6c, 0x65, 0x56, 0x30, 0x12, 0x12, 0x0a, 0x0e, 0x47, 0x52, 0x4f,
+	0x55, 0x50, 0x5f, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x45, 0x52, 0x10, 0x00, 0x12, 0x0e, 0x0a,
+	0x0a, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x10, 0x01, 0x42, 0x2d, 0x5a,
+	0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x75, 0x6d, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x2f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2231,60 +4156,101 @@ func file_chain_proto_rawDescGZIP() []byte {
 	return file_chain_proto_rawDescData
 }
 
-var file_chain_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
-var file_chain_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_chain_proto_enumTypes = make([]protoimpl.EnumInfo, 11)
+var file_chain_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
 var file_chain_proto_goTypes = []interface{}{
-	(PackageType)(0),       // 0: quorum.pb.PackageType
-	(TrxType)(0),           // 1: quorum.pb.TrxType
-	(AnnounceType)(0),      // 2: quorum.pb.AnnounceType
-	(ApproveType)(0),       // 3: quorum.pb.ApproveType
-	(ActionType)(0),        // 4: quorum.pb.ActionType
-	(ReqBlkResult)(0),      // 5: quorum.pb.ReqBlkResult
-	(GroupEncryptType)(0),  // 6: quorum.pb.GroupEncryptType
-	(GroupConsenseType)(0), // 7: quorum.pb.GroupConsenseType
-	(RoleV0)(0),            // 8: quorum.pb.RoleV0
-	(*Package)(nil),        // 9: quorum.pb.Package
-	(*Trx)(nil),            // 10: quorum.pb.Trx
-	(*Block)(nil),          // 11: quorum.pb.Block
-	(*BlockDbChunk)(nil),   // 12: quorum.pb.BlockDbChunk
-	(*ReqBlock)(nil),       // 13: quorum.pb.ReqBlock
-	(*BlockSynced)(nil),    // 14: quorum.pb.BlockSynced
-	(*BlockProduced)(nil),  // 15: quorum.pb.BlockProduced
-	(*ReqBlockResp)(nil),   // 16: quorum.pb.ReqBlockResp
-	(*PostItem)(nil),       // 17: quorum.pb.PostItem
-	(*DenyUserItem)(nil),   // 18: quorum.pb.DenyUserItem
-	(*ProducerItem)(nil),   // 19: quorum.pb.ProducerItem
-	(*AnnounceItem)(nil),   // 20: quorum.pb.AnnounceItem
-	(*SchemaItem)(nil),     // 21: quorum.pb.SchemaItem
-	(*GroupItem)(nil),      // 22: quorum.pb.GroupItem
-	(*GroupItemV0)(nil),    // 23: quorum.pb.GroupItemV0
-	(*PSPing)(nil),         // 24: quorum.pb.PSPing
+	(PackageType)(0),          // 0: quorum.pb.PackageType
+	(TrxType)(0),              // 1: quorum.pb.TrxType
+	(AnnounceType)(0),         // 2: quorum.pb.AnnounceType
+	(ApproveType)(0),          // 3: quorum.pb.ApproveType
+	(ActionType)(0),           // 4: quorum.pb.ActionType
+	(GroupRole)(0),            // 5: quorum.pb.GroupRole
+	(VoteType)(0),             // 6: quorum.pb.VoteType
+	(ReqBlkResult)(0),         // 7: quorum.pb.ReqBlkResult
+	(GroupEncryptType)(0),     // 8: quorum.pb.GroupEncryptType
+	(GroupConsenseType)(0),    // 9: quorum.pb.GroupConsenseType
+	(RoleV0)(0),               // 10: quorum.pb.RoleV0
+	(*Package)(nil),           // 11: quorum.pb.Package
+	(*Trx)(nil),               // 12: quorum.pb.Trx
+	(*Block)(nil),             // 13: quorum.pb.Block
+	(*BlockVote)(nil),         // 14: quorum.pb.BlockVote
+	(*BlockDbChunk)(nil),      // 15: quorum.pb.BlockDbChunk
+	(*ReqBlock)(nil),          // 16: quorum.pb.ReqBlock
+	(*BlockSynced)(nil),       // 17: quorum.pb.BlockSynced
+	(*BlockProduced)(nil),     // 18: quorum.pb.BlockProduced
+	(*ReqBlockResp)(nil),      // 19: quorum.pb.ReqBlockResp
+	(*ReqBlockRange)(nil),     // 20: quorum.pb.ReqBlockRange
+	(*ReqBlockRangeResp)(nil), // 21: quorum.pb.ReqBlockRangeResp
+	(*ReqSnapshot)(nil),       // 22: quorum.pb.ReqSnapshot
+	(*Snapshot)(nil),          // 23: quorum.pb.Snapshot
+	(*PostItem)(nil),          // 24: quorum.pb.PostItem
+	(*DenyUserItem)(nil),      // 25: quorum.pb.DenyUserItem
+	(*ProducerItem)(nil),      // 26: quorum.pb.ProducerItem
+	(*StakeItem)(nil),         // 27: quorum.pb.StakeItem
+	(*AnnounceItem)(nil),      // 28: quorum.pb.AnnounceItem
+	(*WrappedKey)(nil),        // 29: quorum.pb.WrappedKey
+	(*GroupKeyItem)(nil),      // 30: quorum.pb.GroupKeyItem
+	(*RoleItem)(nil),          // 31: quorum.pb.RoleItem
+	(*SchemaItem)(nil),        // 32: quorum.pb.SchemaItem
+	(*GroupItem)(nil),         // 33: quorum.pb.GroupItem
+	(*GroupItemV0)(nil),       // 34: quorum.pb.GroupItemV0
+	(*PSPing)(nil),            // 35: quorum.pb.PSPing
+	(*WebhookItem)(nil),       // 36: quorum.pb.WebhookItem
+	(*WebhookDeadLetter)(nil), // 37: quorum.pb.WebhookDeadLetter
+	(*GroupArchive)(nil),      // 38: quorum.pb.GroupArchive
+	(*FileManifest)(nil),      // 39: quorum.pb.FileManifest
+	(*ReqFileChunk)(nil),      // 40: quorum.pb.ReqFileChunk
+	(*FileChunk)(nil),         // 41: quorum.pb.FileChunk
+	(*ProfileItem)(nil),       // 42: quorum.pb.ProfileItem
+	(*Person)(nil),            // 43: quorum.pb.Person
 }
 var file_chain_proto_depIdxs = []int32{
 	0,  // 0: quorum.pb.Package.type:type_name -> quorum.pb.PackageType
 	1,  // 1: quorum.pb.Trx.Type:type_name -> quorum.pb.TrxType
-	10, // 2: quorum.pb.Block.Trxs:type_name -> quorum.pb.Trx
-	11, // 3: quorum.pb.BlockDbChunk.BlockItem:type_name -> quorum.pb.Block
-	11, // 4: quorum.pb.BlockSynced.BlockItem:type_name -> quorum.pb.Block
-	11, // 5: quorum.pb.BlockProduced.BlockItem:type_name -> quorum.pb.Block
-	5,  // 6: quorum.pb.ReqBlockResp.Result:type_name -> quorum.pb.ReqBlkResult
-	4,  // 7: quorum.pb.ProducerItem.Action:type_name -> quorum.pb.ActionType
-	2,  // 8: quorum.pb.AnnounceItem.Type:type_name -> quorum.pb.AnnounceType
-	3,  // 9: quorum.pb.AnnounceItem.Result:type_name -> quorum.pb.ApproveType
-	4,  // 10: quorum.pb.AnnounceItem.Action:type_name -> quorum.pb.ActionType
-	4,  // 11: quorum.pb.SchemaItem.Action:type_name -> quorum.pb.ActionType
-	11, // 12: quorum.pb.GroupItem.GenesisBlock:type_name -> quorum.pb.Block
-	6,  // 13: quorum.pb.GroupItem.EncryptType:type_name -> quorum.pb.GroupEncryptType
-	7,  // 14: quorum.pb.GroupItem.ConsenseType:type_name -> quorum.pb.GroupConsenseType
-	8,  // 15: quorum.pb.GroupItemV0.UserRole:type_name -> quorum.pb.RoleV0
-	11, // 16: quorum.pb.GroupItemV0.GenesisBlock:type_name -> quorum.pb.Block
-	6,  // 17: quorum.pb.GroupItemV0.EncryptType:type_name -> quorum.pb.GroupEncryptType
-	7,  // 18: quorum.pb.GroupItemV0.ConsenseType:type_name -> quorum.pb.GroupConsenseType
-	19, // [19:19] is the sub-list for method output_type
-	19, // [19:19] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	12, // 2: quorum.pb.Block.Trxs:type_name -> quorum.pb.Trx
+	14, // 3: quorum.pb.Block.Commits:type_name -> quorum.pb.BlockVote
+	6,  // 4: quorum.pb.BlockVote.Type:type_name -> quorum.pb.VoteType
+	13, // 5: quorum.pb.BlockDbChunk.BlockItem:type_name -> quorum.pb.Block
+	13, // 6: quorum.pb.BlockSynced.BlockItem:type_name -> quorum.pb.Block
+	13, // 7: quorum.pb.BlockProduced.BlockItem:type_name -> quorum.pb.Block
+	7,  // 8: quorum.pb.ReqBlockResp.Result:type_name -> quorum.pb.ReqBlkResult
+	7,  // 9: quorum.pb.ReqBlockRangeResp.Result:type_name -> quorum.pb.ReqBlkResult
+	13, // 10: quorum.pb.ReqBlockRangeResp.Blocks:type_name -> quorum.pb.Block
+	13, // 11: quorum.pb.Snapshot.Block:type_name -> quorum.pb.Block
+	26, // 12: quorum.pb.Snapshot.Producers:type_name -> quorum.pb.ProducerItem
+	28, // 13: quorum.pb.Snapshot.Announces:type_name -> quorum.pb.AnnounceItem
+	25, // 14: quorum.pb.Snapshot.DenyList:type_name -> quorum.pb.DenyUserItem
+	32, // 15: quorum.pb.Snapshot.Schemas:type_name -> quorum.pb.SchemaItem
+	27, // 16: quorum.pb.Snapshot.Stakes:type_name -> quorum.pb.StakeItem
+	30, // 17: quorum.pb.Snapshot.GroupKeys:type_name -> quorum.pb.GroupKeyItem
+	31, // 18: quorum.pb.Snapshot.Roles:type_name -> quorum.pb.RoleItem
+	4,  // 19: quorum.pb.ProducerItem.Action:type_name -> quorum.pb.ActionType
+	4,  // 20: quorum.pb.StakeItem.Action:type_name -> quorum.pb.ActionType
+	13, // 21: quorum.pb.StakeItem.Evidence:type_name -> quorum.pb.Block
+	2,  // 22: quorum.pb.AnnounceItem.Type:type_name -> quorum.pb.AnnounceType
+	3,  // 23: quorum.pb.AnnounceItem.Result:type_name -> quorum.pb.ApproveType
+	4,  // 24: quorum.pb.AnnounceItem.Action:type_name -> quorum.pb.ActionType
+	29, // 25: quorum.pb.GroupKeyItem.Keys:type_name -> quorum.pb.WrappedKey
+	5,  // 26: quorum.pb.RoleItem.Role:type_name -> quorum.pb.GroupRole
+	4,  // 27: quorum.pb.RoleItem.Action:type_name -> quorum.pb.ActionType
+	4,  // 28: quorum.pb.SchemaItem.Action:type_name -> quorum.pb.ActionType
+	13, // 29: quorum.pb.GroupItem.GenesisBlock:type_name -> quorum.pb.Block
+	8,  // 30: quorum.pb.GroupItem.EncryptType:type_name -> quorum.pb.GroupEncryptType
+	9,  // 31: quorum.pb.GroupItem.ConsenseType:type_name -> quorum.pb.GroupConsenseType
+	10, // 32: quorum.pb.GroupItemV0.UserRole:type_name -> quorum.pb.RoleV0
+	13, // 33: quorum.pb.GroupItemV0.GenesisBlock:type_name -> quorum.pb.Block
+	8,  // 34: quorum.pb.GroupItemV0.EncryptType:type_name -> quorum.pb.GroupEncryptType
+	9,  // 35: quorum.pb.GroupItemV0.ConsenseType:type_name -> quorum.pb.GroupConsenseType
+	1,  // 36: quorum.pb.WebhookItem.TrxTypes:type_name -> quorum.pb.TrxType
+	1,  // 37: quorum.pb.WebhookDeadLetter.TrxType:type_name -> quorum.pb.TrxType
+	33, // 38: quorum.pb.GroupArchive.GroupItem:type_name -> quorum.pb.GroupItem
+	13, // 39: quorum.pb.GroupArchive.Blocks:type_name -> quorum.pb.Block
+	43, // 40: quorum.pb.ProfileItem.Person:type_name -> quorum.pb.Person
+	41, // [41:41] is the sub-list for method output_type
+	41, // [41:41] is the sub-list for method input_type
+	41, // [41:41] is the sub-list for extension type_name
+	41, // [41:41] is the sub-list for extension extendee
+	0,  // [0:41] is the sub-list for field type_name
 }
 
 func init() { file_chain_proto_init() }
@@ -2292,6 +4258,7 @@ func file_chain_proto_init() {
 	if File_chain_proto != nil {
 		return
 	}
+	file_activity_stream_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_chain_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Package); i {
@@ -2330,7 +4297,7 @@ func file_chain_proto_init() {
 			}
 		}
 		file_chain_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockDbChunk); i {
+			switch v := v.(*BlockVote); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2342,7 +4309,7 @@ func file_chain_proto_init() {
 			}
 		}
 		file_chain_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqBlock); i {
+			switch v := v.(*BlockDbChunk); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2354,7 +4321,7 @@ func file_chain_proto_init() {
 			}
 		}
 		file_chain_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockSynced); i {
+			switch v := v.(*ReqBlock); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2366,7 +4333,7 @@ func file_chain_proto_init() {
 			}
 		}
 		file_chain_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockProduced); i {
+			switch v := v.(*BlockSynced); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2378,7 +4345,7 @@ func file_chain_proto_init() {
 			}
 		}
 		file_chain_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqBlockResp); i {
+			switch v := v.(*BlockProduced); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2390,7 +4357,7 @@ func file_chain_proto_init() {
 			}
 		}
 		file_chain_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PostItem); i {
+			switch v := v.(*ReqBlockResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2402,7 +4369,7 @@ func file_chain_proto_init() {
 			}
 		}
 		file_chain_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DenyUserItem); i {
+			switch v := v.(*ReqBlockRange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2414,7 +4381,7 @@ func file_chain_proto_init() {
 			}
 		}
 		file_chain_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProducerItem); i {
+			switch v := v.(*ReqBlockRangeResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2426,7 +4393,7 @@ func file_chain_proto_init() {
 			}
 		}
 		file_chain_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AnnounceItem); i {
+			switch v := v.(*ReqSnapshot); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2438,7 +4405,7 @@ func file_chain_proto_init() {
 			}
 		}
 		file_chain_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SchemaItem); i {
+			switch v := v.(*Snapshot); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2450,7 +4417,7 @@ func file_chain_proto_init() {
 			}
 		}
 		file_chain_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GroupItem); i {
+			switch v := v.(*PostItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2462,7 +4429,7 @@ func file_chain_proto_init() {
 			}
 		}
 		file_chain_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GroupItemV0); i {
+			switch v := v.(*DenyUserItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2474,6 +4441,114 @@ func file_chain_proto_init() {
 			}
 		}
 		file_chain_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProducerItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chain_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StakeItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chain_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnnounceItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chain_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WrappedKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chain_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupKeyItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chain_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chain_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SchemaItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chain_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chain_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupItemV0); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chain_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PSPing); i {
 			case 0:
 				return &v.state
@@ -2485,14 +4560,98 @@ func file_chain_proto_init() {
 				return nil
 			}
 		}
+		file_chain_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chain_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookDeadLetter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chain_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupArchive); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chain_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileManifest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chain_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqFileChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chain_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chain_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProfileItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_chain_proto_rawDesc,
-			NumEnums:      9,
-			NumMessages:   16,
+			NumEnums:      11,
+			NumMessages:   32,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -3,6 +3,7 @@ package pb
 import (
 	"fmt"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoregistry"
 	"google.golang.org/protobuf/types/known/anypb"
 	"strings"
 )
@@ -39,3 +40,15 @@ func BytesToMessage(trxid string, content []byte) (proto.Message, string, error)
 	}
 	return ctnobj, typeurl, nil
 }
+
+//BytesToTypeUrl returns the type url of the content without unmarshaling the message, old data without a known
+//type is pb.Object
+func BytesToTypeUrl(content []byte) string {
+	anyobj := &anypb.Any{}
+	if err := proto.Unmarshal(content, anyobj); err == nil && anyobj.TypeUrl != "" {
+		if _, err := protoregistry.GlobalTypes.FindMessageByURL(anyobj.TypeUrl); err == nil {
+			return strings.Replace(anyobj.TypeUrl, "type.googleapis.com/", "", 1)
+		}
+	}
+	return "quorum.pb.Object"
+}
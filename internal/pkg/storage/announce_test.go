@@ -0,0 +1,91 @@
+//go:build !js
+// +build !js
+
+package storage
+
+import (
+	"testing"
+
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+func newTestAnnounceTrx(t *testing.T, item *quorumpb.AnnounceItem) *quorumpb.Trx {
+	data, err := proto.Marshal(item)
+	if err != nil {
+		t.Fatalf("marshal announce item err: %s", err)
+	}
+	return &quorumpb.Trx{TrxId: "ann", GroupId: item.GroupId, Data: data}
+}
+
+func newTestAnnounceItem(encryptPubkey string, result quorumpb.ApproveType) *quorumpb.AnnounceItem {
+	item := &quorumpb.AnnounceItem{GroupId: "group", SignPubkey: "user", EncryptPubkey: encryptPubkey, Type: quorumpb.AnnounceType_AS_USER, Result: result}
+	if result != quorumpb.ApproveType_ANNOUNCED {
+		item.OwnerPubkey = "owner"
+		item.OwnerSignature = "sign"
+	}
+	return item
+}
+
+func getTestAnnounceResult(t *testing.T, dbMgr *DbMgr) quorumpb.ApproveType {
+	item, err := dbMgr.GetAnnouncedUser("group", "user", "")
+	if err != nil {
+		t.Fatalf("get announced user err: %s", err)
+	}
+	return item.Result
+}
+
+func TestUpdateAnnounceKeepsResult(t *testing.T) {
+	dbMgr := newTestDbMgr(t)
+	if err := dbMgr.UpdateAnnounce(newTestAnnounceTrx(t, newTestAnnounceItem("key1", quorumpb.ApproveType_ANNOUNCED)), ""); err != nil {
+		t.Fatalf("update announce err: %s", err)
+	}
+	if err := dbMgr.UpdateAnnounceResult(newTestAnnounceTrx(t, newTestAnnounceItem("key1", quorumpb.ApproveType_APPROVED)), ""); err != nil {
+		t.Fatalf("update announce result err: %s", err)
+	}
+
+	//announce again with the same key keeps the result, announcer can not reset it
+	if err := dbMgr.UpdateAnnounce(newTestAnnounceTrx(t, newTestAnnounceItem("key1", quorumpb.ApproveType_ANNOUNCED)), ""); err != nil {
+		t.Fatalf("update announce err: %s", err)
+	}
+	if result := getTestAnnounceResult(t, dbMgr); result != quorumpb.ApproveType_APPROVED {
+		t.Errorf("got result %s after announced again, want APPROVED", result)
+	}
+
+	//a new encrypt pubkey needs a new result
+	if err := dbMgr.UpdateAnnounce(newTestAnnounceTrx(t, newTestAnnounceItem("key2", quorumpb.ApproveType_ANNOUNCED)), ""); err != nil {
+		t.Fatalf("update announce err: %s", err)
+	}
+	if result := getTestAnnounceResult(t, dbMgr); result != quorumpb.ApproveType_ANNOUNCED {
+		t.Errorf("got result %s after announced with a new key, want ANNOUNCED", result)
+	}
+}
+
+func TestAnnounceResultBeforeAnnounce(t *testing.T) {
+	dbMgr := newTestDbMgr(t)
+	if err := dbMgr.UpdateAnnounceResult(newTestAnnounceTrx(t, newTestAnnounceItem("key1", quorumpb.ApproveType_APPROVED)), ""); err != nil {
+		t.Fatalf("update announce result err: %s", err)
+	}
+	if _, err := dbMgr.GetAnnouncedUser("group", "user", ""); err == nil {
+		t.Fatalf("user is announced by the result")
+	}
+
+	if err := dbMgr.UpdateAnnounce(newTestAnnounceTrx(t, newTestAnnounceItem("key1", quorumpb.ApproveType_ANNOUNCED)), ""); err != nil {
+		t.Fatalf("update announce err: %s", err)
+	}
+	item, err := dbMgr.GetAnnouncedUser("group", "user", "")
+	if err != nil {
+		t.Fatalf("get announced user err: %s", err)
+	}
+	if item.Result != quorumpb.ApproveType_APPROVED || item.OwnerSignature != "sign" {
+		t.Errorf("pending result is not applied, got %s", item.Result)
+	}
+
+	//the pending result is applied once
+	if err := dbMgr.UpdateAnnounce(newTestAnnounceTrx(t, newTestAnnounceItem("key2", quorumpb.ApproveType_ANNOUNCED)), ""); err != nil {
+		t.Fatalf("update announce err: %s", err)
+	}
+	if result := getTestAnnounceResult(t, dbMgr); result != quorumpb.ApproveType_ANNOUNCED {
+		t.Errorf("got result %s after announced with a new key, want ANNOUNCED", result)
+	}
+}
@@ -0,0 +1,115 @@
+//go:build !js
+// +build !js
+
+package storage
+
+import (
+	"fmt"
+	"testing"
+
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+)
+
+//the timestamp of the i-th post, nanoseconds with 19 digits as trxs
+const testPostTime int64 = 1600000000000000000
+
+//addTestPosts adds count posts, post i is sent by sender i%2 at testPostTime+i
+func addTestPosts(t *testing.T, dbMgr *DbMgr, count int) {
+	for i := 0; i < count; i++ {
+		trx := &quorumpb.Trx{TrxId: fmt.Sprintf("trx%03d", i), GroupId: "group", SenderPubkey: fmt.Sprintf("sender%d", i%2), TimeStamp: testPostTime + int64(i)}
+		if err := dbMgr.AddPost(trx, ""); err != nil {
+			t.Fatalf("add post err: %s", err)
+		}
+	}
+}
+
+//queryTestPosts returns the trx ids of all pages and the number of pages
+func queryTestPosts(t *testing.T, dbMgr *DbMgr, query GrpCtntQuery) ([]string, int) {
+	var ids []string
+	pages := 0
+	for {
+		posts, cursor, err := dbMgr.QueryGrpCtnt("group", &query, "")
+		if err != nil {
+			t.Fatalf("query group content err: %s", err)
+		}
+		pages++
+		for _, post := range posts {
+			ids = append(ids, post.TrxId)
+		}
+		if cursor == "" {
+			return ids, pages
+		}
+		query.Cursor = cursor
+	}
+}
+
+func testPostIds(from int, to int, step int) []string {
+	var ids []string
+	for i := from; ; i += step {
+		if (step > 0 && i > to) || (step < 0 && i < to) {
+			return ids
+		}
+		ids = append(ids, fmt.Sprintf("trx%03d", i))
+	}
+}
+
+func checkTestPostIds(t *testing.T, name string, got []string, want []string) {
+	if len(got) != len(want) {
+		t.Errorf("%s: got %d posts, want %d", name, len(got), len(want))
+		return
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			t.Errorf("%s: got %s at %d, want %s", name, got[i], i, want[i])
+			return
+		}
+	}
+}
+
+func TestQueryGrpCtntPages(t *testing.T) {
+	dbMgr := newTestDbMgr(t)
+	//more posts than a scan batch
+	count := CTNT_SCAN_BATCH*2 + 50
+	addTestPosts(t, dbMgr, count)
+
+	ids, pages := queryTestPosts(t, dbMgr, GrpCtntQuery{Limit: 20})
+	checkTestPostIds(t, "forward", ids, testPostIds(0, count-1, 1))
+	if pages != count/20+1 {
+		t.Errorf("got %d pages, want %d", pages, count/20+1)
+	}
+
+	ids, _ = queryTestPosts(t, dbMgr, GrpCtntQuery{Limit: 30, Reverse: true})
+	checkTestPostIds(t, "reverse", ids, testPostIds(count-1, 0, -1))
+
+	//pages of a sender skip the scan batches of the other sender
+	ids, _ = queryTestPosts(t, dbMgr, GrpCtntQuery{Limit: 7, Senders: []string{"sender1"}})
+	checkTestPostIds(t, "sender", ids, testPostIds(1, count-1, 2))
+
+	if _, _, err := dbMgr.QueryGrpCtnt("group", &GrpCtntQuery{Limit: 10, Cursor: "invalid"}, ""); err == nil {
+		t.Errorf("query with invalid cursor is allowed")
+	}
+}
+
+func TestQueryGrpCtntTimeRange(t *testing.T) {
+	dbMgr := newTestDbMgr(t)
+	addTestPosts(t, dbMgr, 50)
+
+	cases := []struct {
+		name  string
+		query GrpCtntQuery
+		want  []string
+	}{
+		{"range", GrpCtntQuery{From: testPostTime + 10, To: testPostTime + 20}, testPostIds(10, 20, 1)},
+		{"reverse range", GrpCtntQuery{From: testPostTime + 10, To: testPostTime + 20, Reverse: true}, testPostIds(20, 10, -1)},
+		//timestamps shorter than the keys are ordered before all content
+		{"short from", GrpCtntQuery{From: 5}, testPostIds(0, 49, 1)},
+		{"short to", GrpCtntQuery{To: 9000000000}, nil},
+		{"short to reverse", GrpCtntQuery{To: 9000000000, Reverse: true}, nil},
+		{"short from reverse", GrpCtntQuery{From: 5, Reverse: true}, testPostIds(49, 0, -1)},
+	}
+	for _, c := range cases {
+		c.query.Limit = 8
+		ids, _ := queryTestPosts(t, dbMgr, c.query)
+		checkTestPostIds(t, c.name, ids, c.want)
+	}
+}
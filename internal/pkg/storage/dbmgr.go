@@ -1,12 +1,18 @@
 package storage
 
 import (
+	"bytes"
+	"encoding/base64"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 
 	logging "github.com/ipfs/go-log/v2"
 	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/anypb"
 )
 
 var dbmgr_log = logging.Logger("dbmgr")
@@ -18,7 +24,21 @@ const CNT_PREFIX string = "cnt" //content
 const ATH_PREFIX string = "ath" //auth
 const PRD_PREFIX string = "prd" //producer
 const ANN_PREFIX string = "ann" //announce
+const ANR_PREFIX string = "anr" //announce result arrived before the announce
 const SMA_PREFIX string = "sma" //schema
+const STK_PREFIX string = "stk" //stake
+const FIN_PREFIX string = "fin" //finalized block
+const HGH_PREFIX string = "hgh" //highest block
+const SNP_PREFIX string = "snp" //snapshot
+const HST_PREFIX string = "hst" //history block
+const GKY_PREFIX string = "gky" //group key
+const CKY_PREFIX string = "cky" //cipher key of key epoch
+const ROL_PREFIX string = "rol" //group role
+const WHK_PREFIX string = "whk" //webhook
+const WDL_PREFIX string = "wdl" //webhook dead letter
+const FCH_PREFIX string = "fch" //file chunk
+const PRF_PREFIX string = "prf" //profile
+const PFH_PREFIX string = "pfh" //profile history
 const CHD_PREFIX string = "chd" //cached
 
 type DbMgr struct {
@@ -26,6 +46,9 @@ type DbMgr struct {
 	Db          QuorumStorage
 	Auth        QuorumStorage
 	DataPath    string
+
+	txn      Txn      //set when the DbMgr is returned by Begin
+	onCommit []func() //called after txn is committed
 }
 
 func (dbMgr *DbMgr) CloseDb() {
@@ -34,44 +57,6 @@ func (dbMgr *DbMgr) CloseDb() {
 	dbmgr_log.Infof("ChainCtx Db closed")
 }
 
-func (dbMgr *DbMgr) TryMigration(nodeDataVer int) {
-	if nodeDataVer == 0 { //try migration 0 (Upgrade the GroupItem)
-
-		dbmgr_log.Infof("db migration v0")
-		groupItemsBytes, err := dbMgr.GetGroupsBytes()
-		if err == nil {
-			for _, b := range groupItemsBytes {
-				var item *quorumpb.GroupItem
-				item = &quorumpb.GroupItem{}
-				proto.Unmarshal(b, item)
-				if item.CipherKey == "" {
-					itemv0 := &quorumpb.GroupItemV0{}
-					proto.Unmarshal(b, itemv0)
-					if itemv0.CipherKey != "" { //ok
-						item.LastUpdate = itemv0.LastUpdate
-						item.HighestHeight = itemv0.HighestHeight
-						item.HighestBlockId = itemv0.HighestBlockId
-						item.GenesisBlock = itemv0.GenesisBlock
-						item.EncryptType = itemv0.EncryptType
-						item.ConsenseType = itemv0.ConsenseType
-						item.CipherKey = itemv0.CipherKey
-						item.AppKey = itemv0.AppKey
-						//add group to db
-						value, err := proto.Marshal(item)
-						if err == nil {
-							dbMgr.GroupInfoDb.Set([]byte(item.GroupId), value)
-							dbmgr_log.Infof("db migration v0 for group %s", item.GroupId)
-						}
-					}
-				}
-			}
-
-		}
-
-	}
-
-}
-
 //save trx
 func (dbMgr *DbMgr) AddTrx(trx *quorumpb.Trx, prefix ...string) error {
 	nodeprefix := getPrefix(prefix...)
@@ -296,6 +281,102 @@ func (dbMgr *DbMgr) GetParentBlock(blockId string, prefix ...string) (*quorumpb.
 	return parentChunk.BlockItem, err
 }
 
+//mark block as finalized, a finalized block (and all its ancestors) will never be trimmed from chain
+func (dbMgr *DbMgr) FinalizeBlock(blockId string, prefix ...string) error {
+	chunk, err := dbMgr.getBlockChunk(blockId, false, prefix...)
+	if err != nil {
+		return err
+	}
+
+	if !chunk.Finalized {
+		chunk.Finalized = true
+		err = dbMgr.saveBlockChunk(chunk, false, prefix...)
+		if err != nil {
+			return err
+		}
+	}
+
+	//update the highest finalized block of group
+	_, height, err := dbMgr.GetFinalizedBlock(chunk.BlockItem.GroupId, prefix...)
+	if err != nil {
+		return err
+	}
+
+	if chunk.Height > height {
+		nodeprefix := getPrefix(prefix...)
+		key := nodeprefix + FIN_PREFIX + "_" + chunk.BlockItem.GroupId
+		return dbMgr.Db.Set([]byte(key), []byte(chunk.BlockId))
+	}
+
+	return nil
+}
+
+func (dbMgr *DbMgr) IsBlockFinalized(blockId string, prefix ...string) (bool, error) {
+	chunk, err := dbMgr.getBlockChunk(blockId, false, prefix...)
+	if err != nil {
+		return false, err
+	}
+	return chunk.Finalized, nil
+}
+
+//get the highest finalized block id and height of group, return "" and 0 if no block finalized
+func (dbMgr *DbMgr) GetFinalizedBlock(groupId string, prefix ...string) (string, int64, error) {
+	nodeprefix := getPrefix(prefix...)
+	key := nodeprefix + FIN_PREFIX + "_" + groupId
+
+	exist, err := dbMgr.Db.IsExist([]byte(key))
+	if err != nil {
+		return "", 0, err
+	}
+	if !exist {
+		return "", 0, nil
+	}
+
+	value, err := dbMgr.Db.Get([]byte(key))
+	if err != nil {
+		return "", 0, err
+	}
+
+	chunk, err := dbMgr.getBlockChunk(string(value), false, prefix...)
+	if err != nil {
+		return "", 0, err
+	}
+	return chunk.BlockId, chunk.Height, nil
+}
+
+//save the highest block of group, it is written with the blocks applied in the same transaction, while the
+//chain info in group item is saved to another db after that
+func (dbMgr *DbMgr) SetHighestBlock(groupId string, blockId string, prefix ...string) error {
+	nodeprefix := getPrefix(prefix...)
+	key := nodeprefix + HGH_PREFIX + "_" + groupId
+	return dbMgr.Db.Set([]byte(key), []byte(blockId))
+}
+
+//get the highest block id and height of group, return "" and 0 if not saved
+func (dbMgr *DbMgr) GetHighestBlock(groupId string, prefix ...string) (string, int64, error) {
+	nodeprefix := getPrefix(prefix...)
+	key := nodeprefix + HGH_PREFIX + "_" + groupId
+
+	exist, err := dbMgr.Db.IsExist([]byte(key))
+	if err != nil {
+		return "", 0, err
+	}
+	if !exist {
+		return "", 0, nil
+	}
+
+	value, err := dbMgr.Db.Get([]byte(key))
+	if err != nil {
+		return "", 0, err
+	}
+
+	chunk, err := dbMgr.getBlockChunk(string(value), false, prefix...)
+	if err != nil {
+		return "", 0, err
+	}
+	return chunk.BlockId, chunk.Height, nil
+}
+
 //get block chunk
 func (dbMgr *DbMgr) getBlockChunk(blockId string, cached bool, prefix ...string) (*quorumpb.BlockDbChunk, error) {
 	nodeprefix := getPrefix(prefix...)
@@ -395,11 +476,55 @@ func (dbMgr *DbMgr) RemoveGroupData(item *quorumpb.GroupItem, prefix ...string)
 	//all group announced item
 	key = nodeprefix + ANN_PREFIX + "_" + item.GroupId
 	keys = append(keys, key)
+	key = nodeprefix + ANR_PREFIX + "_" + item.GroupId
+	keys = append(keys, key)
 
 	//all group schema item
 	key = nodeprefix + SMA_PREFIX + "_" + item.GroupId
 	keys = append(keys, key)
 
+	//all group stake item
+	key = nodeprefix + STK_PREFIX + "_" + item.GroupId
+	keys = append(keys, key)
+
+	//group finalized block
+	key = nodeprefix + FIN_PREFIX + "_" + item.GroupId
+	keys = append(keys, key)
+
+	//group highest block
+	key = nodeprefix + HGH_PREFIX + "_" + item.GroupId
+	keys = append(keys, key)
+
+	//group snapshot
+	key = nodeprefix + SNP_PREFIX + "_" + item.GroupId
+	keys = append(keys, key)
+
+	//group history block
+	key = nodeprefix + HST_PREFIX + "_" + item.GroupId
+	keys = append(keys, key)
+
+	//all group key item
+	key = nodeprefix + GKY_PREFIX + "_" + item.GroupId
+	keys = append(keys, key)
+
+	//all cipher key of group key epochs
+	key = nodeprefix + CKY_PREFIX + "_" + item.GroupId
+	keys = append(keys, key)
+
+	//all group role item
+	key = nodeprefix + ROL_PREFIX + "_" + item.GroupId
+	keys = append(keys, key)
+
+	//all file chunks of group
+	key = nodeprefix + FCH_PREFIX + "_" + item.GroupId
+	keys = append(keys, key)
+
+	//all profiles and profile history of group
+	key = nodeprefix + PRF_PREFIX + "_" + item.GroupId
+	keys = append(keys, key)
+	key = nodeprefix + PFH_PREFIX + "_" + item.GroupId
+	keys = append(keys, key)
+
 	//remove all
 	for _, key_prefix := range keys {
 		err := dbMgr.Db.PrefixForeachKey([]byte(key_prefix), []byte(key_prefix), false, func(k []byte, err error) error {
@@ -416,32 +541,15 @@ func (dbMgr *DbMgr) RemoveGroupData(item *quorumpb.GroupItem, prefix ...string)
 		}
 	}
 
-	keys = nil
-	//remove all cached block
-	key = nodeprefix + BLK_PREFIX + "_"
-	keys = append(keys, key)
-	key = nodeprefix + CHD_PREFIX + "_" + BLK_PREFIX + "_"
-	keys = append(keys, key)
-
-	for _, key_prefix := range keys {
-		err := dbMgr.Db.PrefixForeach([]byte(key_prefix), func(k []byte, v []byte, err error) error {
-			if err != nil {
-				return err
-			}
-
-			blockChunk := quorumpb.BlockDbChunk{}
-			perr := proto.Unmarshal(v, &blockChunk)
-			if perr != nil {
-				return perr
-			}
-
-			if blockChunk.BlockItem.GroupId == item.GroupId {
-				dbmgr_log.Debugf("Remove key %s", string(k))
-				return dbMgr.Db.Delete(k)
+	//remove all block and cached block, corrupted chunks of the group are removed too
+	for _, cached := range []bool{false, true} {
+		err := dbMgr.foreachGroupBlockChunk(item.GroupId, cached, func(k []byte, chunk *quorumpb.BlockDbChunk) error {
+			if chunk == nil {
+				dbmgr_log.Warningf("Remove corrupted block chunk %s", string(k))
 			}
-
-			return nil
-		})
+			dbmgr_log.Debugf("Remove key %s", string(k))
+			return dbMgr.Db.Delete(k)
+		}, prefix...)
 
 		if err != nil {
 			return err
@@ -455,14 +563,15 @@ func (dbMgr *DbMgr) RemoveGroupData(item *quorumpb.GroupItem, prefix ...string)
 			return err
 		}
 
+		if !bytes.Contains(v, []byte(item.GroupId)) {
+			return nil
+		}
+
+		//trx can not be unmarshaled but with the group id is removed too
 		trx := quorumpb.Trx{}
 		perr := proto.Unmarshal(v, &trx)
 
-		if perr != nil {
-			return perr
-		}
-
-		if trx.GroupId == item.GroupId {
+		if perr != nil || trx.GroupId == item.GroupId {
 			dbmgr_log.Debugf("Remove key %s", string(k))
 			return dbMgr.Db.Delete(k)
 		}
@@ -485,6 +594,9 @@ func (dbMgr *DbMgr) GetGroupsBytes() ([][]byte, error) {
 		if err != nil {
 			return err
 		}
+		if string(k) == DATA_VER_KEY {
+			return nil
+		}
 		groupItemList = append(groupItemList, v)
 		return nil
 	})
@@ -511,6 +623,52 @@ func (dbMgr *DbMgr) AddPost(trx *quorumpb.Trx, prefix ...string) error {
 	return dbMgr.Db.Set([]byte(key), ctnBytes)
 }
 
+//get post by the trx id, the post key is built from the timestamp of trx. A post is always saved with its trx,
+//the trx not found is not a post of the group
+func (dbMgr *DbMgr) GetPost(groupId string, trxId string, prefix ...string) (*quorumpb.PostItem, error) {
+	nodeprefix := getPrefix(prefix...)
+	pre := nodeprefix + GRP_PREFIX + "_" + CNT_PREFIX + "_" + groupId + "_"
+
+	key := ""
+	if trx, err := dbMgr.GetTrx(trxId, prefix...); err == nil && trx.GroupId == groupId {
+		key = pre + fmt.Sprint(trx.TimeStamp) + "_" + trxId
+	}
+
+	if key == "" {
+		return nil, errors.New("POST_NOT_FOUND")
+	}
+	exist, err := dbMgr.Db.IsExist([]byte(key))
+	if err != nil {
+		return nil, err
+	}
+	if !exist {
+		return nil, errors.New("POST_NOT_FOUND")
+	}
+
+	value, err := dbMgr.Db.Get([]byte(key))
+	if err != nil {
+		return nil, err
+	}
+	item := &quorumpb.PostItem{}
+	if err := proto.Unmarshal(value, item); err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+//save the post updated or deleted
+func (dbMgr *DbMgr) UpdPost(groupId string, item *quorumpb.PostItem, prefix ...string) error {
+	nodeprefix := getPrefix(prefix...)
+	key := nodeprefix + GRP_PREFIX + "_" + CNT_PREFIX + "_" + groupId + "_" + fmt.Sprint(item.TimeStamp) + "_" + item.TrxId
+	dbmgr_log.Infof("Update POST with key %s", key)
+
+	value, err := proto.Marshal(item)
+	if err != nil {
+		return err
+	}
+	return dbMgr.Db.Set([]byte(key), value)
+}
+
 func (dbMgr *DbMgr) GetGrpCtnt(groupId string, ctntype string, prefix ...string) ([]*quorumpb.PostItem, error) {
 	var ctnList []*quorumpb.PostItem
 	nodeprefix := getPrefix(prefix...)
@@ -532,6 +690,166 @@ func (dbMgr *DbMgr) GetGrpCtnt(groupId string, ctntype string, prefix ...string)
 	return ctnList, err
 }
 
+//keys of group content are scanned in batches, values are loaded only for keys in the batch
+const CTNT_SCAN_BATCH int = 100
+
+//GrpCtntQuery is a page query of group content, contents are ordered by timestamp
+type GrpCtntQuery struct {
+	Cursor  string   //next cursor returned by the last page, empty for the first page
+	Limit   int      //max contents in a page
+	From    int64    //timestamp range (nanoseconds), 0 is unbounded
+	To      int64
+	Senders []string //publisher pubkeys, empty for all
+	TypeUrl string   //content type url, empty for all
+	Reverse bool     //descending order
+}
+
+//QueryGrpCtnt returns a page of group content and the cursor of next page, the cursor is empty
+//if there is no more content. Content keys are "<timestamp>_<trxid>" ordered, so a page seeks to
+//the cursor (or the time range) directly instead of loading all group content
+func (dbMgr *DbMgr) QueryGrpCtnt(groupId string, query *GrpCtntQuery, prefix ...string) ([]*quorumpb.PostItem, string, error) {
+	nodeprefix := getPrefix(prefix...)
+	pre := nodeprefix + GRP_PREFIX + "_" + CNT_PREFIX + "_" + groupId + "_"
+
+	if query.Limit <= 0 {
+		return nil, "", errors.New("INVALID_LIMIT")
+	}
+
+	seek := []byte(pre)
+	if query.Reverse {
+		if query.To > 0 {
+			seek = append(seek, []byte(getCtntSeekKey(query.To)+"_")...)
+		}
+		seek = append(seek, 0xff)
+	} else if query.From > 0 {
+		seek = append(seek, []byte(getCtntSeekKey(query.From))...)
+	}
+
+	//the key of cursor is the last key of previous page, skip it
+	skipKey := ""
+	if query.Cursor != "" {
+		cursor, err := base64.RawURLEncoding.DecodeString(query.Cursor)
+		if err != nil {
+			return nil, "", errors.New("INVALID_CURSOR")
+		}
+		if _, _, err := parseCtntKey(string(cursor)); err != nil {
+			return nil, "", errors.New("INVALID_CURSOR")
+		}
+		skipKey = pre + string(cursor)
+		seek = []byte(skipKey)
+	}
+
+	senders := make(map[string]bool)
+	for _, sender := range query.Senders {
+		senders[sender] = true
+	}
+
+	var ctnList []*quorumpb.PostItem
+	for {
+		//scan a batch of keys in the time range
+		var keys []string
+		err := dbMgr.Db.PrefixForeachKey(seek, []byte(pre), query.Reverse, func(k []byte, err error) error {
+			if err != nil {
+				return err
+			}
+			key := string(k)
+			if key == skipKey {
+				return nil
+			}
+
+			timestamp, _, err := parseCtntKey(key[len(pre):])
+			if err != nil {
+				dbmgr_log.Warningf("<%s> invalid content key %s", groupId, key)
+				return nil
+			}
+			if query.From > 0 && timestamp < query.From {
+				if query.Reverse {
+					return errors.New("OK")
+				}
+				return nil
+			}
+			if query.To > 0 && timestamp > query.To {
+				if query.Reverse {
+					return nil
+				}
+				return errors.New("OK")
+			}
+
+			keys = append(keys, key)
+			if len(keys) == CTNT_SCAN_BATCH {
+				// use this to break loop
+				return errors.New("OK")
+			}
+			return nil
+		})
+		if err != nil && err.Error() != "OK" {
+			return nil, "", err
+		}
+
+		for _, key := range keys {
+			value, err := dbMgr.Db.Get([]byte(key))
+			if err != nil {
+				return nil, "", err
+			}
+			item := &quorumpb.PostItem{}
+			if err := proto.Unmarshal(value, item); err != nil {
+				return nil, "", err
+			}
+
+			if len(senders) > 0 && !senders[item.PublisherPubkey] {
+				continue
+			}
+			if query.TypeUrl != "" && GetPostTypeUrl(item.Content) != query.TypeUrl {
+				continue
+			}
+
+			ctnList = append(ctnList, item)
+			if len(ctnList) == query.Limit {
+				return ctnList, base64.RawURLEncoding.EncodeToString([]byte(key[len(pre):])), nil
+			}
+		}
+
+		if len(keys) < CTNT_SCAN_BATCH {
+			return ctnList, "", nil
+		}
+
+		//next batch starts after the last scanned key
+		skipKey = keys[len(keys)-1]
+		seek = []byte(skipKey)
+	}
+}
+
+//getCtntSeekKey pads the timestamp to the width of content keys, timestamps of trxs are nanoseconds with 19 digits,
+//a shorter one is ordered before all keys instead of by its first digits
+func getCtntSeekKey(timestamp int64) string {
+	return fmt.Sprintf("%019d", timestamp)
+}
+
+//content key after group prefix is "<timestamp>_<trxid>"
+func parseCtntKey(key string) (int64, string, error) {
+	idx := strings.Index(key, "_")
+	if idx <= 0 || idx == len(key)-1 {
+		return 0, "", errors.New("INVALID_CONTENT_KEY")
+	}
+	timestamp, err := strconv.ParseInt(key[:idx], 10, 64)
+	if err != nil {
+		return 0, "", err
+	}
+	return timestamp, key[idx+1:], nil
+}
+
+//GetPostTypeUrl returns the type url of POST content, content is an anypb.Any,
+//old content which is a pb.Object is "quorum.pb.Object"
+func GetPostTypeUrl(content []byte) string {
+	anyobj := &anypb.Any{}
+	if err := proto.Unmarshal(content, anyobj); err == nil {
+		if _, err := protoregistry.GlobalTypes.FindMessageByURL(anyobj.TypeUrl); err == nil {
+			return strings.Replace(anyobj.TypeUrl, "type.googleapis.com/", "", 1)
+		}
+	}
+	return "quorum.pb.Object"
+}
+
 //func (dbMgr *DbMgr) GetTrxContent(trxId string, prefix ...string) (*quorumpb.Trx, error) {
 //	nodeprefix := getPrefix(prefix...)
 //	var trx quorumpb.Trx
@@ -719,49 +1037,239 @@ func (dbMgr *DbMgr) IsProducer(groupId, producerPubKey string, prefix ...string)
 	return dbMgr.Db.IsExist([]byte(key))
 }
 
-func (dbMgr *DbMgr) UpdateAnnounce(trx *quorumpb.Trx, prefix ...string) (err error) {
-
+func (dbMgr *DbMgr) UpdateStake(trx *quorumpb.Trx, prefix ...string) (err error) {
 	nodeprefix := getPrefix(prefix...)
-	item := &quorumpb.AnnounceItem{}
+
+	item := &quorumpb.StakeItem{}
 	if err := proto.Unmarshal(trx.Data, item); err != nil {
 		return err
 	}
-	key := nodeprefix + ANN_PREFIX + "_" + item.GroupId + "_" + item.Type.Enum().String() + "_" + item.SignPubkey
-	return dbMgr.Db.Set([]byte(key), trx.Data)
+
+	key := nodeprefix + STK_PREFIX + "_" + item.GroupId + "_" + item.ProducerPubkey
+	dbmgr_log.Infof("upd stake with key %s", key)
+
+	//slashed stake is kept, owner can not add it back or remove the slash record
+	current, err := dbMgr.getStake(key)
+	if err != nil {
+		return err
+	}
+	if current != nil && current.Slashed {
+		return errors.New("Stake Slashed")
+	}
+
+	if item.Action == quorumpb.ActionType_ADD {
+		return dbMgr.Db.Set([]byte(key), trx.Data)
+	} else if item.Action == quorumpb.ActionType_REMOVE {
+		if current == nil {
+			return errors.New("Stake Not Found")
+		}
+		return dbMgr.Db.Delete([]byte(key))
+	} else {
+		return errors.New("unknow msgType")
+	}
 }
 
-func (dbMgr *DbMgr) GetAnnouncedUsersByGroup(groupId string, prefix ...string) ([]*quorumpb.AnnounceItem, error) {
-	var aList []*quorumpb.AnnounceItem
+func (dbMgr *DbMgr) getStake(key string) (*quorumpb.StakeItem, error) {
+	exist, err := dbMgr.Db.IsExist([]byte(key))
+	if !exist {
+		return nil, err
+	}
+
+	value, err := dbMgr.Db.Get([]byte(key))
+	if err != nil {
+		return nil, err
+	}
+	item := &quorumpb.StakeItem{}
+	if err := proto.Unmarshal(value, item); err != nil {
+		return nil, err
+	}
+	return item, nil
+}
 
+func (dbMgr *DbMgr) GetStakes(groupId string, prefix ...string) ([]*quorumpb.StakeItem, error) {
+	var sList []*quorumpb.StakeItem
 	nodeprefix := getPrefix(prefix...)
-	key := nodeprefix + ANN_PREFIX + "_" + groupId + "_" + quorumpb.AnnounceType_AS_USER.String()
+	key := nodeprefix + STK_PREFIX + "_" + groupId
+
 	err := dbMgr.Db.PrefixForeach([]byte(key), func(k []byte, v []byte, err error) error {
 		if err != nil {
 			return err
 		}
-		item := quorumpb.AnnounceItem{}
+		item := quorumpb.StakeItem{}
 		perr := proto.Unmarshal(v, &item)
 		if perr != nil {
 			return perr
 		}
-		aList = append(aList, &item)
+		sList = append(sList, &item)
 		return nil
 	})
-
-	return aList, err
+	return sList, err
 }
 
-func (dbMgr *DbMgr) GetAnnounceProducersByGroup(groupId string, prefix ...string) ([]*quorumpb.AnnounceItem, error) {
-	var aList []*quorumpb.AnnounceItem
-
+//SlashProducer marks the stake of an equivocating producer as slashed with the evidence, slashed stake is not counted any more
+func (dbMgr *DbMgr) SlashProducer(slash *quorumpb.StakeItem, prefix ...string) error {
 	nodeprefix := getPrefix(prefix...)
-	key := nodeprefix + ANN_PREFIX + "_" + groupId + "_" + quorumpb.AnnounceType_AS_PRODUCER.String()
-	err := dbMgr.Db.PrefixForeach([]byte(key), func(k []byte, v []byte, err error) error {
-		if err != nil {
-			return err
-		}
-		item := quorumpb.AnnounceItem{}
-		perr := proto.Unmarshal(v, &item)
+	key := nodeprefix + STK_PREFIX + "_" + slash.GroupId + "_" + slash.ProducerPubkey
+
+	item, err := dbMgr.getStake(key)
+	if err != nil {
+		return err
+	}
+	if item == nil {
+		item = &quorumpb.StakeItem{GroupId: slash.GroupId, ProducerPubkey: slash.ProducerPubkey, Action: quorumpb.ActionType_ADD}
+	}
+	if item.Slashed {
+		return nil
+	}
+
+	item.Stake = 0
+	item.Slashed = true
+	item.Evidence = slash.Evidence
+	item.TimeStamp = slash.TimeStamp
+	dbmgr_log.Infof("slash producer with key %s", key)
+
+	value, err := proto.Marshal(item)
+	if err != nil {
+		return err
+	}
+	return dbMgr.Db.Set([]byte(key), value)
+}
+
+func getAnnounceKey(nodeprefix string, keyPrefix string, item *quorumpb.AnnounceItem) string {
+	return nodeprefix + keyPrefix + "_" + item.GroupId + "_" + item.Type.Enum().String() + "_" + item.SignPubkey
+}
+
+//UpdateAnnounce saves an announced item. Result of the same encrypt pubkey is kept when user announces again,
+//and a result arrived before the announce is applied now
+func (dbMgr *DbMgr) UpdateAnnounce(trx *quorumpb.Trx, prefix ...string) (err error) {
+
+	nodeprefix := getPrefix(prefix...)
+	item := &quorumpb.AnnounceItem{}
+	if err := proto.Unmarshal(trx.Data, item); err != nil {
+		return err
+	}
+	key := getAnnounceKey(nodeprefix, ANN_PREFIX, item)
+
+	//result is set by group owner only, announcer can not approve itself
+	item.Result = quorumpb.ApproveType_ANNOUNCED
+	item.OwnerPubkey = ""
+	item.OwnerSignature = ""
+
+	current, err := dbMgr.getAnnounceItem(key)
+	if err != nil {
+		return err
+	}
+	pendingKey := getAnnounceKey(nodeprefix, ANR_PREFIX, item)
+	pending, err := dbMgr.getAnnounceItem(pendingKey)
+	if err != nil {
+		return err
+	}
+
+	if current != nil && current.EncryptPubkey == item.EncryptPubkey {
+		item.Result = current.Result
+		item.OwnerPubkey = current.OwnerPubkey
+		item.OwnerSignature = current.OwnerSignature
+	} else if pending != nil && pending.EncryptPubkey == item.EncryptPubkey {
+		dbmgr_log.Infof("apply pending announce result with key %s", pendingKey)
+		item.Result = pending.Result
+		item.OwnerPubkey = pending.OwnerPubkey
+		item.OwnerSignature = pending.OwnerSignature
+		if err := dbMgr.Db.Delete([]byte(pendingKey)); err != nil {
+			return err
+		}
+	}
+	return dbMgr.saveAnnounceItem(key, item)
+}
+
+//UpdateAnnounceResult saves the result of an announced item approved or rejected by group owner, result arrived
+//before the announce is kept and applied when the announce arrives
+func (dbMgr *DbMgr) UpdateAnnounceResult(trx *quorumpb.Trx, prefix ...string) (err error) {
+	nodeprefix := getPrefix(prefix...)
+	item := &quorumpb.AnnounceItem{}
+	if err := proto.Unmarshal(trx.Data, item); err != nil {
+		return err
+	}
+	key := getAnnounceKey(nodeprefix, ANN_PREFIX, item)
+
+	announced, err := dbMgr.getAnnounceItem(key)
+	if err != nil {
+		return err
+	}
+	if announced == nil {
+		pendingKey := getAnnounceKey(nodeprefix, ANR_PREFIX, item)
+		dbmgr_log.Infof("announce not found, save pending announce result with key %s", pendingKey)
+		return dbMgr.saveAnnounceItem(pendingKey, item)
+	}
+
+	//result is for the announced encrypt pubkey, user announced again with a new key needs a new result
+	if announced.EncryptPubkey != item.EncryptPubkey {
+		return errors.New("Announce Encrypt Pubkey Mismatch")
+	}
+
+	announced.Result = item.Result
+	announced.OwnerPubkey = item.OwnerPubkey
+	announced.OwnerSignature = item.OwnerSignature
+	return dbMgr.saveAnnounceItem(key, announced)
+}
+
+//getAnnounceItem returns the announce item saved with key, nil if not found
+func (dbMgr *DbMgr) getAnnounceItem(key string) (*quorumpb.AnnounceItem, error) {
+	exist, err := dbMgr.Db.IsExist([]byte(key))
+	if !exist {
+		return nil, err
+	}
+
+	value, err := dbMgr.Db.Get([]byte(key))
+	if err != nil {
+		return nil, err
+	}
+	item := &quorumpb.AnnounceItem{}
+	if err := proto.Unmarshal(value, item); err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+func (dbMgr *DbMgr) saveAnnounceItem(key string, item *quorumpb.AnnounceItem) error {
+	value, err := proto.Marshal(item)
+	if err != nil {
+		return err
+	}
+	return dbMgr.Db.Set([]byte(key), value)
+}
+
+func (dbMgr *DbMgr) GetAnnouncedUsersByGroup(groupId string, prefix ...string) ([]*quorumpb.AnnounceItem, error) {
+	var aList []*quorumpb.AnnounceItem
+
+	nodeprefix := getPrefix(prefix...)
+	key := nodeprefix + ANN_PREFIX + "_" + groupId + "_" + quorumpb.AnnounceType_AS_USER.String()
+	err := dbMgr.Db.PrefixForeach([]byte(key), func(k []byte, v []byte, err error) error {
+		if err != nil {
+			return err
+		}
+		item := quorumpb.AnnounceItem{}
+		perr := proto.Unmarshal(v, &item)
+		if perr != nil {
+			return perr
+		}
+		aList = append(aList, &item)
+		return nil
+	})
+
+	return aList, err
+}
+
+func (dbMgr *DbMgr) GetAnnounceProducersByGroup(groupId string, prefix ...string) ([]*quorumpb.AnnounceItem, error) {
+	var aList []*quorumpb.AnnounceItem
+
+	nodeprefix := getPrefix(prefix...)
+	key := nodeprefix + ANN_PREFIX + "_" + groupId + "_" + quorumpb.AnnounceType_AS_PRODUCER.String()
+	err := dbMgr.Db.PrefixForeach([]byte(key), func(k []byte, v []byte, err error) error {
+		if err != nil {
+			return err
+		}
+		item := quorumpb.AnnounceItem{}
+		perr := proto.Unmarshal(v, &item)
 		if perr != nil {
 			return perr
 		}
@@ -790,6 +1298,24 @@ func (dbMgr *DbMgr) GetAnnouncedProducer(groupId string, pubkey string, prefix .
 	return &ann, err
 }
 
+func (dbMgr *DbMgr) GetAnnouncedUser(groupId string, pubkey string, prefix ...string) (*quorumpb.AnnounceItem, error) {
+	nodeprefix := getPrefix(prefix...)
+	key := nodeprefix + ANN_PREFIX + "_" + groupId + "_" + quorumpb.AnnounceType_AS_USER.String() + "_" + pubkey
+
+	value, err := dbMgr.Db.Get([]byte(key))
+	if err != nil {
+		return nil, err
+	}
+
+	var ann quorumpb.AnnounceItem
+	err = proto.Unmarshal(value, &ann)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ann, err
+}
+
 func (dbMgr *DbMgr) IsProducerAnnounced(groupId, producerSignPubkey string, prefix ...string) (bool, error) {
 	nodeprefix := getPrefix(prefix...)
 	key := nodeprefix + ANN_PREFIX + "_" + groupId + "_" + quorumpb.AnnounceType_AS_PRODUCER.String() + "_" + producerSignPubkey
@@ -884,10 +1410,16 @@ func (dbMgr *DbMgr) GetAllSchemasByGroup(groupId string, prefix ...string) ([]*q
 	return scmList, err
 }
 
+//GetSchemaByGroup returns the schema of the type, nil if group has no schema for it
 func (dbMgr *DbMgr) GetSchemaByGroup(groupId, schemaType string, prefix ...string) (*quorumpb.SchemaItem, error) {
 	nodeprefix := getPrefix(prefix...)
 	key := nodeprefix + SMA_PREFIX + "_" + groupId + "_" + schemaType
 
+	exist, err := dbMgr.Db.IsExist([]byte(key))
+	if !exist {
+		return nil, err
+	}
+
 	schema := quorumpb.SchemaItem{}
 	value, err := dbMgr.Db.Get([]byte(key))
 	if err != nil {
@@ -902,6 +1434,727 @@ func (dbMgr *DbMgr) GetSchemaByGroup(groupId, schemaType string, prefix ...strin
 	return &schema, err
 }
 
+//save the latest snapshot of group, old snapshot is replaced
+func (dbMgr *DbMgr) SaveSnapshot(snapshot *quorumpb.Snapshot, prefix ...string) error {
+	nodeprefix := getPrefix(prefix...)
+	key := nodeprefix + SNP_PREFIX + "_" + snapshot.GroupId
+	dbmgr_log.Infof("save snapshot with key %s, height <%d>", key, snapshot.Height)
+
+	value, err := proto.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+	return dbMgr.Db.Set([]byte(key), value)
+}
+
+//get the latest snapshot of group, return nil if no snapshot taken
+func (dbMgr *DbMgr) GetSnapshot(groupId string, prefix ...string) (*quorumpb.Snapshot, error) {
+	nodeprefix := getPrefix(prefix...)
+	key := nodeprefix + SNP_PREFIX + "_" + groupId
+
+	exist, err := dbMgr.Db.IsExist([]byte(key))
+	if err != nil {
+		return nil, err
+	}
+	if !exist {
+		return nil, nil
+	}
+
+	value, err := dbMgr.Db.Get([]byte(key))
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &quorumpb.Snapshot{}
+	if err := proto.Unmarshal(value, snapshot); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+//ApplySnapshot writes the group state carried by a snapshot, existing items with the same key are overwritten
+func (dbMgr *DbMgr) ApplySnapshot(snapshot *quorumpb.Snapshot, prefix ...string) error {
+	nodeprefix := getPrefix(prefix...)
+	groupId := snapshot.GroupId
+
+	values := make(map[string]proto.Message)
+	for _, item := range snapshot.Producers {
+		values[nodeprefix+PRD_PREFIX+"_"+groupId+"_"+item.ProducerPubkey] = item
+	}
+	for _, item := range snapshot.Announces {
+		values[nodeprefix+ANN_PREFIX+"_"+groupId+"_"+item.Type.Enum().String()+"_"+item.SignPubkey] = item
+	}
+	for _, item := range snapshot.DenyList {
+		values[nodeprefix+ATH_PREFIX+"_"+groupId+"_"+item.PeerId] = item
+	}
+	for _, item := range snapshot.Schemas {
+		values[nodeprefix+SMA_PREFIX+"_"+groupId+"_"+item.Type] = item
+	}
+	for _, item := range snapshot.Stakes {
+		values[nodeprefix+STK_PREFIX+"_"+groupId+"_"+item.ProducerPubkey] = item
+	}
+	for _, item := range snapshot.GroupKeys {
+		values[nodeprefix+GKY_PREFIX+"_"+groupId+"_"+fmt.Sprint(item.Epoch)] = item
+	}
+	for _, item := range snapshot.Roles {
+		values[nodeprefix+ROL_PREFIX+"_"+groupId+"_"+item.UserPubkey] = item
+	}
+
+	for key, item := range values {
+		value, err := proto.Marshal(item)
+		if err != nil {
+			return err
+		}
+		if err := dbMgr.Db.Set([]byte(key), value); err != nil {
+			return err
+		}
+	}
+
+	dbmgr_log.Infof("<%s> snapshot <%s> applied, <%d> items", groupId, snapshot.SnapshotId, len(values))
+	return nil
+}
+
+//AddSnapshotBlock saves the block a snapshot is taken on as a finalized block with the snapshot height,
+//its ancestors are never synced, so chain never reorganizes past it
+func (dbMgr *DbMgr) AddSnapshotBlock(block *quorumpb.Block, height int64, prefix ...string) error {
+	chunk := &quorumpb.BlockDbChunk{}
+	chunk.BlockId = block.BlockId
+	chunk.BlockItem = block
+	chunk.ParentBlockId = block.PrevBlockId
+	chunk.Height = height
+	chunk.Finalized = true
+
+	if err := dbMgr.saveBlockChunk(chunk, false, prefix...); err != nil {
+		return err
+	}
+
+	nodeprefix := getPrefix(prefix...)
+	key := nodeprefix + FIN_PREFIX + "_" + block.GroupId
+	return dbMgr.Db.Set([]byte(key), []byte(block.BlockId))
+}
+
+//SetHistoryBlock saves the block a snapshot is applied on, blocks before it are synced later and connected to it
+func (dbMgr *DbMgr) SetHistoryBlock(groupId string, blockId string, prefix ...string) error {
+	nodeprefix := getPrefix(prefix...)
+	key := nodeprefix + HST_PREFIX + "_" + groupId
+	return dbMgr.Db.Set([]byte(key), []byte(blockId))
+}
+
+//GetHistoryBlock returns the block whose ancestors are not synced yet, return "" if the history is complete
+func (dbMgr *DbMgr) GetHistoryBlock(groupId string, prefix ...string) (string, error) {
+	nodeprefix := getPrefix(prefix...)
+	key := nodeprefix + HST_PREFIX + "_" + groupId
+
+	exist, err := dbMgr.Db.IsExist([]byte(key))
+	if err != nil || !exist {
+		return "", err
+	}
+
+	value, err := dbMgr.Db.Get([]byte(key))
+	if err != nil {
+		return "", err
+	}
+	return string(value), nil
+}
+
+//ConnectHistoryBlock adds the history block to the sub blocks of its parent synced, so the chain can be walked
+//from the genesis block again, and marks the history of group complete
+func (dbMgr *DbMgr) ConnectHistoryBlock(groupId string, prefix ...string) error {
+	blockId, err := dbMgr.GetHistoryBlock(groupId, prefix...)
+	if err != nil || blockId == "" {
+		return err
+	}
+
+	chunk, err := dbMgr.getBlockChunk(blockId, false, prefix...)
+	if err != nil {
+		return err
+	}
+	pChunk, err := dbMgr.getBlockChunk(chunk.ParentBlockId, false, prefix...)
+	if err != nil {
+		return err
+	}
+
+	connected := false
+	for _, id := range pChunk.SubBlockId {
+		if id == blockId {
+			connected = true
+			break
+		}
+	}
+	if !connected {
+		pChunk.SubBlockId = append(pChunk.SubBlockId, blockId)
+		if err := dbMgr.saveBlockChunk(pChunk, false, prefix...); err != nil {
+			return err
+		}
+	}
+
+	nodeprefix := getPrefix(prefix...)
+	key := nodeprefix + HST_PREFIX + "_" + groupId
+	return dbMgr.Db.Delete([]byte(key))
+}
+
+//UpdateGroupKey saves the group key item of the next key epoch, announces of removed users are rejected. An item of
+//the latest key epoch without removed users wraps the same cipher key to users approved after the rotation, its keys
+//are merged to the saved item. Items of other epochs are stale, they are created by concurrent rotations
+func (dbMgr *DbMgr) UpdateGroupKey(trx *quorumpb.Trx, prefix ...string) error {
+	nodeprefix := getPrefix(prefix...)
+
+	item := &quorumpb.GroupKeyItem{}
+	if err := proto.Unmarshal(trx.Data, item); err != nil {
+		return err
+	}
+
+	latest, err := dbMgr.GetGroupKeyEpoch(item.GroupId, prefix...)
+	if err != nil {
+		return err
+	}
+
+	key := nodeprefix + GKY_PREFIX + "_" + item.GroupId + "_" + fmt.Sprint(item.Epoch)
+	if item.Epoch == latest && len(item.RemovedUsers) == 0 {
+		saved, err := dbMgr.GetGroupKey(item.GroupId, item.Epoch, prefix...)
+		if err != nil {
+			return err
+		}
+		wrapped := make(map[string]bool)
+		for _, k := range saved.Keys {
+			wrapped[k.SignPubkey] = true
+		}
+		for _, k := range item.Keys {
+			if !wrapped[k.SignPubkey] {
+				saved.Keys = append(saved.Keys, k)
+				wrapped[k.SignPubkey] = true
+			}
+		}
+		value, err := proto.Marshal(saved)
+		if err != nil {
+			return err
+		}
+		dbmgr_log.Infof("upd group key with key %s, rewrapped", key)
+		return dbMgr.Db.Set([]byte(key), value)
+	}
+
+	if item.Epoch != latest+1 {
+		return fmt.Errorf("Stale Group Key Epoch %d, latest %d", item.Epoch, latest)
+	}
+
+	dbmgr_log.Infof("upd group key with key %s", key)
+	if err := dbMgr.Db.Set([]byte(key), trx.Data); err != nil {
+		return err
+	}
+
+	for _, pubkey := range item.RemovedUsers {
+		isUser, err := dbMgr.IsUser(item.GroupId, pubkey, prefix...)
+		if err != nil {
+			return err
+		}
+		if !isUser {
+			continue
+		}
+		announced, err := dbMgr.GetAnnouncedUser(item.GroupId, pubkey, prefix...)
+		if err != nil {
+			return err
+		}
+		announced.Result = quorumpb.ApproveType_REJECTED
+		annKey := nodeprefix + ANN_PREFIX + "_" + item.GroupId + "_" + quorumpb.AnnounceType_AS_USER.String() + "_" + pubkey
+		if err := dbMgr.saveAnnounceItem(annKey, announced); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (dbMgr *DbMgr) GetGroupKey(groupId string, epoch int64, prefix ...string) (*quorumpb.GroupKeyItem, error) {
+	nodeprefix := getPrefix(prefix...)
+	key := nodeprefix + GKY_PREFIX + "_" + groupId + "_" + fmt.Sprint(epoch)
+
+	exist, err := dbMgr.Db.IsExist([]byte(key))
+	if err != nil {
+		return nil, err
+	}
+	if !exist {
+		return nil, errors.New("GROUP_KEY_NOT_FOUND")
+	}
+
+	value, err := dbMgr.Db.Get([]byte(key))
+	if err != nil {
+		return nil, err
+	}
+	item := &quorumpb.GroupKeyItem{}
+	if err := proto.Unmarshal(value, item); err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+//GetGroupKeyEpoch returns the latest key epoch of saved group key items, 0 (the seed key) if the key is never rotated
+func (dbMgr *DbMgr) GetGroupKeyEpoch(groupId string, prefix ...string) (int64, error) {
+	items, err := dbMgr.GetGroupKeys(groupId, prefix...)
+	if err != nil {
+		return 0, err
+	}
+	var latest int64
+	for _, item := range items {
+		if item.Epoch > latest {
+			latest = item.Epoch
+		}
+	}
+	return latest, nil
+}
+
+func (dbMgr *DbMgr) GetGroupKeys(groupId string, prefix ...string) ([]*quorumpb.GroupKeyItem, error) {
+	var gkList []*quorumpb.GroupKeyItem
+	nodeprefix := getPrefix(prefix...)
+	key := nodeprefix + GKY_PREFIX + "_" + groupId
+
+	err := dbMgr.Db.PrefixForeach([]byte(key), func(k []byte, v []byte, err error) error {
+		if err != nil {
+			return err
+		}
+		item := quorumpb.GroupKeyItem{}
+		perr := proto.Unmarshal(v, &item)
+		if perr != nil {
+			return perr
+		}
+		gkList = append(gkList, &item)
+		return nil
+	})
+	return gkList, err
+}
+
+//cipher keys of key epochs are local, they are unwrapped from group key items or the seed of group
+func (dbMgr *DbMgr) SaveGroupCipherKey(groupId string, epoch int64, cipherKey string, prefix ...string) error {
+	nodeprefix := getPrefix(prefix...)
+	key := nodeprefix + CKY_PREFIX + "_" + groupId + "_" + fmt.Sprint(epoch)
+	return dbMgr.Db.Set([]byte(key), []byte(cipherKey))
+}
+
+func (dbMgr *DbMgr) GetGroupCipherKey(groupId string, epoch int64, prefix ...string) (string, error) {
+	nodeprefix := getPrefix(prefix...)
+	key := nodeprefix + CKY_PREFIX + "_" + groupId + "_" + fmt.Sprint(epoch)
+
+	exist, err := dbMgr.Db.IsExist([]byte(key))
+	if err != nil {
+		return "", err
+	}
+	if !exist {
+		return "", errors.New("CIPHER_KEY_NOT_FOUND")
+	}
+
+	value, err := dbMgr.Db.Get([]byte(key))
+	if err != nil {
+		return "", err
+	}
+	return string(value), nil
+}
+
+//UpdateRole saves the role granted to a user, the grant is deleted when the role is revoked
+func (dbMgr *DbMgr) UpdateRole(trx *quorumpb.Trx, prefix ...string) error {
+	nodeprefix := getPrefix(prefix...)
+
+	item := &quorumpb.RoleItem{}
+	if err := proto.Unmarshal(trx.Data, item); err != nil {
+		return err
+	}
+
+	key := nodeprefix + ROL_PREFIX + "_" + item.GroupId + "_" + item.UserPubkey
+	dbmgr_log.Infof("upd role with key %s", key)
+
+	if item.Action == quorumpb.ActionType_ADD {
+		return dbMgr.Db.Set([]byte(key), trx.Data)
+	} else if item.Action == quorumpb.ActionType_REMOVE {
+		//check if item exist
+		exist, err := dbMgr.Db.IsExist([]byte(key))
+		if !exist {
+			if err != nil {
+				return err
+			}
+			return errors.New("Role Not Found")
+		}
+		return dbMgr.Db.Delete([]byte(key))
+	} else {
+		return errors.New("unknow msgType")
+	}
+}
+
+//get the role granted to user, return nil if no role granted
+func (dbMgr *DbMgr) GetRole(groupId string, userPubkey string, prefix ...string) (*quorumpb.RoleItem, error) {
+	nodeprefix := getPrefix(prefix...)
+	key := nodeprefix + ROL_PREFIX + "_" + groupId + "_" + userPubkey
+
+	exist, err := dbMgr.Db.IsExist([]byte(key))
+	if err != nil {
+		return nil, err
+	}
+	if !exist {
+		return nil, nil
+	}
+
+	value, err := dbMgr.Db.Get([]byte(key))
+	if err != nil {
+		return nil, err
+	}
+
+	item := &quorumpb.RoleItem{}
+	if err := proto.Unmarshal(value, item); err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+func (dbMgr *DbMgr) GetRoles(groupId string, prefix ...string) ([]*quorumpb.RoleItem, error) {
+	var roleList []*quorumpb.RoleItem
+	nodeprefix := getPrefix(prefix...)
+	key := nodeprefix + ROL_PREFIX + "_" + groupId
+
+	err := dbMgr.Db.PrefixForeach([]byte(key), func(k []byte, v []byte, err error) error {
+		if err != nil {
+			return err
+		}
+		item := quorumpb.RoleItem{}
+		perr := proto.Unmarshal(v, &item)
+		if perr != nil {
+			return perr
+		}
+		roleList = append(roleList, &item)
+		return nil
+	})
+	return roleList, err
+}
+
+//save the profile as the current profile of the sender
+func (dbMgr *DbMgr) UpdProfile(item *quorumpb.ProfileItem, prefix ...string) error {
+	nodeprefix := getPrefix(prefix...)
+	key := nodeprefix + PRF_PREFIX + "_" + item.GroupId + "_" + item.Pubkey
+	dbmgr_log.Infof("Update profile with key %s", key)
+
+	value, err := proto.Marshal(item)
+	if err != nil {
+		return err
+	}
+	return dbMgr.Db.Set([]byte(key), value)
+}
+
+//add the profile to the history of the sender, history is ordered by the timestamp of trx
+func (dbMgr *DbMgr) AddProfileHistory(item *quorumpb.ProfileItem, prefix ...string) error {
+	nodeprefix := getPrefix(prefix...)
+	key := nodeprefix + PFH_PREFIX + "_" + item.GroupId + "_" + item.Pubkey + "_" + fmt.Sprintf("%020d", item.TimeStamp) + "_" + item.TrxId
+
+	value, err := proto.Marshal(item)
+	if err != nil {
+		return err
+	}
+	return dbMgr.Db.Set([]byte(key), value)
+}
+
+//remove the profile of a deleted post from the history of the sender
+func (dbMgr *DbMgr) RmProfileHistory(item *quorumpb.ProfileItem, prefix ...string) error {
+	nodeprefix := getPrefix(prefix...)
+	key := nodeprefix + PFH_PREFIX + "_" + item.GroupId + "_" + item.Pubkey + "_" + fmt.Sprintf("%020d", item.TimeStamp) + "_" + item.TrxId
+	return dbMgr.Db.Delete([]byte(key))
+}
+
+//RebuildProfile merges the profile history of the sender as the current profile, history is ordered by the timestamp
+//of trx (and trx id for the same timestamp), the non-empty fields of a later profile replace the earlier ones. The
+//current profile is removed if the history is empty
+func (dbMgr *DbMgr) RebuildProfile(groupId string, pubkey string, prefix ...string) error {
+	history, err := dbMgr.GetProfileHistory(groupId, pubkey, prefix...)
+	if err != nil {
+		return err
+	}
+	if len(history) == 0 {
+		nodeprefix := getPrefix(prefix...)
+		return dbMgr.Db.Delete([]byte(nodeprefix + PRF_PREFIX + "_" + groupId + "_" + pubkey))
+	}
+
+	person := &quorumpb.Person{}
+	for _, item := range history {
+		mergePerson(person, item.Person)
+	}
+	latest := history[len(history)-1]
+	return dbMgr.UpdProfile(&quorumpb.ProfileItem{GroupId: groupId, Pubkey: pubkey, Person: person, TrxId: latest.TrxId, TimeStamp: latest.TimeStamp}, prefix...)
+}
+
+func mergePerson(current *quorumpb.Person, person *quorumpb.Person) {
+	if person == nil {
+		return
+	}
+	if person.Id != "" {
+		current.Id = person.Id
+	}
+	if person.Name != "" {
+		current.Name = person.Name
+	}
+	if person.Image != nil {
+		current.Image = person.Image
+	}
+	if len(person.Wallet) > 0 {
+		current.Wallet = person.Wallet
+	}
+}
+
+//RebuildGroupProfiles rebuilds the profiles and profile history of the group from the Person posts saved
+func (dbMgr *DbMgr) RebuildGroupProfiles(groupId string, prefix ...string) error {
+	nodeprefix := getPrefix(prefix...)
+	for _, key := range []string{nodeprefix + PRF_PREFIX + "_" + groupId + "_", nodeprefix + PFH_PREFIX + "_" + groupId + "_"} {
+		err := dbMgr.Db.PrefixForeachKey([]byte(key), []byte(key), false, func(k []byte, err error) error {
+			if err != nil {
+				return err
+			}
+			return dbMgr.Db.Delete(k)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	senders := make(map[string]bool)
+	pre := nodeprefix + GRP_PREFIX + "_" + CNT_PREFIX + "_" + groupId + "_"
+	var items []*quorumpb.ProfileItem
+	err := dbMgr.Db.PrefixForeach([]byte(pre), func(k []byte, v []byte, err error) error {
+		if err != nil {
+			return err
+		}
+		post := &quorumpb.PostItem{}
+		if err := proto.Unmarshal(v, post); err != nil || post.Deleted {
+			return nil
+		}
+		ctnobj, _, err := quorumpb.BytesToMessage(post.TrxId, post.Content)
+		if err != nil {
+			return nil
+		}
+		if person, ok := ctnobj.(*quorumpb.Person); ok {
+			items = append(items, &quorumpb.ProfileItem{GroupId: groupId, Pubkey: post.PublisherPubkey, Person: person, TrxId: post.TrxId, TimeStamp: post.TimeStamp})
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		if err := dbMgr.AddProfileHistory(item, prefix...); err != nil {
+			return err
+		}
+		senders[item.Pubkey] = true
+	}
+	for pubkey := range senders {
+		if err := dbMgr.RebuildProfile(groupId, pubkey, prefix...); err != nil {
+			return err
+		}
+	}
+	dbmgr_log.Infof("<%s> profiles of <%d> senders rebuilt", groupId, len(senders))
+	return nil
+}
+
+//get the current profile of the sender, return nil if not found
+func (dbMgr *DbMgr) GetProfile(groupId string, pubkey string, prefix ...string) (*quorumpb.ProfileItem, error) {
+	nodeprefix := getPrefix(prefix...)
+	key := nodeprefix + PRF_PREFIX + "_" + groupId + "_" + pubkey
+
+	exist, err := dbMgr.Db.IsExist([]byte(key))
+	if err != nil {
+		return nil, err
+	}
+	if !exist {
+		return nil, nil
+	}
+
+	value, err := dbMgr.Db.Get([]byte(key))
+	if err != nil {
+		return nil, err
+	}
+
+	item := &quorumpb.ProfileItem{}
+	if err := proto.Unmarshal(value, item); err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+func (dbMgr *DbMgr) GetProfiles(groupId string, prefix ...string) ([]*quorumpb.ProfileItem, error) {
+	var profileList []*quorumpb.ProfileItem
+	nodeprefix := getPrefix(prefix...)
+	key := nodeprefix + PRF_PREFIX + "_" + groupId + "_"
+
+	err := dbMgr.Db.PrefixForeach([]byte(key), func(k []byte, v []byte, err error) error {
+		if err != nil {
+			return err
+		}
+		item := quorumpb.ProfileItem{}
+		perr := proto.Unmarshal(v, &item)
+		if perr != nil {
+			return perr
+		}
+		profileList = append(profileList, &item)
+		return nil
+	})
+	return profileList, err
+}
+
+func (dbMgr *DbMgr) GetProfileHistory(groupId string, pubkey string, prefix ...string) ([]*quorumpb.ProfileItem, error) {
+	var profileList []*quorumpb.ProfileItem
+	nodeprefix := getPrefix(prefix...)
+	key := nodeprefix + PFH_PREFIX + "_" + groupId + "_" + pubkey + "_"
+
+	err := dbMgr.Db.PrefixForeach([]byte(key), func(k []byte, v []byte, err error) error {
+		if err != nil {
+			return err
+		}
+		item := quorumpb.ProfileItem{}
+		perr := proto.Unmarshal(v, &item)
+		if perr != nil {
+			return perr
+		}
+		profileList = append(profileList, &item)
+		return nil
+	})
+	return profileList, err
+}
+
+//add or update the webhook subscription
+func (dbMgr *DbMgr) AddWebhook(item *quorumpb.WebhookItem, prefix ...string) error {
+	nodeprefix := getPrefix(prefix...)
+	key := nodeprefix + WHK_PREFIX + "_" + item.WebhookId
+	dbmgr_log.Infof("add webhook with key %s", key)
+
+	value, err := proto.Marshal(item)
+	if err != nil {
+		return err
+	}
+	return dbMgr.Db.Set([]byte(key), value)
+}
+
+//remove the webhook subscription and its dead letters
+func (dbMgr *DbMgr) RmWebhook(webhookId string, prefix ...string) error {
+	nodeprefix := getPrefix(prefix...)
+	key := nodeprefix + WHK_PREFIX + "_" + webhookId
+
+	exist, err := dbMgr.Db.IsExist([]byte(key))
+	if !exist {
+		if err != nil {
+			return err
+		}
+		return errors.New("Webhook Not Found")
+	}
+	if err := dbMgr.Db.Delete([]byte(key)); err != nil {
+		return err
+	}
+
+	key = nodeprefix + WDL_PREFIX + "_" + webhookId + "_"
+	return dbMgr.Db.PrefixForeachKey([]byte(key), []byte(key), false, func(k []byte, err error) error {
+		if err != nil {
+			return err
+		}
+		return dbMgr.Db.Delete(k)
+	})
+}
+
+//get the webhook subscription, return nil if not found
+func (dbMgr *DbMgr) GetWebhook(webhookId string, prefix ...string) (*quorumpb.WebhookItem, error) {
+	nodeprefix := getPrefix(prefix...)
+	key := nodeprefix + WHK_PREFIX + "_" + webhookId
+
+	exist, err := dbMgr.Db.IsExist([]byte(key))
+	if err != nil {
+		return nil, err
+	}
+	if !exist {
+		return nil, nil
+	}
+
+	value, err := dbMgr.Db.Get([]byte(key))
+	if err != nil {
+		return nil, err
+	}
+
+	item := &quorumpb.WebhookItem{}
+	if err := proto.Unmarshal(value, item); err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+func (dbMgr *DbMgr) GetWebhooks(prefix ...string) ([]*quorumpb.WebhookItem, error) {
+	var webhookList []*quorumpb.WebhookItem
+	nodeprefix := getPrefix(prefix...)
+	key := nodeprefix + WHK_PREFIX + "_"
+
+	err := dbMgr.Db.PrefixForeach([]byte(key), func(k []byte, v []byte, err error) error {
+		if err != nil {
+			return err
+		}
+		item := quorumpb.WebhookItem{}
+		perr := proto.Unmarshal(v, &item)
+		if perr != nil {
+			return perr
+		}
+		webhookList = append(webhookList, &item)
+		return nil
+	})
+	return webhookList, err
+}
+
+func (dbMgr *DbMgr) AddWebhookDeadLetter(item *quorumpb.WebhookDeadLetter, prefix ...string) error {
+	nodeprefix := getPrefix(prefix...)
+	key := nodeprefix + WDL_PREFIX + "_" + item.WebhookId + "_" + item.DeliveryId
+	dbmgr_log.Infof("add webhook dead letter with key %s", key)
+
+	value, err := proto.Marshal(item)
+	if err != nil {
+		return err
+	}
+	return dbMgr.Db.Set([]byte(key), value)
+}
+
+func (dbMgr *DbMgr) GetWebhookDeadLetters(webhookId string, prefix ...string) ([]*quorumpb.WebhookDeadLetter, error) {
+	var letterList []*quorumpb.WebhookDeadLetter
+	nodeprefix := getPrefix(prefix...)
+	key := nodeprefix + WDL_PREFIX + "_" + webhookId + "_"
+
+	err := dbMgr.Db.PrefixForeach([]byte(key), func(k []byte, v []byte, err error) error {
+		if err != nil {
+			return err
+		}
+		item := quorumpb.WebhookDeadLetter{}
+		perr := proto.Unmarshal(v, &item)
+		if perr != nil {
+			return perr
+		}
+		letterList = append(letterList, &item)
+		return nil
+	})
+	return letterList, err
+}
+
+//save the encrypted file chunk, keyed by its hash
+func (dbMgr *DbMgr) SaveFileChunk(groupId string, hash string, data []byte, prefix ...string) error {
+	nodeprefix := getPrefix(prefix...)
+	key := nodeprefix + FCH_PREFIX + "_" + groupId + "_" + hash
+	return dbMgr.Db.Set([]byte(key), data)
+}
+
+//get the encrypted file chunk, return nil if not found
+func (dbMgr *DbMgr) GetFileChunk(groupId string, hash string, prefix ...string) ([]byte, error) {
+	nodeprefix := getPrefix(prefix...)
+	key := nodeprefix + FCH_PREFIX + "_" + groupId + "_" + hash
+
+	exist, err := dbMgr.Db.IsExist([]byte(key))
+	if err != nil {
+		return nil, err
+	}
+	if !exist {
+		return nil, nil
+	}
+	return dbMgr.Db.Get([]byte(key))
+}
+
+//remove the encrypted file chunk
+func (dbMgr *DbMgr) RmFileChunk(groupId string, hash string, prefix ...string) error {
+	nodeprefix := getPrefix(prefix...)
+	key := nodeprefix + FCH_PREFIX + "_" + groupId + "_" + hash
+	return dbMgr.Db.Delete([]byte(key))
+}
+
 func getPrefix(prefix ...string) string {
 	nodeprefix := ""
 	if len(prefix) == 1 {
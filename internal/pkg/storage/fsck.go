@@ -0,0 +1,215 @@
+package storage
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+//issues found by CheckGroup
+const FSCK_CORRUPTED_BLOCK string = "CORRUPTED_BLOCK"               //block chunk can not be unmarshaled
+const FSCK_MISSING_BLOCK string = "MISSING_BLOCK"                   //genesis block or SubBlockId not found in chain
+const FSCK_DUPLICATED_SUB_BLOCK string = "DUPLICATED_SUB_BLOCK"     //SubBlockId contains a block more than once
+const FSCK_PARENT_MISMATCH string = "PARENT_MISMATCH"               //ParentBlockId or PrevBlockId of sub block is not the block
+const FSCK_HEIGHT_MISMATCH string = "HEIGHT_MISMATCH"               //height of block is not height of parent + 1, or height of group is wrong
+const FSCK_ORPHAN_BLOCK string = "ORPHAN_BLOCK"                     //block in chain can not be reached from genesis block
+const FSCK_STALE_CACHED_BLOCK string = "STALE_CACHED_BLOCK"         //cached block is already in chain
+const FSCK_ORPHAN_CACHED_BLOCK string = "ORPHAN_CACHED_BLOCK"       //parent of cached block is neither in chain nor in cache
+const FSCK_HIGHEST_BLOCK_MISMATCH string = "HIGHEST_BLOCK_MISMATCH" //HighestBlockId of group is not in chain
+const FSCK_MISSING_TRX string = "MISSING_TRX"                       //trx of block in chain is not saved
+const FSCK_POST_WITHOUT_TRX string = "POST_WITHOUT_TRX"             //trx of post is not saved
+
+type FsckIssue struct {
+	Type    string `json:"type"`
+	BlockId string `json:"block_id,omitempty"`
+	TrxId   string `json:"trx_id,omitempty"`
+	Detail  string `json:"detail"`
+}
+
+type FsckReport struct {
+	GroupId      string       `json:"group_id"`
+	Blocks       int          `json:"blocks"`
+	CachedBlocks int          `json:"cached_blocks"`
+	Trxs         int          `json:"trxs"`
+	Posts        int          `json:"posts"`
+	Issues       []*FsckIssue `json:"issues"`
+}
+
+func (report *FsckReport) addIssue(issueType string, blockId string, trxId string, format string, a ...interface{}) {
+	report.Issues = append(report.Issues, &FsckIssue{Type: issueType, BlockId: blockId, TrxId: trxId, Detail: fmt.Sprintf(format, a...)})
+}
+
+//foreachGroupBlockChunk calls fn with the block chunks of the group in chain or in cache. Block keys have no
+//group id, chunks without the group id in the value are skipped before unmarshaled, chunks with the group id
+//but can not be unmarshaled are passed to fn with a nil chunk
+func (dbMgr *DbMgr) foreachGroupBlockChunk(groupId string, cached bool, fn func(k []byte, chunk *quorumpb.BlockDbChunk) error, prefix ...string) error {
+	nodeprefix := getPrefix(prefix...)
+	var key string
+	if cached {
+		key = nodeprefix + CHD_PREFIX + "_" + BLK_PREFIX + "_"
+	} else {
+		key = nodeprefix + BLK_PREFIX + "_"
+	}
+
+	return dbMgr.Db.PrefixForeach([]byte(key), func(k []byte, v []byte, err error) error {
+		if err != nil {
+			return err
+		}
+		if !bytes.Contains(v, []byte(groupId)) {
+			return nil
+		}
+		chunk := &quorumpb.BlockDbChunk{}
+		if perr := proto.Unmarshal(v, chunk); perr != nil || chunk.BlockItem == nil {
+			return fn(k, nil)
+		}
+		if chunk.BlockItem.GroupId != groupId {
+			return nil
+		}
+		return fn(k, chunk)
+	})
+}
+
+//GetGroupBlockChunks returns the block chunks of the group in chain or in cache, the chunks of the group
+//can not be unmarshaled are returned by keys
+func (dbMgr *DbMgr) GetGroupBlockChunks(groupId string, cached bool, prefix ...string) ([]*quorumpb.BlockDbChunk, []string, error) {
+	chunks := []*quorumpb.BlockDbChunk{}
+	corrupted := []string{}
+	err := dbMgr.foreachGroupBlockChunk(groupId, cached, func(k []byte, chunk *quorumpb.BlockDbChunk) error {
+		if chunk == nil {
+			corrupted = append(corrupted, string(k))
+		} else {
+			chunks = append(chunks, chunk)
+		}
+		return nil
+	}, prefix...)
+	return chunks, corrupted, err
+}
+
+//CheckGroup walks the block chunks from the genesis block of the group, and reports the inconsistencies of
+//blocks, cached blocks, trxs, posts and the chain info of the group item
+func (dbMgr *DbMgr) CheckGroup(item *quorumpb.GroupItem, prefix ...string) (*FsckReport, error) {
+	dbmgr_log.Debugf("<%s> CheckGroup called", item.GroupId)
+	report := &FsckReport{GroupId: item.GroupId, Issues: []*FsckIssue{}}
+
+	chunkList, corrupted, err := dbMgr.GetGroupBlockChunks(item.GroupId, false, prefix...)
+	if err != nil {
+		return nil, err
+	}
+	cachedList, cachedCorrupted, err := dbMgr.GetGroupBlockChunks(item.GroupId, true, prefix...)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range append(corrupted, cachedCorrupted...) {
+		report.addIssue(FSCK_CORRUPTED_BLOCK, "", "", "block chunk %s can not be unmarshaled", key)
+	}
+
+	chunks := make(map[string]*quorumpb.BlockDbChunk)
+	for _, chunk := range chunkList {
+		chunks[chunk.BlockId] = chunk
+	}
+
+	//walk from the genesis block by SubBlockId
+	reached := make(map[string]bool)
+	genesisBlockId := item.GenesisBlock.BlockId
+	if _, ok := chunks[genesisBlockId]; ok {
+		reached[genesisBlockId] = true
+		queue := []string{genesisBlockId}
+		for len(queue) > 0 {
+			chunk := chunks[queue[0]]
+			queue = queue[1:]
+			report.Blocks++
+
+			if chunk.BlockId != genesisBlockId {
+				for _, trx := range chunk.BlockItem.Trxs {
+					report.Trxs++
+					exist, err := dbMgr.IsTrxExist(trx.TrxId, prefix...)
+					if err != nil {
+						return nil, err
+					}
+					if !exist {
+						report.addIssue(FSCK_MISSING_TRX, chunk.BlockId, trx.TrxId, "trx of block is not saved")
+					}
+				}
+			}
+
+			subs := make(map[string]bool)
+			for _, subBlockId := range chunk.SubBlockId {
+				if subs[subBlockId] {
+					report.addIssue(FSCK_DUPLICATED_SUB_BLOCK, chunk.BlockId, "", "sub block %s is duplicated", subBlockId)
+					continue
+				}
+				subs[subBlockId] = true
+
+				sub, ok := chunks[subBlockId]
+				if !ok {
+					report.addIssue(FSCK_MISSING_BLOCK, subBlockId, "", "sub block of %s is not in chain", chunk.BlockId)
+					continue
+				}
+				if sub.ParentBlockId != chunk.BlockId || sub.BlockItem.PrevBlockId != chunk.BlockId {
+					report.addIssue(FSCK_PARENT_MISMATCH, subBlockId, "", "sub block of %s has ParentBlockId %s and PrevBlockId %s", chunk.BlockId, sub.ParentBlockId, sub.BlockItem.PrevBlockId)
+				}
+				if sub.Height != chunk.Height+1 {
+					report.addIssue(FSCK_HEIGHT_MISMATCH, subBlockId, "", "height is %d, height of parent %s is %d", sub.Height, chunk.BlockId, chunk.Height)
+				}
+				if !reached[subBlockId] {
+					reached[subBlockId] = true
+					queue = append(queue, subBlockId)
+				}
+			}
+		}
+	} else {
+		report.addIssue(FSCK_MISSING_BLOCK, genesisBlockId, "", "genesis block is not in chain")
+	}
+
+	for _, chunk := range chunkList {
+		if !reached[chunk.BlockId] {
+			report.addIssue(FSCK_ORPHAN_BLOCK, chunk.BlockId, "", "block with PrevBlockId %s can not be reached from genesis block", chunk.BlockItem.PrevBlockId)
+		}
+	}
+
+	cached := make(map[string]bool)
+	for _, chunk := range cachedList {
+		cached[chunk.BlockId] = true
+	}
+	for _, chunk := range cachedList {
+		report.CachedBlocks++
+		if _, ok := chunks[chunk.BlockId]; ok {
+			report.addIssue(FSCK_STALE_CACHED_BLOCK, chunk.BlockId, "", "cached block is already in chain")
+		} else if _, ok := chunks[chunk.BlockItem.PrevBlockId]; !ok && !cached[chunk.BlockItem.PrevBlockId] {
+			report.addIssue(FSCK_ORPHAN_CACHED_BLOCK, chunk.BlockId, "", "parent %s of cached block is neither in chain nor in cache", chunk.BlockItem.PrevBlockId)
+		}
+	}
+
+	if highest, ok := chunks[item.HighestBlockId]; !ok || !reached[item.HighestBlockId] {
+		report.addIssue(FSCK_HIGHEST_BLOCK_MISMATCH, item.HighestBlockId, "", "highest block of group is not in chain")
+	} else if highest.Height != item.HighestHeight {
+		report.addIssue(FSCK_HEIGHT_MISMATCH, item.HighestBlockId, "", "highest height of group is %d, height of highest block is %d", item.HighestHeight, highest.Height)
+	}
+
+	//post key: <prefix>grp_cnt_<groupid>_<timestamp>_<trxid>
+	key := getPrefix(prefix...) + GRP_PREFIX + "_" + CNT_PREFIX + "_" + item.GroupId + "_"
+	err = dbMgr.Db.PrefixForeachKey([]byte(key), []byte(key), false, func(k []byte, err error) error {
+		if err != nil {
+			return err
+		}
+		report.Posts++
+		trxId := string(k)[strings.LastIndex(string(k), "_")+1:]
+		exist, err := dbMgr.IsTrxExist(trxId, prefix...)
+		if err != nil {
+			return err
+		}
+		if !exist {
+			report.addIssue(FSCK_POST_WITHOUT_TRX, "", trxId, "trx of post is not saved")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	dbmgr_log.Infof("<%s> checked <%d> blocks, <%d> cached blocks, <%d> trxs, <%d> posts, found <%d> issues", item.GroupId, report.Blocks, report.CachedBlocks, report.Trxs, report.Posts, len(report.Issues))
+	return report, nil
+}
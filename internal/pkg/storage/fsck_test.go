@@ -0,0 +1,113 @@
+//go:build !js
+// +build !js
+
+package storage
+
+import (
+	"sort"
+	"testing"
+
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+func setTestBlockChunk(t *testing.T, dbMgr *DbMgr, groupId string, blockId string, cached bool) string {
+	key := getPrefix("") + BLK_PREFIX + "_" + blockId
+	if cached {
+		key = getPrefix("") + CHD_PREFIX + "_" + BLK_PREFIX + "_" + blockId
+	}
+	chunk := &quorumpb.BlockDbChunk{BlockId: blockId, BlockItem: &quorumpb.Block{BlockId: blockId, GroupId: groupId}}
+	value, err := proto.Marshal(chunk)
+	if err != nil {
+		t.Fatalf("marshal chunk err: %s", err)
+	}
+	if err := dbMgr.Db.Set([]byte(key), value); err != nil {
+		t.Fatalf("set chunk err: %s", err)
+	}
+	return key
+}
+
+//setTestCorruptedChunk saves a chunk with the group id which can not be unmarshaled
+func setTestCorruptedChunk(t *testing.T, dbMgr *DbMgr, groupId string, blockId string) string {
+	key := getPrefix("") + BLK_PREFIX + "_" + blockId
+	if err := dbMgr.Db.Set([]byte(key), []byte("\x0a\xff"+groupId)); err != nil {
+		t.Fatalf("set chunk err: %s", err)
+	}
+	return key
+}
+
+func getTestChunkIds(t *testing.T, dbMgr *DbMgr, groupId string, cached bool) ([]string, []string) {
+	chunks, corrupted, err := dbMgr.GetGroupBlockChunks(groupId, cached, "")
+	if err != nil {
+		t.Fatalf("get group block chunks err: %s", err)
+	}
+	var ids []string
+	for _, chunk := range chunks {
+		ids = append(ids, chunk.BlockId)
+	}
+	sort.Strings(ids)
+	return ids, corrupted
+}
+
+func TestGetGroupBlockChunks(t *testing.T) {
+	dbMgr := newTestDbMgr(t)
+	setTestBlockChunk(t, dbMgr, "group_a", "a1", false)
+	setTestBlockChunk(t, dbMgr, "group_a", "a2", false)
+	setTestBlockChunk(t, dbMgr, "group_a", "a3", true)
+	setTestBlockChunk(t, dbMgr, "group_b", "b1", false)
+	corruptedA := setTestCorruptedChunk(t, dbMgr, "group_a", "a4")
+	setTestCorruptedChunk(t, dbMgr, "group_b", "b2")
+
+	ids, corrupted := getTestChunkIds(t, dbMgr, "group_a", false)
+	if len(ids) != 2 || ids[0] != "a1" || ids[1] != "a2" {
+		t.Errorf("got chunks %v of group_a, want [a1 a2]", ids)
+	}
+	if len(corrupted) != 1 || corrupted[0] != corruptedA {
+		t.Errorf("got corrupted chunks %v of group_a, want [%s]", corrupted, corruptedA)
+	}
+
+	ids, corrupted = getTestChunkIds(t, dbMgr, "group_a", true)
+	if len(ids) != 1 || ids[0] != "a3" || len(corrupted) != 0 {
+		t.Errorf("got cached chunks %v, corrupted %v of group_a, want [a3] []", ids, corrupted)
+	}
+}
+
+func TestRemoveGroupDataWithCorruptedChunk(t *testing.T) {
+	dbMgr := newTestDbMgr(t)
+	keys := []string{
+		setTestBlockChunk(t, dbMgr, "group_a", "a1", false),
+		setTestBlockChunk(t, dbMgr, "group_a", "a2", true),
+		setTestCorruptedChunk(t, dbMgr, "group_a", "a3"),
+	}
+	kept := []string{
+		setTestBlockChunk(t, dbMgr, "group_b", "b1", false),
+		setTestCorruptedChunk(t, dbMgr, "group_b", "b2"),
+	}
+	trxs := map[string]string{"ta": "group_a", "tb": "group_b"}
+	for trxId, groupId := range trxs {
+		if err := dbMgr.AddTrx(&quorumpb.Trx{TrxId: trxId, GroupId: groupId}, ""); err != nil {
+			t.Fatalf("add trx err: %s", err)
+		}
+	}
+
+	if err := dbMgr.RemoveGroupData(&quorumpb.GroupItem{GroupId: "group_a"}, ""); err != nil {
+		t.Fatalf("remove group data err: %s", err)
+	}
+
+	for _, key := range keys {
+		if exist, _ := dbMgr.Db.IsExist([]byte(key)); exist {
+			t.Errorf("%s of group_a is not removed", key)
+		}
+	}
+	for _, key := range kept {
+		if exist, _ := dbMgr.Db.IsExist([]byte(key)); !exist {
+			t.Errorf("%s of group_b is removed", key)
+		}
+	}
+	if exist, _ := dbMgr.IsTrxExist("ta", ""); exist {
+		t.Errorf("trx of group_a is not removed")
+	}
+	if exist, _ := dbMgr.IsTrxExist("tb", ""); !exist {
+		t.Errorf("trx of group_b is removed")
+	}
+}
@@ -0,0 +1,251 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+const DATA_VER_KEY string = "_data_ver" //data version of the db
+
+//db names, the suffix of the data path
+const GROUPS_DB string = "_groups"
+const DATA_DB string = "_db"
+const APP_DB string = "_appdb"
+
+var ErrDataVerTooNew = errors.New("DATA_VER_TOO_NEW")
+
+//Migration upgrades the data of a db to Version, it should be safe to run again on the upgraded data,
+//and should only report the changes without writing when dryRun
+type Migration struct {
+	Version int
+	Desc    string
+	Migrate func(db QuorumStorage, dryRun bool) error
+}
+
+type MigrationOptions struct {
+	DryRun bool
+	Backup bool //backup the db to <datapath><dbname>.v<ver>.<time>.bak before migration
+}
+
+//Backuper is implemented by the storages which can be backuped before migration
+type Backuper interface {
+	Backup(path string) error
+}
+
+var migrations = map[string][]*Migration{
+	GROUPS_DB: {
+		{Version: 1, Desc: "upgrade GroupItemV0 to GroupItem", Migrate: migrateGroupItemV0},
+	},
+	DATA_DB: {
+		{Version: 1, Desc: "build profiles from the Person posts", Migrate: migrateProfiles},
+	},
+}
+
+//RegisterMigration adds a migration of the db, versions of a db should be registered in increasing order
+func RegisterMigration(dbname string, m *Migration) {
+	if latest := GetLatestDataVer(dbname); m.Version <= latest {
+		panic(fmt.Sprintf("migration v%d of %s should be newer than v%d", m.Version, dbname, latest))
+	}
+	migrations[dbname] = append(migrations[dbname], m)
+}
+
+//GetLatestDataVer returns the data version of the db supported by this binary
+func GetLatestDataVer(dbname string) int {
+	ms := migrations[dbname]
+	if len(ms) == 0 {
+		return 0
+	}
+	return ms[len(ms)-1].Version
+}
+
+//GetDataVer returns the data version saved in the db, 0 if the db is not versioned yet
+func GetDataVer(db QuorumStorage) (int, error) {
+	exist, err := db.IsExist([]byte(DATA_VER_KEY))
+	if err != nil || !exist {
+		return 0, err
+	}
+	value, err := db.Get([]byte(DATA_VER_KEY))
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(string(value))
+}
+
+func SetDataVer(db QuorumStorage, ver int) error {
+	return db.Set([]byte(DATA_VER_KEY), []byte(strconv.Itoa(ver)))
+}
+
+//Migrate runs the migrations newer than the data version of the db in order, and saves the version after
+//each migration. It returns the migrations ran (or to run when dry run), and ErrDataVerTooNew if the data
+//is written by a newer binary
+func Migrate(datapath string, dbname string, db QuorumStorage, opt *MigrationOptions) ([]*Migration, error) {
+	ver, err := GetDataVer(db)
+	if err != nil {
+		return nil, err
+	}
+	latest := GetLatestDataVer(dbname)
+	if ver > latest {
+		dbmgr_log.Errorf("data version of %s is v%d, this binary supports v%d at most", dbname, ver, latest)
+		return nil, ErrDataVerTooNew
+	}
+
+	pending := []*Migration{}
+	for _, m := range migrations[dbname] {
+		if m.Version > ver {
+			pending = append(pending, m)
+		}
+	}
+	if len(pending) == 0 {
+		return pending, nil
+	}
+
+	if opt.Backup && !opt.DryRun {
+		if backuper, ok := db.(Backuper); ok {
+			backupPath := fmt.Sprintf("%s%s.v%d.%s.bak", datapath, dbname, ver, time.Now().Format("20060102150405"))
+			dbmgr_log.Infof("backup %s v%d to %s", dbname, ver, backupPath)
+			if err := backuper.Backup(backupPath); err != nil {
+				return nil, fmt.Errorf("backup %s failed: %s", dbname, err.Error())
+			}
+		} else {
+			dbmgr_log.Warningf("backup of %s is not supported, skipped", dbname)
+		}
+	}
+
+	for _, m := range pending {
+		if opt.DryRun {
+			dbmgr_log.Infof("[dry run] db migration %s v%d: %s", dbname, m.Version, m.Desc)
+		} else {
+			dbmgr_log.Infof("db migration %s v%d: %s", dbname, m.Version, m.Desc)
+		}
+		if err := m.Migrate(db, opt.DryRun); err != nil {
+			return nil, fmt.Errorf("db migration %s v%d failed: %s", dbname, m.Version, err.Error())
+		}
+		if !opt.DryRun {
+			if err := SetDataVer(db, m.Version); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return pending, nil
+}
+
+//Migrate runs the migrations of the groups db and the data db
+func (dbMgr *DbMgr) Migrate(opt *MigrationOptions) ([]*Migration, error) {
+	ran, err := Migrate(dbMgr.DataPath, GROUPS_DB, dbMgr.GroupInfoDb, opt)
+	if err != nil {
+		return nil, err
+	}
+	dataRan, err := Migrate(dbMgr.DataPath, DATA_DB, dbMgr.Db, opt)
+	if err != nil {
+		return nil, err
+	}
+	return append(ran, dataRan...), nil
+}
+
+//migrateGroupItemV0 upgrades the GroupItem saved by the versions before the CipherKey field was moved
+func migrateGroupItemV0(db QuorumStorage, dryRun bool) error {
+	items := []*quorumpb.GroupItem{}
+	err := db.Foreach(func(k []byte, v []byte, err error) error {
+		if err != nil {
+			return err
+		}
+		if string(k) == DATA_VER_KEY {
+			return nil
+		}
+		//the v0 item may not be unmarshaled as GroupItem without error
+		item := &quorumpb.GroupItem{}
+		proto.Unmarshal(v, item)
+		if item.CipherKey != "" {
+			return nil
+		}
+		itemv0 := &quorumpb.GroupItemV0{}
+		if err := proto.Unmarshal(v, itemv0); err != nil || itemv0.CipherKey == "" {
+			return nil
+		}
+		item.LastUpdate = itemv0.LastUpdate
+		item.HighestHeight = itemv0.HighestHeight
+		item.HighestBlockId = itemv0.HighestBlockId
+		item.GenesisBlock = itemv0.GenesisBlock
+		item.EncryptType = itemv0.EncryptType
+		item.ConsenseType = itemv0.ConsenseType
+		item.CipherKey = itemv0.CipherKey
+		item.AppKey = itemv0.AppKey
+		items = append(items, item)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		if dryRun {
+			dbmgr_log.Infof("[dry run] group %s will be upgraded", item.GroupId)
+			continue
+		}
+		value, err := proto.Marshal(item)
+		if err != nil {
+			return err
+		}
+		if err := db.Set([]byte(item.GroupId), value); err != nil {
+			return err
+		}
+		dbmgr_log.Infof("group %s upgraded", item.GroupId)
+	}
+	return nil
+}
+
+//migrateProfiles builds the profiles of all groups from the Person posts saved before profiles were indexed,
+//keys of posts are "<nodeprefix>grp_cnt_<groupid>_<timestamp>_<trxid>"
+func migrateProfiles(db QuorumStorage, dryRun bool) error {
+	ctntPrefix := GRP_PREFIX + "_" + CNT_PREFIX + "_"
+	groups := make(map[string]map[string]bool) //nodeprefix -> group ids
+	err := db.Foreach(func(k []byte, v []byte, err error) error {
+		if err != nil {
+			return err
+		}
+		key := string(k)
+		idx := strings.Index(key, ctntPrefix)
+		if idx < 0 {
+			return nil
+		}
+		rest := key[idx+len(ctntPrefix):]
+		end := strings.Index(rest, "_")
+		if end <= 0 {
+			return nil
+		}
+		nodeprefix := key[:idx]
+		if groups[nodeprefix] == nil {
+			groups[nodeprefix] = make(map[string]bool)
+		}
+		groups[nodeprefix][rest[:end]] = true
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	dbMgr := &DbMgr{Db: db}
+	for nodeprefix, groupIds := range groups {
+		//getPrefix appends "_" to the node name
+		var prefix []string
+		if nodeprefix != "" {
+			prefix = append(prefix, strings.TrimSuffix(nodeprefix, "_"))
+		}
+		for groupId := range groupIds {
+			if dryRun {
+				dbmgr_log.Infof("[dry run] profiles of group %s will be built", groupId)
+				continue
+			}
+			if err := dbMgr.RebuildGroupProfiles(groupId, prefix...); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
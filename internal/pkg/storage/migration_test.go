@@ -0,0 +1,158 @@
+//go:build !js
+// +build !js
+
+package storage
+
+import (
+	"fmt"
+	"testing"
+
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+)
+
+//registerTestMigrations registers migrations v1 to v3 of a test db, ran records the versions and dry run flags
+func registerTestMigrations(t *testing.T, dbname string, ran *[]string) {
+	t.Cleanup(func() { delete(migrations, dbname) })
+	for ver := 1; ver <= 3; ver++ {
+		ver := ver
+		RegisterMigration(dbname, &Migration{Version: ver, Desc: fmt.Sprintf("test v%d", ver), Migrate: func(db QuorumStorage, dryRun bool) error {
+			*ran = append(*ran, fmt.Sprintf("v%d:%v", ver, dryRun))
+			if dryRun {
+				return nil
+			}
+			return db.Set([]byte(fmt.Sprintf("test_v%d", ver)), []byte("done"))
+		}})
+	}
+}
+
+func getTestDataVer(t *testing.T, db QuorumStorage) int {
+	ver, err := GetDataVer(db)
+	if err != nil {
+		t.Fatalf("get data ver err: %s", err)
+	}
+	return ver
+}
+
+func TestMigrateOrder(t *testing.T) {
+	dbMgr := newTestDbMgr(t)
+	ran := []string{}
+	registerTestMigrations(t, "_test", &ran)
+	if err := SetDataVer(dbMgr.Db, 1); err != nil {
+		t.Fatalf("set data ver err: %s", err)
+	}
+
+	pending, err := Migrate(t.TempDir(), "_test", dbMgr.Db, &MigrationOptions{})
+	if err != nil {
+		t.Fatalf("migrate err: %s", err)
+	}
+	if len(pending) != 2 || fmt.Sprint(ran) != "[v2:false v3:false]" {
+		t.Errorf("got %d migrations ran %v, want v2 and v3 in order", len(pending), ran)
+	}
+	if ver := getTestDataVer(t, dbMgr.Db); ver != 3 {
+		t.Errorf("got data ver %d after migration, want 3", ver)
+	}
+	if exist, _ := dbMgr.Db.IsExist([]byte("test_v1")); exist {
+		t.Errorf("migration older than the data ver is ran")
+	}
+
+	//nothing to run on the migrated data
+	ran = ran[:0]
+	if pending, err := Migrate(t.TempDir(), "_test", dbMgr.Db, &MigrationOptions{}); err != nil || len(pending) != 0 || len(ran) != 0 {
+		t.Errorf("got %d pending migrations, ran %v (%v), want none", len(pending), ran, err)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("migration older than the latest is registered")
+		}
+	}()
+	RegisterMigration("_test", &Migration{Version: 2, Desc: "test older"})
+}
+
+func TestMigrateDryRun(t *testing.T) {
+	dbMgr := newTestDbMgr(t)
+	ran := []string{}
+	registerTestMigrations(t, "_test", &ran)
+
+	pending, err := Migrate(t.TempDir(), "_test", dbMgr.Db, &MigrationOptions{DryRun: true, Backup: true})
+	if err != nil {
+		t.Fatalf("dry run err: %s", err)
+	}
+	if len(pending) != 3 || fmt.Sprint(ran) != "[v1:true v2:true v3:true]" {
+		t.Errorf("got %d pending migrations ran %v, want v1 to v3 in dry run", len(pending), ran)
+	}
+	if ver := getTestDataVer(t, dbMgr.Db); ver != 0 {
+		t.Errorf("data ver is set to %d by dry run", ver)
+	}
+	if exist, _ := dbMgr.Db.IsExist([]byte("test_v1")); exist {
+		t.Errorf("data is written by dry run")
+	}
+}
+
+func TestMigrateDataVerTooNew(t *testing.T) {
+	dbMgr := newTestDbMgr(t)
+	ran := []string{}
+	registerTestMigrations(t, "_test", &ran)
+	if err := SetDataVer(dbMgr.Db, 4); err != nil {
+		t.Fatalf("set data ver err: %s", err)
+	}
+
+	if _, err := Migrate(t.TempDir(), "_test", dbMgr.Db, &MigrationOptions{}); err != ErrDataVerTooNew {
+		t.Errorf("got err %v for data written by a newer binary, want ErrDataVerTooNew", err)
+	}
+	if len(ran) != 0 {
+		t.Errorf("migrations %v are ran on newer data", ran)
+	}
+	if ver := getTestDataVer(t, dbMgr.Db); ver != 4 {
+		t.Errorf("data ver is changed to %d", ver)
+	}
+}
+
+func addTestProfilePost(t *testing.T, dbMgr *DbMgr, trxId string, sender string, timestamp int64, person *quorumpb.Person, prefix ...string) {
+	data, err := quorumpb.ContentToBytes(person)
+	if err != nil {
+		t.Fatalf("marshal person err: %s", err)
+	}
+	trx := &quorumpb.Trx{TrxId: trxId, GroupId: "group", SenderPubkey: sender, TimeStamp: timestamp, Data: data}
+	if err := dbMgr.AddPost(trx, prefix...); err != nil {
+		t.Fatalf("add post err: %s", err)
+	}
+}
+
+func TestMigrateProfiles(t *testing.T) {
+	dbMgr := newTestDbMgr(t)
+	addTestProfilePost(t, dbMgr, "p1", "alice", 10, &quorumpb.Person{Name: "alice"}, "node")
+	addTestProfilePost(t, dbMgr, "p2", "alice", 20, &quorumpb.Person{Image: &quorumpb.Image{MediaType: "image/png"}}, "node")
+	addTestProfilePost(t, dbMgr, "p3", "bob", 10, &quorumpb.Person{Name: "bob"})
+	deleted := &quorumpb.PostItem{TrxId: "p4", PublisherPubkey: "bob", TimeStamp: 20, Deleted: true}
+	if err := dbMgr.UpdPost("group", deleted); err != nil {
+		t.Fatalf("update post err: %s", err)
+	}
+
+	if err := migrateProfiles(dbMgr.Db, true); err != nil {
+		t.Fatalf("dry run err: %s", err)
+	}
+	if item, _ := dbMgr.GetProfile("group", "alice", "node"); item != nil {
+		t.Errorf("profile is saved by dry run")
+	}
+
+	if err := migrateProfiles(dbMgr.Db, false); err != nil {
+		t.Fatalf("migrate profiles err: %s", err)
+	}
+	//ran again on the migrated data
+	if err := migrateProfiles(dbMgr.Db, false); err != nil {
+		t.Fatalf("migrate profiles again err: %s", err)
+	}
+
+	alice, err := dbMgr.GetProfile("group", "alice", "node")
+	if err != nil || alice == nil || alice.Person.Name != "alice" || alice.Person.Image == nil || alice.TrxId != "p2" {
+		t.Errorf("got profile %v of alice, want the merged profile of p1 and p2", alice)
+	}
+	if history, _ := dbMgr.GetProfileHistory("group", "alice", "node"); len(history) != 2 {
+		t.Errorf("got %d profiles in history of alice, want 2", len(history))
+	}
+	bob, err := dbMgr.GetProfile("group", "bob")
+	if err != nil || bob == nil || bob.Person.Name != "bob" || bob.TrxId != "p3" {
+		t.Errorf("got profile %v of bob, want p3", bob)
+	}
+}
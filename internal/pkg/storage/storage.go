@@ -14,6 +14,24 @@ type QuorumStorage interface {
 	// For appdb, atomic batch write
 	BatchWrite(keys [][]byte, values [][]byte) error
 	GetSequence([]byte, uint64) (Sequence, error)
+
+	// Read-write transaction, for the writes of a block applied to chain
+	NewTxn() (Txn, error)
+}
+
+//Txn is a read-write transaction, reads of it see the writes of itself, and the writes are applied
+//atomically by Commit. A Txn should not be used by more than one goroutine
+type Txn interface {
+	Set(key []byte, val []byte) error
+	Delete(key []byte) error
+	Get(key []byte) ([]byte, error)
+	PrefixForeach(prefix []byte, fn func([]byte, []byte, error) error) error
+	PrefixForeachKey(prefix []byte, valid []byte, reverse bool, fn func([]byte, error) error) error
+	Foreach(fn func([]byte, []byte, error) error) error
+	IsExist([]byte) (bool, error)
+
+	Commit() error
+	Discard()
 }
 
 type Sequence interface {
@@ -10,6 +10,8 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
 	"sync/atomic"
 	"syscall/js"
 
@@ -297,6 +299,179 @@ func (s *QSIndexDB) GetSequence(seqK []byte, _ uint64) (Sequence, error) {
 	return NewIndexDBSequence(seqK), nil
 }
 
+func (s *QSIndexDB) NewTxn() (Txn, error) {
+	return &QSIndexDBTxn{s: s, writes: make(map[string]*txnWrite)}, nil
+}
+
+type txnWrite struct {
+	value   []byte
+	deleted bool
+}
+
+//QSIndexDBTxn buffers the writes and applies them in one IndexedDB transaction by Commit. An IndexedDB
+//transaction commits itself once it has no pending request, so it can not be kept open while trxs are applied
+type QSIndexDBTxn struct {
+	s      *QSIndexDB
+	writes map[string]*txnWrite
+}
+
+func (t *QSIndexDBTxn) Set(key []byte, val []byte) error {
+	t.writes[string(key)] = &txnWrite{value: append([]byte{}, val...)}
+	return nil
+}
+
+func (t *QSIndexDBTxn) Delete(key []byte) error {
+	t.writes[string(key)] = &txnWrite{deleted: true}
+	return nil
+}
+
+func (t *QSIndexDBTxn) Get(key []byte) ([]byte, error) {
+	if w, ok := t.writes[string(key)]; ok {
+		if w.deleted {
+			return nil, errors.New("KeyNotFound")
+		}
+		return w.value, nil
+	}
+	return t.s.Get(key)
+}
+
+func (t *QSIndexDBTxn) IsExist(key []byte) (bool, error) {
+	if w, ok := t.writes[string(key)]; ok {
+		return !w.deleted, nil
+	}
+	return t.s.IsExist(key)
+}
+
+func (t *QSIndexDBTxn) PrefixForeach(prefix []byte, fn func([]byte, []byte, error) error) error {
+	match := func(k string) bool { return strings.HasPrefix(k, string(prefix)) }
+	foreach := func(f func([]byte, []byte) error) error {
+		return t.s.PrefixForeach(prefix, func(k []byte, v []byte, err error) error {
+			if err != nil {
+				return err
+			}
+			return f(k, v)
+		})
+	}
+	return t.foreachMerged(match, false, foreach, func(k []byte, v []byte) error {
+		return fn(k, v, nil)
+	})
+}
+
+func (t *QSIndexDBTxn) PrefixForeachKey(prefix []byte, valid []byte, reverse bool, fn func([]byte, error) error) error {
+	match := func(k string) bool {
+		if reverse {
+			return k <= string(prefix) && strings.HasPrefix(k, string(valid))
+		}
+		return k >= string(prefix) && strings.HasPrefix(k, string(valid))
+	}
+	foreach := func(f func([]byte, []byte) error) error {
+		return t.s.PrefixForeachKey(prefix, valid, reverse, func(k []byte, err error) error {
+			if err != nil {
+				return err
+			}
+			return f(k, nil)
+		})
+	}
+	return t.foreachMerged(match, reverse, foreach, func(k []byte, _ []byte) error {
+		return fn(k, nil)
+	})
+}
+
+func (t *QSIndexDBTxn) Foreach(fn func([]byte, []byte, error) error) error {
+	match := func(k string) bool { return true }
+	foreach := func(f func([]byte, []byte) error) error {
+		return t.s.Foreach(func(k []byte, v []byte, err error) error {
+			if err != nil {
+				return err
+			}
+			return f(k, v)
+		})
+	}
+	return t.foreachMerged(match, false, foreach, func(k []byte, v []byte) error {
+		return fn(k, v, nil)
+	})
+}
+
+//foreachMerged iterates the keys of the store in order, the buffered writes matched are merged into them
+func (t *QSIndexDBTxn) foreachMerged(match func(string) bool, reverse bool, foreach func(func([]byte, []byte) error) error, fn func([]byte, []byte) error) error {
+	keys := []string{}
+	for k := range t.writes {
+		if match(k) {
+			keys = append(keys, k)
+		}
+	}
+	before := func(a string, b string) bool {
+		if reverse {
+			return a > b
+		}
+		return a < b
+	}
+	sort.Slice(keys, func(i, j int) bool { return before(keys[i], keys[j]) })
+
+	emitWrite := func(k string) error {
+		if w := t.writes[k]; !w.deleted {
+			return fn([]byte(k), w.value)
+		}
+		return nil
+	}
+
+	err := foreach(func(k []byte, v []byte) error {
+		for len(keys) > 0 && before(keys[0], string(k)) {
+			if err := emitWrite(keys[0]); err != nil {
+				return err
+			}
+			keys = keys[1:]
+		}
+		if len(keys) > 0 && keys[0] == string(k) {
+			keys = keys[1:]
+			return emitWrite(string(k))
+		}
+		return fn(k, v)
+	})
+	if err != nil {
+		return err
+	}
+	for _, k := range keys {
+		if err := emitWrite(k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *QSIndexDBTxn) Commit() error {
+	if len(t.writes) == 0 {
+		return nil
+	}
+	txn, err := t.s.db.Transaction(idb.TransactionReadWrite, t.s.name)
+	if err != nil {
+		return err
+	}
+	store, err := txn.ObjectStore(t.s.name)
+	if err != nil {
+		return err
+	}
+	//requests are issued without waiting for each other, so the transaction is not committed in the middle
+	for key, w := range t.writes {
+		k := BytesToArrayBuffer([]byte(key))
+		if w.deleted {
+			_, err = store.Delete(k)
+		} else {
+			_, err = store.PutKey(k, BytesToArrayBuffer(w.value))
+		}
+		if err != nil {
+			txn.Abort()
+			return err
+		}
+	}
+	t.writes = make(map[string]*txnWrite)
+	return txn.Await(t.s.ctx)
+}
+
+func (t *QSIndexDBTxn) Discard() {
+	t.writes = make(map[string]*txnWrite)
+}
+
 func ArrayBufferToBytes(buffer js.Value) []byte {
 	view := js.Global().Get("Uint8Array").New(buffer)
 	dataLen := view.Length()
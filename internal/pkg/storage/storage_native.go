@@ -5,6 +5,7 @@ package storage
 
 import (
 	"errors"
+	"os"
 
 	badger "github.com/dgraph-io/badger/v3"
 	"github.com/dgraph-io/badger/v3/options"
@@ -15,7 +16,7 @@ type QSBadger struct {
 }
 
 var DefaultLogFileSize int64 = 16 << 20
-var DefaultMemTableSize int64 = 8 << 20
+var DefaultMemTableSize int64 = 32 << 20 //max batch size is 15% of it, a txn holds all the writes of a full block
 var DefaultMaxEntries uint32 = 50000
 var DefaultBlockCacheSize int64 = 32 << 20
 var DefaultCompressionType = options.Snappy
@@ -53,32 +54,17 @@ func (s *QSBadger) Delete(key []byte) error {
 func (s *QSBadger) Get(key []byte) ([]byte, error) {
 	var val []byte
 	err := s.db.View(func(txn *badger.Txn) error {
-		item, err := txn.Get([]byte(key))
-		if err != nil {
-			return err
-		}
-
-		val, err = item.ValueCopy(nil)
-		if err != nil {
-			return err
-		}
-		return nil
+		var err error
+		val, err = txnGet(txn, key)
+		return err
 	})
 	return val, err
 }
 
 func (s *QSBadger) IsExist(key []byte) (bool, error) {
 	var ret bool
-
 	err := s.db.View(func(txn *badger.Txn) error {
-		opts := badger.DefaultIteratorOptions
-		opts.PrefetchSize = 1
-		opts.PrefetchValues = false
-		it := txn.NewIterator(opts)
-		defer it.Close()
-
-		it.Seek(key)
-		ret = it.ValidForPrefix(key)
+		ret = txnIsExist(txn, key)
 		return nil
 	})
 
@@ -89,70 +75,21 @@ func (s *QSBadger) IsExist(key []byte) (bool, error) {
 }
 
 func (s *QSBadger) PrefixForeach(prefix []byte, fn func([]byte, []byte, error) error) error {
-	err := s.db.View(func(txn *badger.Txn) error {
-		opts := badger.DefaultIteratorOptions
-		opts.PrefetchSize = DefaultPrefetchSize
-		it := txn.NewIterator(opts)
-		defer it.Close()
-		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
-			item := it.Item()
-			key := item.KeyCopy(nil)
-			val, err := item.ValueCopy(nil)
-			if err != nil {
-				return err
-			}
-			ferr := fn(key, val, nil)
-			if ferr != nil {
-				return ferr
-			}
-		}
-		return nil
+	return s.db.View(func(txn *badger.Txn) error {
+		return txnPrefixForeach(txn, prefix, fn)
 	})
-	return err
 }
 
 func (s *QSBadger) PrefixForeachKey(prefix []byte, valid []byte, reverse bool, fn func([]byte, error) error) error {
-	err := s.db.View(func(txn *badger.Txn) error {
-		opts := badger.DefaultIteratorOptions
-		opts.PrefetchSize = 20
-		opts.PrefetchValues = false
-		opts.Reverse = reverse
-		it := txn.NewIterator(opts)
-		defer it.Close()
-		for it.Seek(prefix); it.ValidForPrefix(valid); it.Next() {
-			item := it.Item()
-			key := item.KeyCopy(nil)
-			ferr := fn(key, nil)
-			if ferr != nil {
-				return ferr
-			}
-		}
-		return nil
+	return s.db.View(func(txn *badger.Txn) error {
+		return txnPrefixForeachKey(txn, prefix, valid, reverse, fn)
 	})
-	return err
 }
 
 func (s *QSBadger) Foreach(fn func([]byte, []byte, error) error) error {
-	err := s.db.View(func(txn *badger.Txn) error {
-		opts := badger.DefaultIteratorOptions
-		opts.PrefetchSize = DefaultPrefetchSize
-		it := txn.NewIterator(opts)
-		defer it.Close()
-		for it.Rewind(); it.Valid(); it.Next() {
-			item := it.Item()
-			key := item.KeyCopy(nil)
-			val, err := item.ValueCopy(nil)
-			if err != nil {
-				return err
-			}
-			ferr := fn(key, val, nil)
-			if ferr != nil {
-				return ferr
-			}
-		}
-		return nil
+	return s.db.View(func(txn *badger.Txn) error {
+		return txnForeach(txn, fn)
 	})
-	return err
 }
 
 func (s *QSBadger) BatchWrite(keys [][]byte, values [][]byte) error {
@@ -175,6 +112,143 @@ func (s *QSBadger) BatchWrite(keys [][]byte, values [][]byte) error {
 
 }
 
+//Backup writes a full backup of the db to the file, it can be restored by `badger restore`
+func (s *QSBadger) Backup(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if _, err := s.db.Backup(f, 0); err != nil {
+		return err
+	}
+	return f.Sync()
+}
+
 func (s *QSBadger) GetSequence(key []byte, bandwidth uint64) (Sequence, error) {
 	return s.db.GetSequence(key, bandwidth)
 }
+
+func (s *QSBadger) NewTxn() (Txn, error) {
+	return &QSBadgerTxn{txn: s.db.NewTransaction(true)}, nil
+}
+
+//QSBadgerTxn is a badger read-write transaction, the iterators of it see the writes made before they are created.
+//Writes over the max batch size fail with badger.ErrTxnTooBig, the transaction is never split so Discard drops all
+//the writes made in it
+type QSBadgerTxn struct {
+	txn *badger.Txn
+}
+
+func (t *QSBadgerTxn) Set(key []byte, val []byte) error {
+	return t.txn.SetEntry(badger.NewEntry(key, val))
+}
+
+func (t *QSBadgerTxn) Delete(key []byte) error {
+	return t.txn.Delete(key)
+}
+
+func (t *QSBadgerTxn) Get(key []byte) ([]byte, error) {
+	return txnGet(t.txn, key)
+}
+
+func (t *QSBadgerTxn) IsExist(key []byte) (bool, error) {
+	return txnIsExist(t.txn, key), nil
+}
+
+func (t *QSBadgerTxn) PrefixForeach(prefix []byte, fn func([]byte, []byte, error) error) error {
+	return txnPrefixForeach(t.txn, prefix, fn)
+}
+
+func (t *QSBadgerTxn) PrefixForeachKey(prefix []byte, valid []byte, reverse bool, fn func([]byte, error) error) error {
+	return txnPrefixForeachKey(t.txn, prefix, valid, reverse, fn)
+}
+
+func (t *QSBadgerTxn) Foreach(fn func([]byte, []byte, error) error) error {
+	return txnForeach(t.txn, fn)
+}
+
+func (t *QSBadgerTxn) Commit() error {
+	return t.txn.Commit()
+}
+
+func (t *QSBadgerTxn) Discard() {
+	t.txn.Discard()
+}
+
+func txnGet(txn *badger.Txn, key []byte) ([]byte, error) {
+	item, err := txn.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	return item.ValueCopy(nil)
+}
+
+func txnIsExist(txn *badger.Txn, key []byte) bool {
+	opts := badger.DefaultIteratorOptions
+	opts.PrefetchSize = 1
+	opts.PrefetchValues = false
+	it := txn.NewIterator(opts)
+	defer it.Close()
+
+	it.Seek(key)
+	return it.ValidForPrefix(key)
+}
+
+func txnPrefixForeach(txn *badger.Txn, prefix []byte, fn func([]byte, []byte, error) error) error {
+	opts := badger.DefaultIteratorOptions
+	opts.PrefetchSize = DefaultPrefetchSize
+	it := txn.NewIterator(opts)
+	defer it.Close()
+	for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+		item := it.Item()
+		key := item.KeyCopy(nil)
+		val, err := item.ValueCopy(nil)
+		if err != nil {
+			return err
+		}
+		ferr := fn(key, val, nil)
+		if ferr != nil {
+			return ferr
+		}
+	}
+	return nil
+}
+
+func txnPrefixForeachKey(txn *badger.Txn, prefix []byte, valid []byte, reverse bool, fn func([]byte, error) error) error {
+	opts := badger.DefaultIteratorOptions
+	opts.PrefetchSize = 20
+	opts.PrefetchValues = false
+	opts.Reverse = reverse
+	it := txn.NewIterator(opts)
+	defer it.Close()
+	for it.Seek(prefix); it.ValidForPrefix(valid); it.Next() {
+		item := it.Item()
+		key := item.KeyCopy(nil)
+		ferr := fn(key, nil)
+		if ferr != nil {
+			return ferr
+		}
+	}
+	return nil
+}
+
+func txnForeach(txn *badger.Txn, fn func([]byte, []byte, error) error) error {
+	opts := badger.DefaultIteratorOptions
+	opts.PrefetchSize = DefaultPrefetchSize
+	it := txn.NewIterator(opts)
+	defer it.Close()
+	for it.Rewind(); it.Valid(); it.Next() {
+		item := it.Item()
+		key := item.KeyCopy(nil)
+		val, err := item.ValueCopy(nil)
+		if err != nil {
+			return err
+		}
+		ferr := fn(key, val, nil)
+		if ferr != nil {
+			return ferr
+		}
+	}
+	return nil
+}
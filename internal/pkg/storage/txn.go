@@ -0,0 +1,90 @@
+package storage
+
+import (
+	"errors"
+)
+
+//txnStorage runs the reads and writes of Db in a transaction, so the methods of DbMgr can be used in it
+type txnStorage struct {
+	Txn
+	db QuorumStorage
+}
+
+func (s *txnStorage) Init(path string) error {
+	return errors.New("TXN_NOT_SUPPORTED")
+}
+
+func (s *txnStorage) Close() error {
+	return errors.New("TXN_NOT_SUPPORTED")
+}
+
+func (s *txnStorage) BatchWrite(keys [][]byte, values [][]byte) error {
+	if len(keys) != len(values) {
+		return errors.New("keys' and values' length should be equal")
+	}
+	for i, k := range keys {
+		if err := s.Set(k, values[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//sequences are not rolled back with the transaction
+func (s *txnStorage) GetSequence(key []byte, bandwidth uint64) (Sequence, error) {
+	return s.db.GetSequence(key, bandwidth)
+}
+
+func (s *txnStorage) NewTxn() (Txn, error) {
+	return nil, errors.New("TXN_ALREADY_BEGUN")
+}
+
+//Begin returns a DbMgr which reads and writes Db in a transaction, the writes are applied atomically by
+//Commit or dropped by Discard. GroupInfoDb and Auth are not in the transaction
+func (dbMgr *DbMgr) Begin() (*DbMgr, error) {
+	txn, err := dbMgr.Db.NewTxn()
+	if err != nil {
+		return nil, err
+	}
+	return &DbMgr{
+		GroupInfoDb: dbMgr.GroupInfoDb,
+		Db:          &txnStorage{Txn: txn, db: dbMgr.Db},
+		Auth:        dbMgr.Auth,
+		DataPath:    dbMgr.DataPath,
+		txn:         txn,
+	}, nil
+}
+
+//OnCommit adds a function called after the transaction is committed, such as publishing the events of
+//the writes. It is called at once if dbMgr is not in a transaction
+func (dbMgr *DbMgr) OnCommit(fn func()) {
+	if dbMgr.txn == nil {
+		fn()
+		return
+	}
+	dbMgr.onCommit = append(dbMgr.onCommit, fn)
+}
+
+func (dbMgr *DbMgr) Commit() error {
+	if dbMgr.txn == nil {
+		return errors.New("TXN_NOT_BEGUN")
+	}
+	if err := dbMgr.txn.Commit(); err != nil {
+		return err
+	}
+	onCommit := dbMgr.onCommit
+	dbMgr.onCommit = nil
+	for _, fn := range onCommit {
+		fn()
+	}
+	return nil
+}
+
+//Discard drops the writes not committed, it is safe to call after Commit
+func (dbMgr *DbMgr) Discard() {
+	if dbMgr.txn == nil {
+		return
+	}
+	dbMgr.txn.Discard()
+	dbMgr.onCommit = nil
+}
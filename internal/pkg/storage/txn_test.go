@@ -0,0 +1,159 @@
+//go:build !js
+// +build !js
+
+package storage
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	badger "github.com/dgraph-io/badger/v3"
+)
+
+func newTestDbMgr(t *testing.T) *DbMgr {
+	db := &QSBadger{}
+	if err := db.Init(t.TempDir()); err != nil {
+		t.Fatalf("init db err: %s", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return &DbMgr{Db: db, GroupInfoDb: db}
+}
+
+func TestTxnCommit(t *testing.T) {
+	dbMgr := newTestDbMgr(t)
+	txnMgr, err := dbMgr.Begin()
+	if err != nil {
+		t.Fatalf("begin err: %s", err)
+	}
+	defer txnMgr.Discard()
+
+	committed := false
+	txnMgr.OnCommit(func() { committed = true })
+	if err := txnMgr.Db.Set([]byte("k1"), []byte("v1")); err != nil {
+		t.Fatalf("set err: %s", err)
+	}
+
+	//the txn reads its own writes, others do not see them before commit
+	if v, err := txnMgr.Db.Get([]byte("k1")); err != nil || string(v) != "v1" {
+		t.Errorf("txn get k1 got %q, %v", v, err)
+	}
+	if exist, _ := dbMgr.Db.IsExist([]byte("k1")); exist {
+		t.Errorf("k1 is visible before commit")
+	}
+	if committed {
+		t.Errorf("OnCommit called before commit")
+	}
+
+	if err := txnMgr.Commit(); err != nil {
+		t.Fatalf("commit err: %s", err)
+	}
+	if !committed {
+		t.Errorf("OnCommit not called after commit")
+	}
+	if v, err := dbMgr.Db.Get([]byte("k1")); err != nil || string(v) != "v1" {
+		t.Errorf("get k1 after commit got %q, %v", v, err)
+	}
+}
+
+func TestTxnDiscard(t *testing.T) {
+	dbMgr := newTestDbMgr(t)
+	if err := dbMgr.Db.Set([]byte("k1"), []byte("v1")); err != nil {
+		t.Fatalf("set err: %s", err)
+	}
+
+	txnMgr, err := dbMgr.Begin()
+	if err != nil {
+		t.Fatalf("begin err: %s", err)
+	}
+	committed := false
+	txnMgr.OnCommit(func() { committed = true })
+	txnMgr.Db.Set([]byte("k1"), []byte("v2"))
+	txnMgr.Db.Set([]byte("k2"), []byte("v2"))
+	txnMgr.Db.Delete([]byte("k1"))
+	txnMgr.Discard()
+
+	if committed {
+		t.Errorf("OnCommit called after discard")
+	}
+	if v, err := dbMgr.Db.Get([]byte("k1")); err != nil || string(v) != "v1" {
+		t.Errorf("k1 is not rolled back, got %q, %v", v, err)
+	}
+	if exist, _ := dbMgr.Db.IsExist([]byte("k2")); exist {
+		t.Errorf("k2 is not rolled back")
+	}
+
+	//a block fails in the middle of applying keeps nothing
+	txnMgr, err = dbMgr.Begin()
+	if err != nil {
+		t.Fatalf("begin err: %s", err)
+	}
+	apply := func() error {
+		if err := txnMgr.Db.Set([]byte("k3"), []byte("v3")); err != nil {
+			return err
+		}
+		return fmt.Errorf("apply failed")
+	}
+	if err := apply(); err == nil {
+		t.Fatalf("apply should fail")
+	}
+	txnMgr.Discard()
+	if exist, _ := dbMgr.Db.IsExist([]byte("k3")); exist {
+		t.Errorf("k3 is not rolled back")
+	}
+}
+
+func TestOnCommitWithoutTxn(t *testing.T) {
+	dbMgr := newTestDbMgr(t)
+	called := false
+	dbMgr.OnCommit(func() { called = true })
+	if !called {
+		t.Errorf("OnCommit without txn is not called at once")
+	}
+	if err := dbMgr.Commit(); err == nil {
+		t.Errorf("commit without txn should fail")
+	}
+}
+
+func TestTxnTooBig(t *testing.T) {
+	dbMgr := newTestDbMgr(t)
+
+	//the writes of a full block, the block, its parent, the trxs and the posts of them
+	txnMgr, err := dbMgr.Begin()
+	if err != nil {
+		t.Fatalf("begin err: %s", err)
+	}
+	value := bytes.Repeat([]byte("x"), 900*1024)
+	for i := 0; i < 4; i++ {
+		if err := txnMgr.Db.Set([]byte(fmt.Sprintf("block_%d", i)), value); err != nil {
+			t.Fatalf("set %d err: %s", i, err)
+		}
+	}
+	if err := txnMgr.Commit(); err != nil {
+		t.Fatalf("commit err: %s", err)
+	}
+
+	//writes over the max batch size fail, and none of them is applied
+	txnMgr, err = dbMgr.Begin()
+	if err != nil {
+		t.Fatalf("begin err: %s", err)
+	}
+	value = bytes.Repeat([]byte("x"), 200*1024)
+	var setErr error
+	for i := 0; i < 100 && setErr == nil; i++ {
+		setErr = txnMgr.Db.Set([]byte(fmt.Sprintf("big_%02d", i)), value)
+	}
+	if setErr != badger.ErrTxnTooBig {
+		t.Fatalf("got err %v, want ErrTxnTooBig", setErr)
+	}
+	txnMgr.Discard()
+
+	n := 0
+	dbMgr.Db.PrefixForeach([]byte("big_"), func(k []byte, v []byte, err error) error {
+		n++
+		return err
+	})
+	if n != 0 {
+		t.Errorf("got %d keys after discard, want 0", n)
+	}
+}
@@ -0,0 +1,372 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	guuid "github.com/google/uuid"
+	logging "github.com/ipfs/go-log/v2"
+	"github.com/rumsystem/quorum/internal/pkg/eventbus"
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+	"github.com/rumsystem/quorum/internal/pkg/storage"
+)
+
+var webhook_log = logging.Logger("webhook")
+
+const WEBHOOK_QUEUE_SIZE int = 1024             //pending deliveries of a webhook, new deliveries go to dead letter when full
+const WEBHOOK_MAX_ATTEMPTS int = 8              //attempts before a delivery goes to dead letter
+const WEBHOOK_INITIAL_BACKOFF time.Duration = 1 //1s, doubled after each failed attempt
+const WEBHOOK_MAX_BACKOFF time.Duration = 300   //300s
+const WEBHOOK_TIMEOUT time.Duration = 10        //10s
+
+const SIGNATURE_HEADER string = "X-Quorum-Signature"
+const WEBHOOK_ID_HEADER string = "X-Quorum-Webhook-Id"
+const DELIVERY_ID_HEADER string = "X-Quorum-Delivery-Id"
+
+//trx types a webhook can subscribe to
+var WebhookTrxTypes = []quorumpb.TrxType{
+	quorumpb.TrxType_POST,
+	quorumpb.TrxType_ANNOUNCE,
+	quorumpb.TrxType_PRODUCER,
+	quorumpb.TrxType_AUTH,
+	quorumpb.TrxType_SCHEMA,
+}
+
+//Payload is the json body posted to the webhook url
+type Payload struct {
+	WebhookId  string `json:"webhook_id"`
+	DeliveryId string `json:"delivery_id"`
+	GroupId    string `json:"group_id"`
+	TrxId      string `json:"trx_id"`
+	TrxType    string `json:"trx_type"`
+	Sender     string `json:"sender"`
+	TimeStamp  int64  `json:"timestamp"`
+}
+
+type delivery struct {
+	payload *Payload
+	body    []byte
+}
+
+//worker delivers payloads of a webhook one by one
+type worker struct {
+	item   *quorumpb.WebhookItem
+	queue  chan *delivery
+	cancel context.CancelFunc
+	mu     sync.RWMutex
+}
+
+type WebhookMgr struct {
+	dbMgr    *storage.DbMgr
+	nodename string
+	client   *http.Client
+	ctx      context.Context
+	workers  map[string]*worker
+	mu       sync.Mutex
+}
+
+var webhookMgr *WebhookMgr
+
+func GetWebhookMgr() *WebhookMgr {
+	return webhookMgr
+}
+
+func InitWebhookMgr(ctx context.Context, dbMgr *storage.DbMgr, nodename string) *WebhookMgr {
+	webhook_log.Debug("InitWebhookMgr called")
+	webhookMgr = &WebhookMgr{dbMgr: dbMgr, nodename: nodename, ctx: ctx}
+	webhookMgr.client = &http.Client{Timeout: WEBHOOK_TIMEOUT * time.Second}
+	webhookMgr.workers = make(map[string]*worker)
+	return webhookMgr
+}
+
+//Start loads the saved webhooks and delivers the trxs applied from now on
+func (mgr *WebhookMgr) Start() error {
+	items, err := mgr.dbMgr.GetWebhooks(mgr.nodename)
+	if err != nil {
+		return err
+	}
+
+	mgr.mu.Lock()
+	for _, item := range items {
+		mgr.startWorker(item)
+	}
+	mgr.mu.Unlock()
+
+	//subscribed before return, trxs applied after Start are delivered
+	sub := eventbus.GetEventBus().Subscribe(webhookFilter, 0)
+	go mgr.dispatch(sub)
+	return nil
+}
+
+//GenerateSecret returns a random secret to sign the payload
+func GenerateSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := io.ReadFull(rand.Reader, secret); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(secret), nil
+}
+
+//Sign returns the hex encoded HMAC-SHA256 of body
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func IsWebhookTrxType(trxType quorumpb.TrxType) bool {
+	for _, t := range WebhookTrxTypes {
+		if t == trxType {
+			return true
+		}
+	}
+	return false
+}
+
+func (mgr *WebhookMgr) GetWebhooks() ([]*quorumpb.WebhookItem, error) {
+	return mgr.dbMgr.GetWebhooks(mgr.nodename)
+}
+
+func (mgr *WebhookMgr) GetWebhook(webhookId string) (*quorumpb.WebhookItem, error) {
+	return mgr.dbMgr.GetWebhook(webhookId, mgr.nodename)
+}
+
+func (mgr *WebhookMgr) GetDeadLetters(webhookId string) ([]*quorumpb.WebhookDeadLetter, error) {
+	return mgr.dbMgr.GetWebhookDeadLetters(webhookId, mgr.nodename)
+}
+
+//AddWebhook saves a new webhook or updates an existed one, pending deliveries of an updated webhook
+//are sent to the new url
+func (mgr *WebhookMgr) AddWebhook(item *quorumpb.WebhookItem) error {
+	if err := mgr.dbMgr.AddWebhook(item, mgr.nodename); err != nil {
+		return err
+	}
+
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+	if w, ok := mgr.workers[item.WebhookId]; ok {
+		w.mu.Lock()
+		w.item = item
+		w.mu.Unlock()
+	} else {
+		mgr.startWorker(item)
+	}
+	return nil
+}
+
+//RmWebhook removes the webhook with its dead letters, pending deliveries are dropped
+func (mgr *WebhookMgr) RmWebhook(webhookId string) error {
+	if err := mgr.dbMgr.RmWebhook(webhookId, mgr.nodename); err != nil {
+		return err
+	}
+
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+	if w, ok := mgr.workers[webhookId]; ok {
+		w.cancel()
+		delete(mgr.workers, webhookId)
+	}
+	return nil
+}
+
+func (mgr *WebhookMgr) startWorker(item *quorumpb.WebhookItem) {
+	ctx, cancel := context.WithCancel(mgr.ctx)
+	w := &worker{item: item, queue: make(chan *delivery, WEBHOOK_QUEUE_SIZE), cancel: cancel}
+	mgr.workers[item.WebhookId] = w
+	go mgr.runWorker(ctx, w)
+}
+
+var webhookFilter = &eventbus.Filter{Types: []eventbus.EventType{eventbus.TRX_APPLIED}}
+
+//dispatch receives TRX_APPLIED from the event bus, and queues the trx to the matched webhooks
+func (mgr *WebhookMgr) dispatch(sub *eventbus.Subscriber) {
+	bus := eventbus.GetEventBus()
+	var lastId uint64
+	for {
+		for {
+			var event *eventbus.Event
+			var ok bool
+			select {
+			case event, ok = <-sub.C:
+			case <-mgr.ctx.Done():
+				bus.Unsubscribe(sub)
+				return
+			}
+			if !ok {
+				//dropped by the event bus, resubscribe from the last event
+				webhook_log.Warningf("dropped by event bus, resubscribe from event <%d>", lastId)
+				break
+			}
+			if event.Type == eventbus.EVENTS_LOST {
+				webhook_log.Warningf("trxs applied after event <%d> are lost", event.Id)
+				mgr.addLostLetters(event)
+				continue
+			}
+			lastId = event.Id
+			mgr.queue(event)
+		}
+		sub = bus.Subscribe(webhookFilter, lastId)
+	}
+}
+
+//addLostLetters saves a dead letter without trx to every webhook when trxs are lost by the event bus, the
+//receiver should fetch the trxs of the group applied after its last delivery
+func (mgr *WebhookMgr) addLostLetters(event *eventbus.Event) {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	for _, w := range mgr.workers {
+		w.mu.RLock()
+		item := w.item
+		w.mu.RUnlock()
+
+		letter := &quorumpb.WebhookDeadLetter{}
+		letter.WebhookId = item.WebhookId
+		letter.DeliveryId = guuid.New().String()
+		letter.GroupId = item.GroupId
+		letter.LastError = fmt.Sprintf("EVENTS_LOST: trxs applied after event <%d> are lost", event.Id)
+		letter.TimeStamp = time.Now().UnixNano()
+		mgr.saveDeadLetter(letter)
+	}
+}
+
+func (mgr *WebhookMgr) queue(event *eventbus.Event) {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	for _, w := range mgr.workers {
+		w.mu.RLock()
+		item := w.item
+		w.mu.RUnlock()
+		if !matchEvent(item, event) {
+			continue
+		}
+
+		payload := &Payload{WebhookId: item.WebhookId, DeliveryId: guuid.New().String(), GroupId: event.GroupId, TrxId: event.TrxId, TrxType: event.TrxType, Sender: event.Sender, TimeStamp: event.TimeStamp}
+		body, err := json.Marshal(payload)
+		if err != nil {
+			webhook_log.Errorf("<%s> marshal payload of trx <%s> failed: %s", event.GroupId, event.TrxId, err.Error())
+			continue
+		}
+
+		d := &delivery{payload: payload, body: body}
+		select {
+		case w.queue <- d:
+		default:
+			mgr.addDeadLetter(d, 0, errors.New("webhook queue is full"))
+		}
+	}
+}
+
+func matchEvent(item *quorumpb.WebhookItem, event *eventbus.Event) bool {
+	if item.GroupId != event.GroupId {
+		return false
+	}
+	for _, t := range item.TrxTypes {
+		if t.String() == event.TrxType {
+			return true
+		}
+	}
+	return false
+}
+
+func (mgr *WebhookMgr) runWorker(ctx context.Context, w *worker) {
+	for {
+		select {
+		case d := <-w.queue:
+			mgr.deliver(ctx, w, d)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+//deliver posts the payload until succeeded, it is retried with exponential backoff and
+//saved as dead letter after WEBHOOK_MAX_ATTEMPTS
+func (mgr *WebhookMgr) deliver(ctx context.Context, w *worker, d *delivery) {
+	backoff := WEBHOOK_INITIAL_BACKOFF * time.Second
+	var err error
+	for attempt := 1; attempt <= WEBHOOK_MAX_ATTEMPTS; attempt++ {
+		w.mu.RLock()
+		item := w.item
+		w.mu.RUnlock()
+
+		if err = mgr.post(ctx, item, d); err == nil {
+			return
+		}
+		webhook_log.Warningf("<%s> deliver trx <%s> to webhook <%s> failed, attempt <%d>: %s", d.payload.GroupId, d.payload.TrxId, item.WebhookId, attempt, err.Error())
+		if attempt == WEBHOOK_MAX_ATTEMPTS {
+			break
+		}
+
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return
+		}
+		backoff *= 2
+		if backoff > WEBHOOK_MAX_BACKOFF*time.Second {
+			backoff = WEBHOOK_MAX_BACKOFF * time.Second
+		}
+	}
+	mgr.addDeadLetter(d, WEBHOOK_MAX_ATTEMPTS, err)
+}
+
+func (mgr *WebhookMgr) post(ctx context.Context, item *quorumpb.WebhookItem, d *delivery) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, item.Url, bytes.NewReader(d.body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WEBHOOK_ID_HEADER, item.WebhookId)
+	req.Header.Set(DELIVERY_ID_HEADER, d.payload.DeliveryId)
+	req.Header.Set(SIGNATURE_HEADER, "sha256="+Sign(item.Secret, d.body))
+
+	resp, err := mgr.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("http status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func (mgr *WebhookMgr) addDeadLetter(d *delivery, attempts int, err error) {
+	webhook_log.Errorf("<%s> deliver trx <%s> to webhook <%s> failed, save to dead letter", d.payload.GroupId, d.payload.TrxId, d.payload.WebhookId)
+
+	letter := &quorumpb.WebhookDeadLetter{}
+	letter.WebhookId = d.payload.WebhookId
+	letter.DeliveryId = d.payload.DeliveryId
+	letter.GroupId = d.payload.GroupId
+	letter.TrxId = d.payload.TrxId
+	letter.TrxType = quorumpb.TrxType(quorumpb.TrxType_value[d.payload.TrxType])
+	letter.Payload = d.body
+	letter.Attempts = int32(attempts)
+	letter.TimeStamp = time.Now().UnixNano()
+	if err != nil {
+		letter.LastError = err.Error()
+	}
+	mgr.saveDeadLetter(letter)
+}
+
+func (mgr *WebhookMgr) saveDeadLetter(letter *quorumpb.WebhookDeadLetter) {
+	if err := mgr.dbMgr.AddWebhookDeadLetter(letter, mgr.nodename); err != nil {
+		webhook_log.Errorf("save dead letter <%s> failed: %s", letter.DeliveryId, err.Error())
+	}
+}
@@ -0,0 +1,106 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rumsystem/quorum/internal/pkg/eventbus"
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+	"github.com/rumsystem/quorum/internal/pkg/storage"
+)
+
+func newTestWebhookMgr(t *testing.T, ctx context.Context) *WebhookMgr {
+	db := storage.QSBadger{}
+	if err := db.Init(t.TempDir() + "/db"); err != nil {
+		t.Fatalf("db init err: %s", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	mgr := InitWebhookMgr(ctx, &storage.DbMgr{Db: &db}, "test")
+	if err := mgr.Start(); err != nil {
+		t.Fatalf("webhook mgr start err: %s", err)
+	}
+	return mgr
+}
+
+func TestDeliverSignedPayload(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	received := make(chan *Payload, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if r.Header.Get(SIGNATURE_HEADER) != "sha256="+Sign("secret", body) {
+			t.Errorf("invalid signature: %s", r.Header.Get(SIGNATURE_HEADER))
+		}
+		payload := &Payload{}
+		if err := json.Unmarshal(body, payload); err != nil {
+			t.Errorf("unmarshal payload err: %s", err)
+		}
+		received <- payload
+	}))
+	defer server.Close()
+
+	mgr := newTestWebhookMgr(t, ctx)
+	item := &quorumpb.WebhookItem{WebhookId: "webhook1", Url: server.URL, GroupId: "group1", TrxTypes: []quorumpb.TrxType{quorumpb.TrxType_POST}, Secret: "secret"}
+	if err := mgr.AddWebhook(item); err != nil {
+		t.Fatalf("add webhook err: %s", err)
+	}
+	//events are subscribed when the mgr is started
+	//not matched group or trx type
+	eventbus.Publish(&eventbus.Event{Type: eventbus.TRX_APPLIED, GroupId: "group2", TrxId: "trx1", TrxType: "POST"})
+	eventbus.Publish(&eventbus.Event{Type: eventbus.TRX_APPLIED, GroupId: "group1", TrxId: "trx2", TrxType: "SCHEMA"})
+	eventbus.Publish(&eventbus.Event{Type: eventbus.TRX_APPLIED, GroupId: "group1", TrxId: "trx3", TrxType: "POST"})
+
+	select {
+	case payload := <-received:
+		if payload.WebhookId != "webhook1" || payload.GroupId != "group1" || payload.TrxId != "trx3" || payload.TrxType != "POST" {
+			t.Errorf("unexpected payload: %+v", payload)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("payload not delivered")
+	}
+
+	select {
+	case payload := <-received:
+		t.Errorf("unexpected payload: %+v", payload)
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	items, err := mgr.GetWebhooks()
+	if err != nil || len(items) != 1 {
+		t.Errorf("GetWebhooks should return 1 webhook, got %d, err: %v", len(items), err)
+	}
+	if err := mgr.RmWebhook(item.WebhookId); err != nil {
+		t.Errorf("remove webhook err: %s", err)
+	}
+	if item, _ := mgr.GetWebhook("webhook1"); item != nil {
+		t.Errorf("webhook should be removed")
+	}
+}
+
+func TestEventsLostDeadLetter(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mgr := newTestWebhookMgr(t, ctx)
+	item := &quorumpb.WebhookItem{WebhookId: "webhook1", Url: "http://127.0.0.1:1", GroupId: "group1", TrxTypes: []quorumpb.TrxType{quorumpb.TrxType_POST}, Secret: "secret"}
+	if err := mgr.AddWebhook(item); err != nil {
+		t.Fatalf("add webhook err: %s", err)
+	}
+
+	mgr.addLostLetters(&eventbus.Event{Id: 5, Type: eventbus.EVENTS_LOST})
+	letters, err := mgr.GetDeadLetters("webhook1")
+	if err != nil {
+		t.Fatalf("get dead letters err: %s", err)
+	}
+	if len(letters) != 1 || letters[0].GroupId != "group1" || letters[0].TrxId != "" || !strings.HasPrefix(letters[0].LastError, "EVENTS_LOST") {
+		t.Errorf("lost events should be saved as a dead letter, got %v", letters)
+	}
+}
@@ -1,10 +1,8 @@
 package api
 
 import (
-	"encoding/hex"
 	"github.com/labstack/echo/v4"
 	chain "github.com/rumsystem/quorum/internal/pkg/chain"
-	localcrypto "github.com/rumsystem/quorum/internal/pkg/crypto"
 	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
 	"google.golang.org/protobuf/proto"
 	"net/http"
@@ -12,11 +10,17 @@ import (
 )
 
 type GroupContentObjectItem struct {
-	TrxId     string
-	Publisher string
-	Content   proto.Message
-	TypeUrl   string
-	TimeStamp int64
+	TrxId      string
+	Publisher  string
+	Content    proto.Message
+	TypeUrl    string
+	TimeStamp  int64
+	Edited     bool                  //Content is the latest version
+	Deleted    bool                  //deleted post has no Content
+	ReplyCount int64                 //count of direct replies
+	Reactions  map[string]int64      //counts of Like, Dislike and emoji reactions
+	MyReaction string                //reaction of this node, empty if not reacted
+	Profile    *quorumpb.ProfileItem `json:",omitempty"` //current profile of the publisher, set if profile=true
 }
 
 type SenderList struct {
@@ -25,12 +29,13 @@ type SenderList struct {
 
 // @Tags Apps
 // @Summary GetGroupContents
-// @Description Get contents in a group
+// @Description Get contents in a group, edited post returns the latest content, deleted post returns no content
 // @Produce json
 // @Param group_id path string  true "Group Id"
 // @Param num query string false "the count of returns results"
 // @Param reverse query boolean false "reverse = true will return results by most recently"
 // @Param starttrx query string false "returns results from this trxid, but exclude it"
+// @Param profile query boolean false "profile = true will embed the current profile of the publisher"
 // @Param data body SenderList true "SenderList"
 // @Success 200 {array} GroupContentObjectItem
 // @Router /app/api/v1/group/{group_id}/content [post]
@@ -46,6 +51,7 @@ func (h *Handler) ContentByPeers(c echo.Context) (err error) {
 	if c.QueryParam("reverse") == "true" {
 		reverse = true
 	}
+	withProfile := c.QueryParam("profile") == "true"
 	senderlist := &SenderList{}
 	if err = c.Bind(&senderlist); err != nil {
 		output[ERROR_INFO] = err.Error()
@@ -63,43 +69,57 @@ func (h *Handler) ContentByPeers(c echo.Context) (err error) {
 		output[ERROR_INFO] = err.Error()
 		return c.JSON(http.StatusBadRequest, output)
 	}
+	profiles := make(map[string]*quorumpb.ProfileItem)
 	ctnobjList := []*GroupContentObjectItem{}
 	for _, trxid := range trxids {
-		trx, err := h.Chaindb.GetTrx(trxid, h.NodeName)
+		ctnobjitem, err := h.getContentItem(groupitem, trxid)
 		if err != nil {
-			c.Logger().Errorf("GetTrx Err: %s", err)
+			c.Logger().Errorf("Get content %s Err: %s", trxid, err)
 			continue
 		}
-
-		//decrypt trx data
-		if trx.Type == quorumpb.TrxType_POST && groupitem.EncryptType == quorumpb.GroupEncryptType_PRIVATE {
-			//for post, private group, encrypted by pgp for all announced group user
-			ks := localcrypto.GetKeystore()
-			decryptData, err := ks.Decrypt(groupitem.UserEncryptPubkey, trx.Data)
-			if err != nil {
-				return err
-			}
-			trx.Data = decryptData
-		} else {
-			//decode trx data
-			ciperKey, err := hex.DecodeString(groupitem.CipherKey)
-			if err != nil {
-				return err
-			}
-
-			decryptData, err := localcrypto.AesDecode(trx.Data, ciperKey)
-			if err != nil {
-				return err
+		if withProfile {
+			profile, ok := profiles[ctnobjitem.Publisher]
+			if !ok {
+				if profile, err = h.Chaindb.GetProfile(groupid, ctnobjitem.Publisher, h.NodeName); err != nil {
+					c.Logger().Errorf("GetProfile %s Err: %s", ctnobjitem.Publisher, err)
+				}
+				profiles[ctnobjitem.Publisher] = profile
 			}
-			trx.Data = decryptData
-		}
-
-		ctnobj, typeurl, errum := quorumpb.BytesToMessage(trx.TrxId, trx.Data)
-		if errum != nil {
-			c.Logger().Errorf("Unmarshal trx.Data %s Err: %s", trx.TrxId, errum)
+			ctnobjitem.Profile = profile
 		}
-		ctnobjitem := &GroupContentObjectItem{TrxId: trx.TrxId, Publisher: trx.SenderPubkey, Content: ctnobj, TimeStamp: trx.TimeStamp, TypeUrl: typeurl}
 		ctnobjList = append(ctnobjList, ctnobjitem)
 	}
 	return c.JSON(http.StatusOK, ctnobjList)
 }
+
+//getContentItem returns the latest content of the post, the count of its replies and the reactions
+func (h *Handler) getContentItem(groupitem *quorumpb.GroupItem, trxid string) (*GroupContentObjectItem, error) {
+	trx, err := h.Chaindb.GetTrx(trxid, h.NodeName)
+	if err != nil {
+		return nil, err
+	}
+
+	replyCount, err := h.Appdb.GetReplyCount(groupitem.GroupId, trxid)
+	if err != nil {
+		return nil, err
+	}
+	reactions, myReaction, err := h.Appdb.GetReactions(groupitem.GroupId, trxid, groupitem.UserSignPubkey)
+	if err != nil {
+		return nil, err
+	}
+
+	//decrypt trx data, by the cipher key of the key epoch the trx carries, or the latest content of edited post
+	data, edited, deleted, err := chain.GetPostContent(groupitem, trx, h.NodeName)
+	if err != nil {
+		return nil, err
+	}
+	if deleted {
+		return &GroupContentObjectItem{TrxId: trx.TrxId, Publisher: trx.SenderPubkey, TimeStamp: trx.TimeStamp, Deleted: true, ReplyCount: replyCount, Reactions: reactions, MyReaction: myReaction}, nil
+	}
+
+	ctnobj, typeurl, errum := quorumpb.BytesToMessage(trx.TrxId, data)
+	if errum != nil {
+		logger.Errorf("Unmarshal trx.Data %s Err: %s", trx.TrxId, errum)
+	}
+	return &GroupContentObjectItem{TrxId: trx.TrxId, Publisher: trx.SenderPubkey, Content: ctnobj, TimeStamp: trx.TimeStamp, TypeUrl: typeurl, Edited: edited, ReplyCount: replyCount, Reactions: reactions, MyReaction: myReaction}, nil
+}
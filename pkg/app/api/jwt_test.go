@@ -0,0 +1,155 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"github.com/rumsystem/quorum/internal/pkg/options"
+)
+
+func newTestServer(t *testing.T) (*echo.Echo, *options.NodeOptions) {
+	nodeOpt, err := options.InitNodeOptions(t.TempDir(), "peer")
+	if err != nil {
+		t.Fatalf("init node options err: %s", err)
+	}
+
+	e := echo.New()
+	e.Use(middleware.JWTWithConfig(CustomJWTConfig(nodeOpt.JWTKey)))
+	ok := func(c echo.Context) error { return c.String(http.StatusOK, "ok") }
+	e.GET("/api/v1/node", ok, ScopeAuth(SCOPE_READ))
+	e.POST("/api/v1/group/:group_id/files", ok, ScopeAuth(SCOPE_POST))
+	e.POST("/api/v1/group", ok, ScopeAuth(SCOPE_ADMIN))
+	return e, nodeOpt
+}
+
+func newTestToken(t *testing.T, nodeOpt *options.NodeOptions, scopes []string, groups []string) (string, *options.APIToken) {
+	apiToken := newAPIToken("app", scopes, groups, TOKEN_DEFAULT_EXPIRES)
+	tokenStr, err := getToken(apiToken, nodeOpt.JWTKey)
+	if err != nil {
+		t.Fatalf("get token err: %s", err)
+	}
+	if err := nodeOpt.AddAPIToken(apiToken); err != nil {
+		t.Fatalf("add token err: %s", err)
+	}
+	return tokenStr, apiToken
+}
+
+func doRequest(e *echo.Echo, method string, path string, remoteAddr string, host string, token string) int {
+	req := httptest.NewRequest(method, path, strings.NewReader("{}"))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.RemoteAddr = remoteAddr
+	if host != "" {
+		req.Host = host
+	}
+	if token != "" {
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec.Code
+}
+
+func TestLoopbackSkipsToken(t *testing.T) {
+	e, _ := newTestServer(t)
+
+	for _, addr := range []string{"127.0.0.1:5000", "[::1]:5000"} {
+		if code := doRequest(e, http.MethodPost, "/api/v1/group", addr, "", ""); code != http.StatusOK {
+			t.Errorf("request from %s without token got %d, want 200", addr, code)
+		}
+	}
+}
+
+func TestRemoteRequiresToken(t *testing.T) {
+	e, _ := newTestServer(t)
+
+	//the Host header is set by the client
+	for _, host := range []string{"", "localhost", "localhost:8002", "127.0.0.1:8002"} {
+		if code := doRequest(e, http.MethodGet, "/api/v1/node", "10.0.0.2:5000", host, ""); code == http.StatusOK {
+			t.Errorf("remote request with host %q without token is not rejected", host)
+		}
+	}
+}
+
+func TestScopeAuthFailsClosed(t *testing.T) {
+	if _, err := options.InitNodeOptions(t.TempDir(), "peer"); err != nil {
+		t.Fatalf("init node options err: %s", err)
+	}
+
+	//ScopeAuth without the JWT middleware before it
+	e := echo.New()
+	e.GET("/api/v1/node", func(c echo.Context) error { return c.String(http.StatusOK, "ok") }, ScopeAuth(SCOPE_READ))
+	if code := doRequest(e, http.MethodGet, "/api/v1/node", "10.0.0.2:5000", "localhost", ""); code != http.StatusUnauthorized {
+		t.Errorf("remote request without user got %d, want 401", code)
+	}
+	if code := doRequest(e, http.MethodGet, "/api/v1/node", "127.0.0.1:5000", "", ""); code != http.StatusOK {
+		t.Errorf("loopback request without user got %d, want 200", code)
+	}
+}
+
+func TestTokenScopes(t *testing.T) {
+	e, nodeOpt := newTestServer(t)
+	remote := "10.0.0.2:5000"
+
+	readToken, _ := newTestToken(t, nodeOpt, []string{SCOPE_READ}, nil)
+	postToken, _ := newTestToken(t, nodeOpt, []string{SCOPE_POST}, []string{"group1"})
+	nodeToken, _ := newTestToken(t, nodeOpt, []string{SCOPE_NODE}, nil)
+
+	cases := []struct {
+		method string
+		path   string
+		token  string
+		code   int
+	}{
+		{http.MethodGet, "/api/v1/node", readToken, http.StatusOK},
+		{http.MethodPost, "/api/v1/group", readToken, http.StatusForbidden},
+		{http.MethodPost, "/api/v1/group/group1/files", postToken, http.StatusOK},
+		{http.MethodPost, "/api/v1/group/group2/files", postToken, http.StatusForbidden},
+		{http.MethodGet, "/api/v1/node", postToken, http.StatusForbidden},
+		{http.MethodPost, "/api/v1/group", nodeToken, http.StatusOK},
+		{http.MethodPost, "/api/v1/group/group2/files", nodeToken, http.StatusOK},
+	}
+	for _, tc := range cases {
+		if code := doRequest(e, tc.method, tc.path, remote, "", tc.token); code != tc.code {
+			t.Errorf("%s %s got %d, want %d", tc.method, tc.path, code, tc.code)
+		}
+	}
+}
+
+func TestRevokedToken(t *testing.T) {
+	e, nodeOpt := newTestServer(t)
+	remote := "10.0.0.2:5000"
+
+	tokenStr, apiToken := newTestToken(t, nodeOpt, []string{SCOPE_READ}, nil)
+	if code := doRequest(e, http.MethodGet, "/api/v1/node", remote, "", tokenStr); code != http.StatusOK {
+		t.Fatalf("token got %d before revoked, want 200", code)
+	}
+	if err := nodeOpt.RevokeAPIToken(apiToken.Id); err != nil {
+		t.Fatalf("revoke token err: %s", err)
+	}
+	if code := doRequest(e, http.MethodGet, "/api/v1/node", remote, "", tokenStr); code != http.StatusUnauthorized {
+		t.Errorf("revoked token got %d, want 401", code)
+	}
+}
+
+func TestLegacyTokenRejected(t *testing.T) {
+	e, nodeOpt := newTestServer(t)
+
+	//token issued before scopes are supported, it has no id
+	token := jwt.New(jwt.SigningMethodHS256)
+	claims := token.Claims.(jwt.MapClaims)
+	claims["name"] = "peer"
+	claims["exp"] = time.Now().Unix() + TOKEN_DEFAULT_EXPIRES
+	tokenStr, err := token.SignedString([]byte(nodeOpt.JWTKey))
+	if err != nil {
+		t.Fatalf("sign token err: %s", err)
+	}
+	if code := doRequest(e, http.MethodGet, "/api/v1/node", "10.0.0.2:5000", "", tokenStr); code != http.StatusUnauthorized {
+		t.Errorf("legacy token got %d, want 401", code)
+	}
+}
@@ -0,0 +1,109 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	chain "github.com/rumsystem/quorum/internal/pkg/chain"
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+)
+
+const DEFAULT_REPLY_NUM int = 20
+const MAX_REPLY_NUM int = 100
+const DEFAULT_REPLY_DEPTH int = 1
+const MAX_REPLY_DEPTH int = 5
+const MAX_REPLY_NODES int = 500 //max replies of all levels in a response
+
+type ReplyItem struct {
+	GroupContentObjectItem
+	Replies []*ReplyItem `json:",omitempty"` //nested replies, the first num replies of each level
+}
+
+// @Tags Apps
+// @Summary GetContentReplies
+// @Description Get the replies of a POST in a group ordered by time, nested replies are returned up to depth levels, the first num replies of each nested level are returned, get more by the trx_id of the nested reply. 500 replies of all levels at most, nested replies of the later replies are omitted once reached
+// @Produce json
+// @Param group_id path string true "Group Id"
+// @Param trx_id path string true "Trx Id of the POST"
+// @Param num query int false "the count of returns replies of each level, 20 by default, 100 at most"
+// @Param reverse query boolean false "reverse = true will return replies by most recently"
+// @Param starttrx query string false "returns direct replies from this trxid, but exclude it"
+// @Param depth query int false "levels of nested replies, 1 by default (direct replies only), 5 at most"
+// @Success 200 {array} ReplyItem
+// @Router /app/api/v1/group/{group_id}/content/{trx_id}/replies [get]
+func (h *Handler) GetContentReplies(c echo.Context) (err error) {
+	output := make(map[string]string)
+	groupid := c.Param("group_id")
+	trxid := c.Param("trx_id")
+	starttrx := c.QueryParam("starttrx")
+	reverse := c.QueryParam("reverse") == "true"
+
+	num := DEFAULT_REPLY_NUM
+	if n := c.QueryParam("num"); n != "" {
+		if num, err = strconv.Atoi(n); err != nil || num <= 0 || num > MAX_REPLY_NUM {
+			output[ERROR_INFO] = fmt.Sprintf("num should be 1-%d", MAX_REPLY_NUM)
+			return c.JSON(http.StatusBadRequest, output)
+		}
+	}
+	depth := DEFAULT_REPLY_DEPTH
+	if d := c.QueryParam("depth"); d != "" {
+		if depth, err = strconv.Atoi(d); err != nil || depth <= 0 || depth > MAX_REPLY_DEPTH {
+			output[ERROR_INFO] = fmt.Sprintf("depth should be 1-%d", MAX_REPLY_DEPTH)
+			return c.JSON(http.StatusBadRequest, output)
+		}
+	}
+
+	groupmgr := chain.GetGroupMgr()
+	groupitem, err := groupmgr.GetGroupItem(groupid)
+	if err != nil {
+		output[ERROR_INFO] = err.Error()
+		return c.JSON(http.StatusBadRequest, output)
+	}
+
+	remain := MAX_REPLY_NODES
+	replies, err := h.getReplies(groupitem, trxid, starttrx, num, reverse, depth, &remain)
+	if err != nil {
+		output[ERROR_INFO] = err.Error()
+		return c.JSON(http.StatusBadRequest, output)
+	}
+	return c.JSON(http.StatusOK, replies)
+}
+
+//getReplies returns the replies of the POST, and the nested replies of them down to depth levels, remain is the
+//count of replies can still be returned, replies of a level are counted before their nested replies
+func (h *Handler) getReplies(groupitem *quorumpb.GroupItem, trxid string, starttrx string, num int, reverse bool, depth int, remain *int) ([]*ReplyItem, error) {
+	limit := num
+	if limit > *remain {
+		limit = *remain
+	}
+	items := []*ReplyItem{}
+	if limit <= 0 {
+		return items, nil
+	}
+
+	replies, err := h.Appdb.GetReplies(groupitem.GroupId, trxid, starttrx, limit, reverse)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, reply := range replies {
+		ctnobjitem, err := h.getContentItem(groupitem, reply.TrxId)
+		if err != nil {
+			logger.Errorf("Get content %s Err: %s", reply.TrxId, err)
+			continue
+		}
+		items = append(items, &ReplyItem{GroupContentObjectItem: *ctnobjitem})
+	}
+	*remain -= len(items)
+
+	for _, item := range items {
+		if depth > 1 && item.ReplyCount > 0 {
+			if item.Replies, err = h.getReplies(groupitem, item.TrxId, "", num, reverse, depth-1, remain); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return items, nil
+}
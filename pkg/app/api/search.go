@@ -0,0 +1,136 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rumsystem/quorum/internal/pkg/appdata"
+	chain "github.com/rumsystem/quorum/internal/pkg/chain"
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+)
+
+const DEFAULT_SEARCH_LIMIT int = 20
+const MAX_SEARCH_LIMIT int = 100
+
+type SearchContentItem struct {
+	GroupContentObjectItem
+	Score float64
+}
+
+// @Tags Apps
+// @Summary SearchGroupContent
+// @Description Full-text search in the name, summary, content and tags of the POSTs of a group, all words of the query should be matched, "a phrase" matches the words in order, word* matches the words starts with it; results are ranked by relevance
+// @Produce json
+// @Param group_id path string true "Group Id"
+// @Param q query string true "query"
+// @Param senders query []string false "sender pubkeys, can be repeated or separated by comma"
+// @Param from query int false "returns POSTs from this timestamp (included)"
+// @Param to query int false "returns POSTs until this timestamp (included)"
+// @Param offset query int false "skip the first offset results"
+// @Param limit query int false "the count of returns results, 20 by default, 100 at most"
+// @Success 200 {array} SearchContentItem
+// @Router /app/api/v1/group/{group_id}/search [get]
+func (h *Handler) SearchGroupContent(c echo.Context) (err error) {
+	output := make(map[string]string)
+	groupid := c.Param("group_id")
+	query, err := getSearchQuery(c)
+	if err != nil {
+		output[ERROR_INFO] = err.Error()
+		return c.JSON(http.StatusBadRequest, output)
+	}
+
+	groupmgr := chain.GetGroupMgr()
+	groupitem, err := groupmgr.GetGroupItem(groupid)
+	if err != nil {
+		output[ERROR_INFO] = err.Error()
+		return c.JSON(http.StatusBadRequest, output)
+	}
+
+	results, err := h.Appdb.SearchGroupContent(groupid, query)
+	if err != nil {
+		output[ERROR_INFO] = err.Error()
+		return c.JSON(http.StatusBadRequest, output)
+	}
+
+	itemList := []*SearchContentItem{}
+	for _, result := range results {
+		trx, err := h.Chaindb.GetTrx(result.TrxId, h.NodeName)
+		if err != nil {
+			c.Logger().Errorf("GetTrx Err: %s", err)
+			continue
+		}
+
+		data, edited, deleted, err := chain.GetPostContent(groupitem, trx, h.NodeName)
+		if err != nil {
+			c.Logger().Errorf("Decrypt trx %s Err: %s", trx.TrxId, err)
+			continue
+		}
+		if deleted {
+			continue
+		}
+
+		ctnobj, typeurl, errum := quorumpb.BytesToMessage(trx.TrxId, data)
+		if errum != nil {
+			c.Logger().Errorf("Unmarshal trx.Data %s Err: %s", trx.TrxId, errum)
+			continue
+		}
+		replyCount, err := h.Appdb.GetReplyCount(groupid, trx.TrxId)
+		if err != nil {
+			c.Logger().Errorf("GetReplyCount %s Err: %s", trx.TrxId, err)
+		}
+		reactions, myReaction, err := h.Appdb.GetReactions(groupid, trx.TrxId, groupitem.UserSignPubkey)
+		if err != nil {
+			c.Logger().Errorf("GetReactions %s Err: %s", trx.TrxId, err)
+		}
+		ctnobjitem := GroupContentObjectItem{TrxId: trx.TrxId, Publisher: trx.SenderPubkey, Content: ctnobj, TimeStamp: trx.TimeStamp, TypeUrl: typeurl, Edited: edited, ReplyCount: replyCount, Reactions: reactions, MyReaction: myReaction}
+		itemList = append(itemList, &SearchContentItem{GroupContentObjectItem: ctnobjitem, Score: result.Score})
+	}
+	return c.JSON(http.StatusOK, itemList)
+}
+
+func getSearchQuery(c echo.Context) (*appdata.SearchQuery, error) {
+	var err error
+	query := &appdata.SearchQuery{Query: c.QueryParam("q")}
+	if strings.TrimSpace(query.Query) == "" {
+		return nil, fmt.Errorf("q is required")
+	}
+
+	query.Limit = DEFAULT_SEARCH_LIMIT
+	if limit := c.QueryParam("limit"); limit != "" {
+		if query.Limit, err = strconv.Atoi(limit); err != nil || query.Limit <= 0 || query.Limit > MAX_SEARCH_LIMIT {
+			return nil, fmt.Errorf("limit should be 1-%d", MAX_SEARCH_LIMIT)
+		}
+	}
+	if offset := c.QueryParam("offset"); offset != "" {
+		if query.Offset, err = strconv.Atoi(offset); err != nil || query.Offset < 0 {
+			return nil, fmt.Errorf("invalid offset: %s", offset)
+		}
+	}
+
+	if from := c.QueryParam("from"); from != "" {
+		if query.From, err = strconv.ParseInt(from, 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid from: %s", from)
+		}
+	}
+	if to := c.QueryParam("to"); to != "" {
+		if query.To, err = strconv.ParseInt(to, 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid to: %s", to)
+		}
+	}
+	if query.From > 0 && query.To > 0 && query.From > query.To {
+		return nil, fmt.Errorf("from should not be later than to")
+	}
+
+	//senders can be repeated or separated by comma
+	for _, senders := range c.QueryParams()["senders"] {
+		for _, sender := range strings.Split(senders, ",") {
+			if sender = strings.TrimSpace(sender); sender != "" {
+				query.Senders = append(query.Senders, sender)
+			}
+		}
+	}
+	return query, nil
+}
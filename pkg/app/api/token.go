@@ -0,0 +1,149 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/rumsystem/quorum/internal/pkg/options"
+)
+
+type CreateTokenParam struct {
+	Name      string   `from:"name"       json:"name"       validate:"required"`
+	Scopes    []string `from:"scopes"     json:"scopes"     validate:"required,min=1,dive,oneof=read post admin node"`
+	Groups    []string `from:"groups"     json:"groups"`
+	ExpiresIn int64    `from:"expires_in" json:"expires_in" validate:"gte=0"`
+}
+
+type RevokeTokenParam struct {
+	TokenId string `from:"token_id" json:"token_id" validate:"required"`
+}
+
+type TokenInfo struct {
+	TokenId   string   `json:"token_id"`
+	Name      string   `json:"name"`
+	Scopes    []string `json:"scopes"`
+	Groups    []string `json:"groups"`
+	IssuedAt  int64    `json:"issued_at"`
+	ExpiresAt int64    `json:"expires_at"`
+	Revoked   bool     `json:"revoked"`
+}
+
+type CreateTokenResult struct {
+	TokenInfo
+	Token string `json:"token"`
+}
+
+// @Tags Apps
+// @Summary CreateToken
+// @Description Create a named auth token with scopes, the post scope is limited to the groups if any, expires_in is in seconds and 30 days by default
+// @Accept json
+// @Produce json
+// @Param data body CreateTokenParam true "CreateTokenParam"
+// @Success 200 {object} CreateTokenResult
+// @Router /app/api/v1/token [post]
+func (h *Handler) CreateToken(c echo.Context) (err error) {
+	output := make(map[string]string)
+	validate := validator.New()
+	params := new(CreateTokenParam)
+	if err = c.Bind(params); err != nil {
+		output[ERROR_INFO] = err.Error()
+		return c.JSON(http.StatusBadRequest, output)
+	}
+	if err = validate.Struct(params); err != nil {
+		output[ERROR_INFO] = err.Error()
+		return c.JSON(http.StatusBadRequest, output)
+	}
+
+	nodeOpt := options.GetNodeOptions()
+	if nodeOpt == nil {
+		output[ERROR_INFO] = "Call InitNodeOptions() before use it"
+		return c.JSON(http.StatusInternalServerError, output)
+	}
+
+	expiresIn := params.ExpiresIn
+	if expiresIn == 0 {
+		expiresIn = TOKEN_DEFAULT_EXPIRES
+	}
+	apiToken := newAPIToken(params.Name, params.Scopes, params.Groups, expiresIn)
+	tokenStr, err := getToken(apiToken, nodeOpt.JWTKey)
+	if err != nil {
+		output[ERROR_INFO] = err.Error()
+		return c.JSON(http.StatusInternalServerError, output)
+	}
+	if err := nodeOpt.AddAPIToken(apiToken); err != nil {
+		output[ERROR_INFO] = err.Error()
+		return c.JSON(http.StatusInternalServerError, output)
+	}
+
+	return c.JSON(http.StatusOK, &CreateTokenResult{TokenInfo: *tokenInfo(nodeOpt, apiToken), Token: tokenStr})
+}
+
+// @Tags Apps
+// @Summary GetTokens
+// @Description Get the auth tokens issued by the node, the token strings are not returned
+// @Produce json
+// @Success 200 {array} TokenInfo
+// @Router /app/api/v1/token [get]
+func (h *Handler) GetTokens(c echo.Context) (err error) {
+	output := make(map[string]string)
+	nodeOpt := options.GetNodeOptions()
+	if nodeOpt == nil {
+		output[ERROR_INFO] = "Call InitNodeOptions() before use it"
+		return c.JSON(http.StatusInternalServerError, output)
+	}
+
+	tokenList := []*TokenInfo{}
+	for _, apiToken := range nodeOpt.GetAPITokens() {
+		tokenList = append(tokenList, tokenInfo(nodeOpt, apiToken))
+	}
+	return c.JSON(http.StatusOK, tokenList)
+}
+
+// @Tags Apps
+// @Summary RevokeToken
+// @Description Revoke an auth token, the token and its refreshed tokens are rejected from now on
+// @Accept json
+// @Produce json
+// @Param data body RevokeTokenParam true "RevokeTokenParam"
+// @Success 200 {object} TokenInfo
+// @Router /app/api/v1/token/revoke [post]
+func (h *Handler) RevokeToken(c echo.Context) (err error) {
+	output := make(map[string]string)
+	validate := validator.New()
+	params := new(RevokeTokenParam)
+	if err = c.Bind(params); err != nil {
+		output[ERROR_INFO] = err.Error()
+		return c.JSON(http.StatusBadRequest, output)
+	}
+	if err = validate.Struct(params); err != nil {
+		output[ERROR_INFO] = err.Error()
+		return c.JSON(http.StatusBadRequest, output)
+	}
+
+	nodeOpt := options.GetNodeOptions()
+	if nodeOpt == nil {
+		output[ERROR_INFO] = "Call InitNodeOptions() before use it"
+		return c.JSON(http.StatusInternalServerError, output)
+	}
+
+	apiToken := nodeOpt.GetAPIToken(params.TokenId)
+	if apiToken == nil {
+		output[ERROR_INFO] = fmt.Sprintf("token %s not exist", params.TokenId)
+		return c.JSON(http.StatusBadRequest, output)
+	}
+	if err := nodeOpt.RevokeAPIToken(apiToken.Id); err != nil {
+		output[ERROR_INFO] = err.Error()
+		return c.JSON(http.StatusInternalServerError, output)
+	}
+	return c.JSON(http.StatusOK, tokenInfo(nodeOpt, apiToken))
+}
+
+func tokenInfo(nodeOpt *options.NodeOptions, apiToken *options.APIToken) *TokenInfo {
+	groups := apiToken.Groups
+	if groups == nil {
+		groups = []string{}
+	}
+	return &TokenInfo{TokenId: apiToken.Id, Name: apiToken.Name, Scopes: apiToken.Scopes, Groups: groups, IssuedAt: apiToken.IssuedAt, ExpiresAt: apiToken.ExpiresAt, Revoked: nodeOpt.IsTokenRevoked(apiToken.Id)}
+}
@@ -4,12 +4,11 @@
 package api
 
 import (
-	"encoding/hex"
 	"errors"
 	"fmt"
 
 	"github.com/rumsystem/quorum/internal/pkg/chain"
-	qCrypto "github.com/rumsystem/quorum/internal/pkg/crypto"
+	"github.com/rumsystem/quorum/internal/pkg/nodectx"
 	"github.com/rumsystem/quorum/internal/pkg/pb"
 	"google.golang.org/protobuf/proto"
 )
@@ -48,7 +47,7 @@ func GetDecodedBlockById(gid string, bid string) (*DecodeBlockRespStruct, error)
 		}
 		if group.Item.EncryptType == pb.GroupEncryptType_PUBLIC {
 			// aes decode trx
-			decodedTrxs, err = aesDecodeTrxData(block, group.Item.CipherKey)
+			decodedTrxs, err = aesDecodeTrxData(block, group.Item)
 			if err != nil {
 				return nil, errors.New(fmt.Sprint("Failed to decode trx: ", err.Error()))
 			}
@@ -61,14 +60,11 @@ func GetDecodedBlockById(gid string, bid string) (*DecodeBlockRespStruct, error)
 	}
 }
 
-func aesDecodeTrxData(block *pb.Block, kStr string) (*[]DecodeTrxStruct, error) {
+func aesDecodeTrxData(block *pb.Block, groupItem *pb.GroupItem) (*[]DecodeTrxStruct, error) {
 	ret := &[]DecodeTrxStruct{}
-	k, err := hex.DecodeString(kStr)
-	if err != nil {
-		return nil, err
-	}
 	for _, trx := range block.Trxs {
-		decodedData, err := qCrypto.AesDecode(trx.Data, k)
+		//trxs in block are encrypted by the cipher key of their own key epoch
+		decodedData, err := chain.DecryptTrxData(groupItem, trx, nodectx.GetNodeCtx().Name)
 		if err != nil {
 			return nil, err
 		}
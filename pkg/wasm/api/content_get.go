@@ -4,10 +4,7 @@
 package api
 
 import (
-	"encoding/hex"
-
 	"github.com/rumsystem/quorum/internal/pkg/chain"
-	localcrypto "github.com/rumsystem/quorum/internal/pkg/crypto"
 	"github.com/rumsystem/quorum/internal/pkg/nodectx"
 	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
 	quorumContext "github.com/rumsystem/quorum/pkg/wasm/context"
@@ -15,11 +12,16 @@ import (
 )
 
 type GroupContent struct {
-	TrxId     string
-	Publisher string
-	Content   proto.Message
-	TypeUrl   string
-	TimeStamp int64
+	TrxId      string
+	Publisher  string
+	Content    proto.Message
+	TypeUrl    string
+	TimeStamp  int64
+	Edited     bool             //Content is the latest version
+	Deleted    bool             //deleted post has no Content
+	ReplyCount int64            //count of direct replies
+	Reactions  map[string]int64 //counts of Like, Dislike and emoji reactions
+	MyReaction string           //reaction of this node, empty if not reacted
 }
 
 type GroupContentResp struct {
@@ -41,44 +43,48 @@ func GetContent(groupId string, num int, startTrx string, reverse bool, senders
 		return nil, err
 	}
 	for _, trxid := range trxids {
-		trx, err := wasmCtx.DbMgr.GetTrx(trxid, nodectx.GetNodeCtx().Name)
+		item, err := getContentItem(groupitem, trxid)
 		if err != nil {
 			println(err)
 			continue
 		}
+		data = append(data, *item)
+	}
 
-		//decrypt trx data
-		if trx.Type == quorumpb.TrxType_POST && groupitem.EncryptType == quorumpb.GroupEncryptType_PRIVATE {
-			//for post, private group, encrypted by pgp for all announced group user
-			ks := localcrypto.GetKeystore()
-			decryptData, err := ks.Decrypt(groupitem.UserEncryptPubkey, trx.Data)
-			if err != nil {
-				return nil, err
-			}
-			trx.Data = decryptData
-		} else {
-			//decode trx data
-			ciperKey, err := hex.DecodeString(groupitem.CipherKey)
-			if err != nil {
-				return nil, err
-			}
+	ret := GroupContentResp{&data}
 
-			decryptData, err := localcrypto.AesDecode(trx.Data, ciperKey)
-			if err != nil {
-				return nil, err
-			}
-			trx.Data = decryptData
-		}
+	return &ret, nil
+}
 
-		ctnobj, typeurl, errum := quorumpb.BytesToMessage(trx.TrxId, trx.Data)
-		if errum != nil {
-			println("Unmarshal trx.Data %s Err: %s", trx.TrxId, errum)
-		}
-		item := GroupContent{TrxId: trx.TrxId, Publisher: trx.SenderPubkey, Content: ctnobj, TimeStamp: trx.TimeStamp, TypeUrl: typeurl}
-		data = append(data, item)
+//getContentItem returns the latest content of the post, the count of its replies and the reactions
+func getContentItem(groupitem *quorumpb.GroupItem, trxid string) (*GroupContent, error) {
+	wasmCtx := quorumContext.GetWASMContext()
+	trx, err := wasmCtx.DbMgr.GetTrx(trxid, nodectx.GetNodeCtx().Name)
+	if err != nil {
+		return nil, err
 	}
 
-	ret := GroupContentResp{&data}
+	replyCount, err := wasmCtx.AppDb.GetReplyCount(groupitem.GroupId, trxid)
+	if err != nil {
+		return nil, err
+	}
+	reactions, myReaction, err := wasmCtx.AppDb.GetReactions(groupitem.GroupId, trxid, groupitem.UserSignPubkey)
+	if err != nil {
+		return nil, err
+	}
 
-	return &ret, nil
+	//decrypt trx data, by the cipher key of the key epoch the trx carries, or the latest content of edited post
+	ctnData, edited, deleted, err := chain.GetPostContent(groupitem, trx, nodectx.GetNodeCtx().Name)
+	if err != nil {
+		return nil, err
+	}
+	if deleted {
+		return &GroupContent{TrxId: trx.TrxId, Publisher: trx.SenderPubkey, TimeStamp: trx.TimeStamp, Deleted: true, ReplyCount: replyCount, Reactions: reactions, MyReaction: myReaction}, nil
+	}
+
+	ctnobj, typeurl, errum := quorumpb.BytesToMessage(trx.TrxId, ctnData)
+	if errum != nil {
+		println("Unmarshal trx.Data %s Err: %s", trx.TrxId, errum)
+	}
+	return &GroupContent{TrxId: trx.TrxId, Publisher: trx.SenderPubkey, Content: ctnobj, TimeStamp: trx.TimeStamp, TypeUrl: typeurl, Edited: edited, ReplyCount: replyCount, Reactions: reactions, MyReaction: myReaction}, nil
 }
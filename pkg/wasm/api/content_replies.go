@@ -0,0 +1,85 @@
+//go:build js && wasm
+// +build js,wasm
+
+package api
+
+import (
+	"fmt"
+
+	"github.com/rumsystem/quorum/internal/pkg/chain"
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+	quorumContext "github.com/rumsystem/quorum/pkg/wasm/context"
+)
+
+const MAX_REPLY_NUM int = 100
+const MAX_REPLY_DEPTH int = 5
+const MAX_REPLY_NODES int = 500 //max replies of all levels in a response
+
+type ReplyContent struct {
+	GroupContent
+	Replies []*ReplyContent `json:",omitempty"`
+}
+
+type ReplyContentResp struct {
+	Data []*ReplyContent `json:"data"`
+}
+
+func GetContentReplies(groupId string, trxId string, num int, startTrx string, reverse bool, depth int) (*ReplyContentResp, error) {
+	if num <= 0 || num > MAX_REPLY_NUM {
+		return nil, fmt.Errorf("num should be 1-%d", MAX_REPLY_NUM)
+	}
+	if depth <= 0 || depth > MAX_REPLY_DEPTH {
+		return nil, fmt.Errorf("depth should be 1-%d", MAX_REPLY_DEPTH)
+	}
+
+	groupmgr := chain.GetGroupMgr()
+	groupitem, err := groupmgr.GetGroupItem(groupId)
+	if err != nil {
+		return nil, err
+	}
+
+	remain := MAX_REPLY_NODES
+	data, err := getReplies(groupitem, trxId, startTrx, num, reverse, depth, &remain)
+	if err != nil {
+		return nil, err
+	}
+	return &ReplyContentResp{data}, nil
+}
+
+//getReplies returns the replies and their nested replies down to depth levels, remain is the count of replies can
+//still be returned, replies of a level are counted before their nested replies
+func getReplies(groupitem *quorumpb.GroupItem, trxId string, startTrx string, num int, reverse bool, depth int, remain *int) ([]*ReplyContent, error) {
+	limit := num
+	if limit > *remain {
+		limit = *remain
+	}
+	data := []*ReplyContent{}
+	if limit <= 0 {
+		return data, nil
+	}
+
+	wasmCtx := quorumContext.GetWASMContext()
+	replies, err := wasmCtx.AppDb.GetReplies(groupitem.GroupId, trxId, startTrx, limit, reverse)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, reply := range replies {
+		item, err := getContentItem(groupitem, reply.TrxId)
+		if err != nil {
+			println(err)
+			continue
+		}
+		data = append(data, &ReplyContent{GroupContent: *item})
+	}
+	*remain -= len(data)
+
+	for _, replyItem := range data {
+		if depth > 1 && replyItem.ReplyCount > 0 {
+			if replyItem.Replies, err = getReplies(groupitem, replyItem.TrxId, "", num, reverse, depth-1, remain); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return data, nil
+}
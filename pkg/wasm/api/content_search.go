@@ -0,0 +1,73 @@
+//go:build js && wasm
+// +build js,wasm
+
+package api
+
+import (
+	"github.com/rumsystem/quorum/internal/pkg/appdata"
+	"github.com/rumsystem/quorum/internal/pkg/chain"
+	"github.com/rumsystem/quorum/internal/pkg/nodectx"
+	quorumpb "github.com/rumsystem/quorum/internal/pkg/pb"
+	quorumContext "github.com/rumsystem/quorum/pkg/wasm/context"
+)
+
+type SearchContent struct {
+	GroupContent
+	Score float64
+}
+
+type SearchContentResp struct {
+	Data *[]SearchContent `json:"data"`
+}
+
+func SearchGroupContent(groupId string, query *appdata.SearchQuery) (*SearchContentResp, error) {
+	data := []SearchContent{}
+
+	wasmCtx := quorumContext.GetWASMContext()
+	results, err := wasmCtx.AppDb.SearchGroupContent(groupId, query)
+	if err != nil {
+		return nil, err
+	}
+
+	groupmgr := chain.GetGroupMgr()
+	groupitem, err := groupmgr.GetGroupItem(groupId)
+	if err != nil {
+		return nil, err
+	}
+	for _, result := range results {
+		trx, err := wasmCtx.DbMgr.GetTrx(result.TrxId, nodectx.GetNodeCtx().Name)
+		if err != nil {
+			println(err)
+			continue
+		}
+
+		ctnData, edited, deleted, err := chain.GetPostContent(groupitem, trx, nodectx.GetNodeCtx().Name)
+		if err != nil {
+			println(err)
+			continue
+		}
+		if deleted {
+			continue
+		}
+
+		ctnobj, typeurl, errum := quorumpb.BytesToMessage(trx.TrxId, ctnData)
+		if errum != nil {
+			println("Unmarshal trx.Data %s Err: %s", trx.TrxId, errum)
+			continue
+		}
+		replyCount, err := wasmCtx.AppDb.GetReplyCount(groupId, trx.TrxId)
+		if err != nil {
+			println(err)
+		}
+		reactions, myReaction, err := wasmCtx.AppDb.GetReactions(groupId, trx.TrxId, groupitem.UserSignPubkey)
+		if err != nil {
+			println(err)
+		}
+		item := GroupContent{TrxId: trx.TrxId, Publisher: trx.SenderPubkey, Content: ctnobj, TimeStamp: trx.TimeStamp, TypeUrl: typeurl, Edited: edited, ReplyCount: replyCount, Reactions: reactions, MyReaction: myReaction}
+		data = append(data, SearchContent{GroupContent: item, Score: result.Score})
+	}
+
+	ret := SearchContentResp{&data}
+
+	return &ret, nil
+}
@@ -63,6 +63,8 @@ func GetGroups() (*GroupInfoList, error) {
 			group.GroupStatus = "SYNCING"
 		case chain.SYNCING_FORWARD:
 			group.GroupStatus = "SYNCING"
+		case chain.SYNCING_SNAPSHOT:
+			group.GroupStatus = "SYNCING"
 		case chain.SYNC_FAILED:
 			group.GroupStatus = "SYNC_FAILED"
 		case chain.IDLE:
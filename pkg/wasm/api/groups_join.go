@@ -195,7 +195,11 @@ func createGroupItem(params *JoinGroupParam, ownerPubkeyBytes []byte, groupSignP
 	item.CipherKey = params.CipherKey
 	item.AppKey = params.AppKey
 
-	item.ConsenseType = quorumpb.GroupConsenseType_POA
+	if params.ConsensusType == "pos" {
+		item.ConsenseType = quorumpb.GroupConsenseType_POS
+	} else {
+		item.ConsenseType = quorumpb.GroupConsenseType_POA
+	}
 	item.UserSignPubkey = p2pcrypto.ConfigEncodeKey(groupSignPubkey)
 
 	item.UserEncryptPubkey = userEncryptKey
@@ -121,6 +121,9 @@ func newAppDb() (*storage.QSIndexDB, error) {
 	if err != nil {
 		return nil, err
 	}
+	if _, err := storage.Migrate(".", storage.APP_DB, &appDb, &storage.MigrationOptions{}); err != nil {
+		return nil, err
+	}
 	return &appDb, nil
 }
 
@@ -142,6 +145,10 @@ func newStoreManager() (*storage.DbMgr, error) {
 	storeMgr.Auth = nil
 	storeMgr.DataPath = "."
 
+	//the browser storage can not be backuped
+	if _, err := storeMgr.Migrate(&storage.MigrationOptions{}); err != nil {
+		return nil, err
+	}
 	return &storeMgr, nil
 }
 
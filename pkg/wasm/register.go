@@ -5,9 +5,11 @@ package wasm
 
 import (
 	"encoding/json"
+	"strconv"
 	"strings"
 	"syscall/js"
 
+	"github.com/rumsystem/quorum/internal/pkg/appdata"
 	quorumAPI "github.com/rumsystem/quorum/pkg/wasm/api"
 )
 
@@ -72,6 +74,66 @@ func RegisterJSFunctions() {
 		return Promisefy(handler)
 	}))
 
+	js.Global().Set("GetContentReplies", js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		if len(args) < 6 {
+			return nil
+		}
+		groupId := args[0].String()
+		trxId := args[1].String()
+		num := args[2].Int()
+		startTrx := args[3].String()
+		reverse := args[4].Bool()
+		depth := args[5].Int()
+
+		handler := func() (map[string]interface{}, error) {
+			ret := make(map[string]interface{})
+			res, err := quorumAPI.GetContentReplies(groupId, trxId, num, startTrx, reverse, depth)
+			if err != nil {
+				return ret, err
+			}
+			retBytes, _ := json.Marshal(res)
+			json.Unmarshal(retBytes, &ret)
+			return ret, nil
+		}
+		return Promisefy(handler)
+	}))
+
+	js.Global().Set("SearchContent", js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		if len(args) < 6 {
+			return nil
+		}
+		groupId := args[0].String()
+		query := &appdata.SearchQuery{}
+		query.Query = args[1].String()
+		//timestamps in nanoseconds are over the max safe integer of js, they are passed as strings
+		from := args[2].String()
+		to := args[3].String()
+		query.Offset = args[4].Int()
+		query.Limit = args[5].Int()
+		for i := 6; i < len(args); i += 1 {
+			query.Senders = append(query.Senders, args[i].String())
+		}
+
+		handler := func() (map[string]interface{}, error) {
+			ret := make(map[string]interface{})
+			var err error
+			if query.From, err = parseTimeStamp(from); err != nil {
+				return ret, err
+			}
+			if query.To, err = parseTimeStamp(to); err != nil {
+				return ret, err
+			}
+			res, err := quorumAPI.SearchGroupContent(groupId, query)
+			if err != nil {
+				return ret, err
+			}
+			retBytes, _ := json.Marshal(res)
+			json.Unmarshal(retBytes, &ret)
+			return ret, nil
+		}
+		return Promisefy(handler)
+	}))
+
 	js.Global().Set("JoinGroup", js.FuncOf(func(this js.Value, args []js.Value) interface{} {
 		seed := args[0].String()
 		handler := func() (map[string]interface{}, error) {
@@ -140,3 +202,11 @@ func RegisterJSFunctions() {
 		return js.ValueOf(true).Bool()
 	}))
 }
+
+//parseTimeStamp parses the timestamp passed as a string, empty for no limit
+func parseTimeStamp(s string) (int64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	return strconv.ParseInt(s, 10, 64)
+}
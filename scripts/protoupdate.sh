@@ -1,3 +1,6 @@
 protoc -I=internal/pkg/pb --go_out=internal/pkg/pb internal/pkg/pb/chain.proto
 mv internal/pkg/pb/github.com/rumsystem/quorum/internal/pkg/pb/chain.pb.go internal/pkg/pb/chain.pb.go
 sed -i 's/TimeStamp,omitempty/TimeStamp,omitempty,string/g' internal/pkg/pb/chain.pb.go
+protoc -I=internal/pkg/pb --go_out=internal/pkg/pb --go-grpc_out=internal/pkg/pb internal/pkg/pb/api.proto
+mv internal/pkg/pb/github.com/rumsystem/quorum/internal/pkg/pb/api.pb.go internal/pkg/pb/api.pb.go
+mv internal/pkg/pb/github.com/rumsystem/quorum/internal/pkg/pb/api_grpc.pb.go internal/pkg/pb/api_grpc.pb.go